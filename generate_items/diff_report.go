@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Snapshot of the generated items, written next to the other item data on
+// every generation. The diff report compares against the previous snapshot,
+// so the generator doesn't depend on the items package it writes into.
+const itemSnapshotPath = "./assets/item_data/item_snapshot.csv"
+
+// The fields of a generated item which affect sim results.
+type ItemSnapshot struct {
+	ID          int
+	Name        string
+	Phase       int
+	Quality     proto.ItemQuality
+	Ilvl        int
+	GemSockets  []proto.GemColor
+	Stats       Stats
+	SocketBonus Stats
+	HasEffect   bool
+}
+
+func newItemSnapshot(itemData ItemData) ItemSnapshot {
+	return ItemSnapshot{
+		ID:          itemData.Declaration.ID,
+		Name:        itemData.Response.Name,
+		Phase:       itemData.GetPhase(),
+		Quality:     proto.ItemQuality(itemData.Response.Quality),
+		Ilvl:        itemData.Response.GetItemLevel(),
+		GemSockets:  itemData.Response.GetGemSockets(),
+		Stats:       mergeStats(itemData.Response.GetStats(), itemData.Declaration.Stats),
+		SocketBonus: mergeStats(itemData.Response.GetSocketBonus(), Stats{}),
+		HasEffect:   itemData.Response.HasEffect(),
+	}
+}
+
+// Writes a report of how the generated items differ from the previous
+// snapshot, then replaces the snapshot with the generated items.
+func writeItemDiffReport(path string, itemsData []ItemData) {
+	newItems := make([]ItemSnapshot, len(itemsData))
+	for i, itemData := range itemsData {
+		newItems[i] = newItemSnapshot(itemData)
+	}
+
+	lines := getItemDiffReport(readItemSnapshot(), newItems)
+	report := strings.Join(lines, "\n") + "\n"
+	if len(lines) == 0 {
+		report = "No item changes.\n"
+	}
+
+	if path == "" {
+		fmt.Print(report)
+	} else if err := os.WriteFile(path, []byte(report), 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	writeItemSnapshot(newItems)
+}
+
+func getItemDiffReport(oldItemsList []ItemSnapshot, newItemsList []ItemSnapshot) []string {
+	oldItems := make(map[int]ItemSnapshot, len(oldItemsList))
+	for _, item := range oldItemsList {
+		oldItems[item.ID] = item
+	}
+	newItems := make(map[int]ItemSnapshot, len(newItemsList))
+	for _, item := range newItemsList {
+		newItems[item.ID] = item
+	}
+
+	ids := make([]int, 0, len(newItems)+len(oldItems))
+	for id := range newItems {
+		ids = append(ids, id)
+	}
+	for id := range oldItems {
+		if _, ok := newItems[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	var lines []string
+	for _, id := range ids {
+		oldItem, hasOld := oldItems[id]
+		newItem, hasNew := newItems[id]
+		if !hasOld {
+			lines = append(lines, fmt.Sprintf("Added %s (%d)", newItem.Name, id))
+		} else if !hasNew {
+			lines = append(lines, fmt.Sprintf("Removed %s (%d)", oldItem.Name, id))
+		} else if changes := getItemChanges(oldItem, newItem); len(changes) > 0 {
+			lines = append(lines, fmt.Sprintf("Changed %s (%d): %s", oldItem.Name, id, strings.Join(changes, ", ")))
+		}
+	}
+	return lines
+}
+
+func getItemChanges(oldItem ItemSnapshot, newItem ItemSnapshot) []string {
+	var changes []string
+	addChange := func(field string, oldValue interface{}, newValue interface{}) {
+		if fmt.Sprint(oldValue) != fmt.Sprint(newValue) {
+			changes = append(changes, fmt.Sprintf("%s %v -> %v", field, oldValue, newValue))
+		}
+	}
+
+	addChange("Name", oldItem.Name, newItem.Name)
+	addChange("Phase", oldItem.Phase, newItem.Phase)
+	addChange("Quality", oldItem.Quality, newItem.Quality)
+	addChange("Ilvl", oldItem.Ilvl, newItem.Ilvl)
+	addChange("GemSockets", oldItem.GemSockets, newItem.GemSockets)
+	addChange("HasEffect", oldItem.HasEffect, newItem.HasEffect)
+
+	for stat := stats.Stat(0); stat < stats.Len; stat++ {
+		addChange(stat.StatName(), oldItem.Stats[stat], newItem.Stats[stat])
+		addChange("SocketBonus "+stat.StatName(), oldItem.SocketBonus[stat], newItem.SocketBonus[stat])
+	}
+
+	return changes
+}
+
+// Returns the items from the previous snapshot, or none if there isn't one.
+func readItemSnapshot() []ItemSnapshot {
+	if _, err := os.Stat(itemSnapshotPath); err != nil {
+		return nil
+	}
+	snapshotData := readCsvFile(itemSnapshotPath)
+
+	// Ignore first line
+	snapshotData = snapshotData[1:]
+
+	snapshot := make([]ItemSnapshot, len(snapshotData))
+	for i, row := range snapshotData {
+		item := ItemSnapshot{
+			Name:        row[1],
+			Stats:       snapshotStatsFromString(row[6]),
+			SocketBonus: snapshotStatsFromString(row[7]),
+			HasEffect:   row[8] == "true",
+		}
+		var err error
+		if item.ID, err = strconv.Atoi(row[0]); err != nil {
+			log.Fatal("Invalid item ID: " + row[0])
+		}
+		if item.Phase, err = strconv.Atoi(row[2]); err != nil {
+			log.Fatal("Invalid phase: " + row[2])
+		}
+		quality, ok := proto.ItemQuality_value["ItemQuality"+row[3]]
+		if !ok {
+			log.Fatal("Invalid item quality: " + row[3])
+		}
+		item.Quality = proto.ItemQuality(quality)
+		if item.Ilvl, err = strconv.Atoi(row[4]); err != nil {
+			log.Fatal("Invalid item level: " + row[4])
+		}
+		for _, colorName := range strings.Fields(row[5]) {
+			color, ok := proto.GemColor_value["GemColor"+colorName]
+			if !ok {
+				log.Fatal("Invalid gem color: " + colorName)
+			}
+			item.GemSockets = append(item.GemSockets, proto.GemColor(color))
+		}
+
+		snapshot[i] = item
+	}
+
+	return snapshot
+}
+
+func writeItemSnapshot(snapshot []ItemSnapshot) {
+	file, err := os.Create(itemSnapshotPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	sorted := make([]ItemSnapshot, len(snapshot))
+	copy(sorted, snapshot)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	writer := csv.NewWriter(file)
+	writer.Write([]string{"ID", "Name", "Phase", "Quality", "Ilvl", "GemSockets", "Stats", "SocketBonus", "HasEffect"})
+	for _, item := range sorted {
+		gemSockets := make([]string, len(item.GemSockets))
+		for i, color := range item.GemSockets {
+			gemSockets[i] = strings.TrimPrefix(color.String(), "GemColor")
+		}
+		writer.Write([]string{
+			strconv.Itoa(item.ID),
+			item.Name,
+			strconv.Itoa(item.Phase),
+			strings.TrimPrefix(item.Quality.String(), "ItemQuality"),
+			strconv.Itoa(item.Ilvl),
+			strings.Join(gemSockets, " "),
+			snapshotStatsToString(item.Stats),
+			snapshotStatsToString(item.SocketBonus),
+			strconv.FormatBool(item.HasEffect),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Stats are written as space-separated Name=Value pairs, skipping zeroes.
+func snapshotStatsToString(statlist Stats) string {
+	var pairs []string
+	for stat, value := range statlist {
+		if value != 0 {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", stats.Stat(stat).StatName(), strconv.FormatFloat(value, 'f', -1, 64)))
+		}
+	}
+	return strings.Join(pairs, " ")
+}
+
+func snapshotStatsFromString(statsStr string) Stats {
+	statlist := Stats{}
+	for _, pair := range strings.Fields(statsStr) {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			log.Fatal("Invalid snapshot stat: " + pair)
+		}
+		stat, ok := statFromName(parts[0])
+		if !ok {
+			log.Fatal("Invalid snapshot stat: " + parts[0])
+		}
+		value, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			log.Fatal("Invalid snapshot stat value: " + parts[1])
+		}
+		statlist[stat] = value
+	}
+	return statlist
+}
@@ -19,6 +19,50 @@ type ItemData struct {
 	Response    WowheadItemResponse
 
 	QualityModifier float64
+	Source          ItemSource
+}
+
+func (itemData ItemData) GetPhase() int {
+	if itemData.Declaration.Phase != 0 {
+		return itemData.Declaration.Phase
+	}
+	return itemData.Response.GetPhase()
+}
+
+// Where an item comes from, read from item_sources.csv.
+type ItemSource struct {
+	Zone              string
+	Drop              string
+	Vendor            string
+	BadgeCost         int
+	ReputationFaction string
+	ReputationLevel   proto.RepLevel
+}
+
+// A random suffix, read from random_suffixes.csv.
+type RandomSuffixData struct {
+	ID   int
+	Name string
+
+	// Stats per 10000 random property points.
+	Allocation Stats
+}
+
+// A random suffix item with only its base stats, read from
+// random_suffix_items.csv. Wowhead doesn't have tooltips for these.
+type RandomSuffixItemData struct {
+	ID               int
+	Name             string
+	Type             proto.ItemType
+	ArmorType        proto.ArmorType
+	RangedWeaponType proto.RangedWeaponType
+	Phase            int
+	Quality          proto.ItemQuality
+	Armor            float64
+	RandomPropPoints int
+
+	// IDs of the suffixes this item can have.
+	SuffixOptions []int
 }
 
 type GemDeclaration struct {
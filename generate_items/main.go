@@ -4,17 +4,33 @@ import (
 	"bufio"
 	"encoding/csv"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
 )
 
+const tooltipsDBPath = "./assets/item_data/all_item_tooltips.csv"
+
+// Generation only reads the versioned files in assets/item_data, so it is
+// reproducible and works offline. Use -fetch to refresh the tooltips DB from
+// wowhead, as a separate step, and commit the result with the other data.
 func main() {
 	outDir := flag.String("outDir", "", "Path to output directory for writing generated .go files.")
+	fetch := flag.Bool("fetch", false, "Download tooltips for items missing from the tooltips DB, instead of generating files.")
+	diffReport := flag.String("diffReport", "", "Path to write a report of item changes compared to the previous item snapshot. Prints to stdout if not set.")
 	flag.Parse()
 
+	if *fetch {
+		fetchMissingTooltips()
+		return
+	}
+
 	if *outDir == "" {
 		panic("outDir flag is required!")
 	}
@@ -38,12 +54,14 @@ func main() {
 
 	itemDeclarations := getItemDeclarations()
 	qualityModifiers := getItemQualityModifiers()
+	itemSources := getItemSources()
 	itemsData := make([]ItemData, len(itemDeclarations))
 	for idx, itemDeclaration := range itemDeclarations {
 		itemData := ItemData{
 			Declaration:     itemDeclaration,
 			Response:        getWowheadItemResponse(itemDeclaration.ID, tooltipsDB),
 			QualityModifier: qualityModifiers[itemDeclaration.ID],
+			Source:          itemSources[itemDeclaration.ID],
 		}
 		//fmt.Printf("\n\n%+v\n", itemData.Response)
 		itemsData[idx] = itemData
@@ -51,7 +69,11 @@ func main() {
 	sort.SliceStable(itemsData, func(i, j int) bool {
 		return itemsData[i].Response.Name < itemsData[j].Response.Name
 	})
-	writeItemFile(*outDir, itemsData)
+	writtenItems := writeItemFile(*outDir, itemsData)
+	writeItemDiffReport(*diffReport, writtenItems)
+
+	writeRandomSuffixFile(*outDir, getRandomSuffixes())
+	writeRandomSuffixItemFile(*outDir, getRandomSuffixItems())
 }
 
 func getGemDeclarations() []GemDeclaration {
@@ -144,9 +166,9 @@ func getItemDeclarations() []ItemDeclaration {
 // Returns the prefetched list of all wowhead tooltips.
 // Maps item IDs to tooltip strings.
 func getWowheadTooltipsDB() map[int]string {
-	file, err := os.Open("./assets/item_data/all_item_tooltips.csv")
+	file, err := os.Open(tooltipsDBPath)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatal("Unable to read the tooltips DB, run the generator with -fetch to create it and commit it with the other item data. ", err)
 	}
 	defer file.Close()
 
@@ -175,6 +197,50 @@ func getWowheadTooltipsDB() map[int]string {
 	return db
 }
 
+// Adds tooltips for all gem and item IDs which are missing from the tooltips
+// DB, and rewrites the DB sorted by ID.
+func fetchMissingTooltips() {
+	db := map[int]string{}
+	if _, err := os.Stat(tooltipsDBPath); err == nil {
+		db = getWowheadTooltipsDB()
+	}
+
+	var ids []int
+	for _, gemDeclaration := range getGemDeclarations() {
+		ids = append(ids, gemDeclaration.ID)
+	}
+	for _, itemDeclaration := range getItemDeclarations() {
+		ids = append(ids, itemDeclaration.ID)
+	}
+	for _, id := range ids {
+		if _, ok := db[id]; !ok {
+			log.Printf("Fetching tooltip for item %d\n", id)
+			db[id] = fetchWowheadTooltip(id)
+		}
+	}
+
+	sortedIDs := make([]int, 0, len(db))
+	for id := range db {
+		sortedIDs = append(sortedIDs, id)
+	}
+	sort.Ints(sortedIDs)
+
+	file, err := os.Create(tooltipsDBPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	writer.WriteString("ID,Tooltip\n")
+	for _, id := range sortedIDs {
+		writer.WriteString(fmt.Sprintf("%d,%s\n", id, db[id]))
+	}
+	if err := writer.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
+
 func getItemQualityModifiers() map[int]float64 {
 	file, err := os.Open("./assets/item_data/quality_modifiers.csv")
 	if err != nil {
@@ -212,6 +278,168 @@ func getItemQualityModifiers() map[int]float64 {
 	return qualityMods
 }
 
+func getRandomSuffixes() []RandomSuffixData {
+	suffixesData := readCsvFile("./assets/item_data/random_suffixes.csv")
+
+	// Ignore first line
+	suffixesData = suffixesData[1:]
+
+	suffixes := make([]RandomSuffixData, len(suffixesData))
+	for i, row := range suffixesData {
+		suffixID, err := strconv.Atoi(row[0])
+		if err != nil {
+			log.Fatal("Invalid random suffix ID: " + row[0])
+		}
+		suffix := RandomSuffixData{
+			ID:   suffixID,
+			Name: row[1],
+		}
+
+		// The rest of the row is pairs of stat name and allocation.
+		for col := 2; col+1 < len(row); col += 2 {
+			if row[col] == "" {
+				continue
+			}
+			stat, ok := statFromName(row[col])
+			if !ok {
+				log.Fatal("Invalid stat for random suffix: " + row[col])
+			}
+			allocation, err := strconv.ParseFloat(row[col+1], 64)
+			if err != nil {
+				log.Fatal("Invalid random suffix allocation: " + row[col+1])
+			}
+			suffix.Allocation[stat] = allocation
+		}
+
+		suffixes[i] = suffix
+	}
+
+	return suffixes
+}
+
+func getRandomSuffixItems() []RandomSuffixItemData {
+	itemsData := readCsvFile("./assets/item_data/random_suffix_items.csv")
+
+	// Ignore first line
+	itemsData = itemsData[1:]
+
+	items := make([]RandomSuffixItemData, len(itemsData))
+	for i, row := range itemsData {
+		itemID, err := strconv.Atoi(row[0])
+		if err != nil {
+			log.Fatal("Invalid item ID: " + row[0])
+		}
+		item := RandomSuffixItemData{
+			ID:   itemID,
+			Name: row[1],
+		}
+
+		itemType, ok := proto.ItemType_value["ItemType"+row[2]]
+		if !ok {
+			log.Fatal("Invalid item type: " + row[2])
+		}
+		item.Type = proto.ItemType(itemType)
+		if row[3] != "" {
+			armorType, ok := proto.ArmorType_value["ArmorType"+row[3]]
+			if !ok {
+				log.Fatal("Invalid armor type: " + row[3])
+			}
+			item.ArmorType = proto.ArmorType(armorType)
+		}
+		if row[4] != "" {
+			rangedWeaponType, ok := proto.RangedWeaponType_value["RangedWeaponType"+row[4]]
+			if !ok {
+				log.Fatal("Invalid ranged weapon type: " + row[4])
+			}
+			item.RangedWeaponType = proto.RangedWeaponType(rangedWeaponType)
+		}
+		item.Phase, err = strconv.Atoi(row[5])
+		if err != nil {
+			log.Fatal("Invalid phase: " + row[5])
+		}
+		quality, ok := proto.ItemQuality_value["ItemQuality"+row[6]]
+		if !ok {
+			log.Fatal("Invalid item quality: " + row[6])
+		}
+		item.Quality = proto.ItemQuality(quality)
+		if row[7] != "" {
+			item.Armor, err = strconv.ParseFloat(row[7], 64)
+			if err != nil {
+				log.Fatal("Invalid armor: " + row[7])
+			}
+		}
+		item.RandomPropPoints, err = strconv.Atoi(row[8])
+		if err != nil {
+			log.Fatal("Invalid random property points: " + row[8])
+		}
+
+		// Suffix IDs are separated by spaces.
+		for _, suffixStr := range strings.Fields(row[9]) {
+			suffixID, err := strconv.Atoi(suffixStr)
+			if err != nil {
+				log.Fatal("Invalid random suffix ID: " + suffixStr)
+			}
+			item.SuffixOptions = append(item.SuffixOptions, suffixID)
+		}
+		if len(item.SuffixOptions) == 0 {
+			log.Fatal("No random suffixes for item: " + row[0])
+		}
+
+		items[i] = item
+	}
+
+	return items
+}
+
+func statFromName(name string) (stats.Stat, bool) {
+	for stat := stats.Stat(0); stat < stats.Len; stat++ {
+		if stat.StatName() == name {
+			return stat, true
+		}
+	}
+	return 0, false
+}
+
+// Returns the zone, boss, vendor and requirements for each item which has them.
+func getItemSources() map[int]ItemSource {
+	sourcesData := readCsvFile("./assets/item_data/item_sources.csv")
+
+	// Ignore first line
+	sourcesData = sourcesData[1:]
+
+	sources := make(map[int]ItemSource)
+	for _, row := range sourcesData {
+		itemID, err := strconv.Atoi(row[0])
+		if err != nil {
+			log.Fatal("Invalid item ID: " + row[0])
+		}
+
+		source := ItemSource{
+			Zone:              row[2],
+			Drop:              row[3],
+			Vendor:            row[4],
+			ReputationFaction: row[6],
+		}
+		if row[5] != "" {
+			source.BadgeCost, err = strconv.Atoi(row[5])
+			if err != nil {
+				log.Fatal("Invalid badge cost: " + row[5])
+			}
+		}
+		if row[7] != "" {
+			repLevel, ok := proto.RepLevel_value["RepLevel"+row[7]]
+			if !ok {
+				log.Fatal("Invalid reputation level: " + row[7])
+			}
+			source.ReputationLevel = proto.RepLevel(repLevel)
+		}
+
+		sources[itemID] = source
+	}
+
+	return sources
+}
+
 func readCsvFile(filePath string) [][]string {
 	f, err := os.Open(filePath)
 	if err != nil {
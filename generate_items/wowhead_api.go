@@ -277,6 +277,27 @@ func (item WowheadItemResponse) GetUnique() bool {
 	return uniqueRegex.MatchString(item.Tooltip)
 }
 
+// Tooltip lines for effects which aren't plain stats, so the sim needs a
+// registered item effect to model them. Set bonuses are ignored, since those
+// are registered separately.
+var itemEffectPatterns = []*regexp.Regexp{
+	regexp.MustCompile("Use: "),
+	regexp.MustCompile("Chance on hit: "),
+	regexp.MustCompile("(?i)Equip: [^<]*(chance|each time|whenever)"),
+}
+
+func (item WowheadItemResponse) HasEffect() bool {
+	tooltip := item.TooltipWithoutSetBonus()
+	for _, pattern := range itemEffectPatterns {
+		if pattern.MatchString(tooltip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Pattern maps are checked in enum order rather than map order, so that the
+// generated output is deterministic when more than one pattern matches.
 var itemTypePatterns = map[proto.ItemType]*regexp.Regexp{
 	proto.ItemType_ItemTypeHead:     regexp.MustCompile("<td>Head</td>"),
 	proto.ItemType_ItemTypeNeck:     regexp.MustCompile("<td>Neck</td>"),
@@ -295,8 +316,8 @@ var itemTypePatterns = map[proto.ItemType]*regexp.Regexp{
 }
 
 func (item WowheadItemResponse) GetItemType() proto.ItemType {
-	for itemType, pattern := range itemTypePatterns {
-		if pattern.MatchString(item.Tooltip) {
+	for itemType := proto.ItemType(0); int(itemType) < len(proto.ItemType_name); itemType++ {
+		if pattern, ok := itemTypePatterns[itemType]; ok && pattern.MatchString(item.Tooltip) {
 			return itemType
 		}
 	}
@@ -311,8 +332,8 @@ var armorTypePatterns = map[proto.ArmorType]*regexp.Regexp{
 }
 
 func (item WowheadItemResponse) GetArmorType() proto.ArmorType {
-	for armorType, pattern := range armorTypePatterns {
-		if pattern.MatchString(item.Tooltip) {
+	for armorType := proto.ArmorType(0); int(armorType) < len(proto.ArmorType_name); armorType++ {
+		if pattern, ok := armorTypePatterns[armorType]; ok && pattern.MatchString(item.Tooltip) {
 			return armorType
 		}
 	}
@@ -332,8 +353,8 @@ var weaponTypePatterns = map[proto.WeaponType]*regexp.Regexp{
 }
 
 func (item WowheadItemResponse) GetWeaponType() proto.WeaponType {
-	for weaponType, pattern := range weaponTypePatterns {
-		if pattern.MatchString(item.Tooltip) {
+	for weaponType := proto.WeaponType(0); int(weaponType) < len(proto.WeaponType_name); weaponType++ {
+		if pattern, ok := weaponTypePatterns[weaponType]; ok && pattern.MatchString(item.Tooltip) {
 			return weaponType
 		}
 	}
@@ -348,8 +369,8 @@ var handTypePatterns = map[proto.HandType]*regexp.Regexp{
 }
 
 func (item WowheadItemResponse) GetHandType() proto.HandType {
-	for handType, pattern := range handTypePatterns {
-		if pattern.MatchString(item.Tooltip) {
+	for handType := proto.HandType(0); int(handType) < len(proto.HandType_name); handType++ {
+		if pattern, ok := handTypePatterns[handType]; ok && pattern.MatchString(item.Tooltip) {
 			return handType
 		}
 	}
@@ -368,8 +389,8 @@ var rangedWeaponTypePatterns = map[proto.RangedWeaponType]*regexp.Regexp{
 }
 
 func (item WowheadItemResponse) GetRangedWeaponType() proto.RangedWeaponType {
-	for rangedWeaponType, pattern := range rangedWeaponTypePatterns {
-		if pattern.MatchString(item.Tooltip) {
+	for rangedWeaponType := proto.RangedWeaponType(0); int(rangedWeaponType) < len(proto.RangedWeaponType_name); rangedWeaponType++ {
+		if pattern, ok := rangedWeaponTypePatterns[rangedWeaponType]; ok && pattern.MatchString(item.Tooltip) {
 			return rangedWeaponType
 		}
 	}
@@ -500,8 +521,8 @@ var gemSocketColorPatterns = map[proto.GemColor]*regexp.Regexp{
 }
 
 func (item WowheadItemResponse) GetSocketColor() proto.GemColor {
-	for socketColor, pattern := range gemSocketColorPatterns {
-		if pattern.MatchString(item.Tooltip) {
+	for socketColor := proto.GemColor(0); int(socketColor) < len(proto.GemColor_name); socketColor++ {
+		if pattern, ok := gemSocketColorPatterns[socketColor]; ok && pattern.MatchString(item.Tooltip) {
 			return socketColor
 		}
 	}
@@ -595,44 +616,44 @@ func (item WowheadItemResponse) GetItemSetName() string {
 }
 
 func getWowheadItemResponse(itemID int, tooltipsDB map[int]string) WowheadItemResponse {
-	// If the db already has it, just return the db value.
-	var tooltipBytes []byte
+	tooltipStr, ok := tooltipsDB[itemID]
+	if !ok {
+		log.Fatalf("Item DB missing ID: %d. Run the generator with -fetch to update the tooltips DB.", itemID)
+	}
 
-	if tooltipStr, ok := tooltipsDB[itemID]; ok {
-		tooltipBytes = []byte(tooltipStr)
-	} else {
-		fmt.Printf("Item DB missing ID: %d\n", itemID)
-		url := fmt.Sprintf("https://tbc.wowhead.com/tooltip/item/%d", itemID)
+	itemResponse := WowheadItemResponse{}
+	err := json.Unmarshal([]byte(tooltipStr), &itemResponse)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		httpClient := http.Client{
-			Timeout: 5 * time.Second,
-		}
+	return itemResponse
+}
 
-		request, err := http.NewRequest(http.MethodGet, url, nil)
-		if err != nil {
-			log.Fatal(err)
-		}
+// Downloads the tooltip JSON for an item from wowhead.
+func fetchWowheadTooltip(itemID int) string {
+	url := fmt.Sprintf("https://tbc.wowhead.com/tooltip/item/%d", itemID)
 
-		result, err := httpClient.Do(request)
-		if err != nil {
-			log.Fatal(err)
-		}
+	httpClient := http.Client{
+		Timeout: 5 * time.Second,
+	}
 
-		defer result.Body.Close()
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		resultBody, err := ioutil.ReadAll(result.Body)
-		if err != nil {
-			log.Fatal(err)
-		}
-		tooltipBytes = resultBody
+	result, err := httpClient.Do(request)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	//fmt.Printf(string(tooltipStr))
-	itemResponse := WowheadItemResponse{}
-	err := json.Unmarshal(tooltipBytes, &itemResponse)
+	defer result.Body.Close()
+
+	resultBody, err := ioutil.ReadAll(result.Body)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	return itemResponse
+	return string(resultBody)
 }
@@ -64,7 +64,8 @@ var Gems = []Gem{
 	file.Sync()
 }
 
-func writeItemFile(outDir string, itemsData []ItemData) {
+// Returns the items which were written.
+func writeItemFile(outDir string, itemsData []ItemData) []ItemData {
 	err := os.MkdirAll(outDir, os.ModePerm)
 	if err != nil {
 		panic(err)
@@ -88,6 +89,7 @@ import (
 var Items = []Item{
 `)
 
+	var written []ItemData
 	for _, itemData := range itemsData {
 		itemLevel := itemData.Response.GetItemLevel()
 		if itemData.Declaration.Filter {
@@ -126,6 +128,75 @@ var Items = []Item{
 		}
 
 		file.WriteString(fmt.Sprintf("\t%s,\n", itemToGoString(itemData)))
+		written = append(written, itemData)
+	}
+
+	file.WriteString("}\n")
+
+	file.Sync()
+	return written
+}
+
+func writeRandomSuffixFile(outDir string, suffixesData []RandomSuffixData) {
+	err := os.MkdirAll(outDir, os.ModePerm)
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := os.Create(fmt.Sprintf("%s/all_random_suffixes.go", outDir))
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	file.WriteString(`// DO NOT EDIT. This file is auto-generated by the item generator tool. Use that to make edits.
+	
+package items
+	
+import (
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+var RandomSuffixes = []RandomSuffix{
+`)
+
+	for _, suffixData := range suffixesData {
+		file.WriteString(fmt.Sprintf("\t{ID:%d, Name:\"%s\", Allocation: %s},\n", suffixData.ID, suffixData.Name, statsToGoString(suffixData.Allocation, Stats{})))
+	}
+
+	file.WriteString("}\n")
+
+	file.Sync()
+}
+
+func writeRandomSuffixItemFile(outDir string, itemsData []RandomSuffixItemData) {
+	err := os.MkdirAll(outDir, os.ModePerm)
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := os.Create(fmt.Sprintf("%s/all_random_suffix_items.go", outDir))
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	file.WriteString(`// DO NOT EDIT. This file is auto-generated by the item generator tool. Use that to make edits.
+	
+package items
+	
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Random suffix items, with only their base stats. Stats from the suffix are
+// added when an ItemSpec picks one of the RandomSuffixOptions.
+var RandomSuffixItems = []Item{
+`)
+
+	for _, itemData := range itemsData {
+		file.WriteString(fmt.Sprintf("\t%s,\n", randomSuffixItemToGoString(itemData)))
 	}
 
 	file.WriteString("}\n")
@@ -210,11 +281,7 @@ func itemToGoString(itemData ItemData) string {
 		itemStr += fmt.Sprintf("SwingSpeed: %0.2f, ", speed)
 	}
 
-	phase := itemData.Declaration.Phase
-	if phase == 0 {
-		phase = itemData.Response.GetPhase()
-	}
-	itemStr += fmt.Sprintf("Phase:%d, ", phase)
+	itemStr += fmt.Sprintf("Phase:%d, ", itemData.GetPhase())
 	itemStr += fmt.Sprintf("Quality:proto.ItemQuality_%s, ", proto.ItemQuality(itemData.Response.Quality).String())
 
 	if itemData.Response.GetUnique() {
@@ -245,20 +312,85 @@ func itemToGoString(itemData ItemData) string {
 		itemStr += fmt.Sprintf(", SetName: \"%s\"", setName)
 	}
 
+	if itemData.Response.HasEffect() {
+		itemStr += ", HasEffect: true"
+	}
+
+	source := itemData.Source
+	if source.Zone != "" {
+		itemStr += fmt.Sprintf(", SourceZone: \"%s\"", source.Zone)
+	}
+	if source.Drop != "" {
+		itemStr += fmt.Sprintf(", SourceDrop: \"%s\"", source.Drop)
+	}
+	if source.Vendor != "" {
+		itemStr += fmt.Sprintf(", SourceVendor: \"%s\"", source.Vendor)
+	}
+	if source.BadgeCost != 0 {
+		itemStr += fmt.Sprintf(", BadgeCost: %d", source.BadgeCost)
+	}
+	if source.ReputationFaction != "" {
+		itemStr += fmt.Sprintf(", ReputationFaction: \"%s\"", source.ReputationFaction)
+		itemStr += fmt.Sprintf(", ReputationLevel: proto.RepLevel_%s", source.ReputationLevel.String())
+	}
+
 	itemStr += "}"
 	return itemStr
 }
 
-func statsToGoString(statlist Stats, overrides Stats) string {
-	statsStr := "stats.Stats{"
+func randomSuffixItemToGoString(itemData RandomSuffixItemData) string {
+	itemStr := "{"
+
+	itemStr += fmt.Sprintf("Name:\"%s\", ", strings.ReplaceAll(itemData.Name, "\"", "\\\""))
+	itemStr += fmt.Sprintf("ID:%d, ", itemData.ID)
+	itemStr += fmt.Sprintf("Type:proto.ItemType_%s, ", itemData.Type.String())
+	if itemData.ArmorType != proto.ArmorType_ArmorTypeUnknown {
+		itemStr += fmt.Sprintf("ArmorType:proto.ArmorType_%s, ", itemData.ArmorType.String())
+	}
+	if itemData.RangedWeaponType != proto.RangedWeaponType_RangedWeaponTypeUnknown {
+		itemStr += fmt.Sprintf("RangedWeaponType:proto.RangedWeaponType_%s, ", itemData.RangedWeaponType.String())
+	}
+	itemStr += fmt.Sprintf("Phase:%d, ", itemData.Phase)
+	itemStr += fmt.Sprintf("Quality:proto.ItemQuality_%s, ", itemData.Quality.String())
+
+	if itemData.Armor != 0 {
+		baseStats := Stats{}
+		baseStats[proto.Stat_StatArmor] = itemData.Armor
+		itemStr += fmt.Sprintf("Stats: %s, ", statsToGoString(baseStats, Stats{}))
+	}
+
+	itemStr += fmt.Sprintf("RandomPropPoints:%d, ", itemData.RandomPropPoints)
+
+	itemStr += "RandomSuffixOptions: []int32{"
+	for _, suffixID := range itemData.SuffixOptions {
+		itemStr += fmt.Sprintf("%d,", suffixID)
+	}
+	itemStr += "}"
+
+	itemStr += "}"
+	return itemStr
+}
 
+// Applies overrides to stats which are present in statlist.
+func mergeStats(statlist Stats, overrides Stats) Stats {
+	merged := Stats{}
 	for stat, value := range statlist {
-		val := value
-		if overrides[stat] > 0 {
-			val = overrides[stat]
+		if value > 0 {
+			merged[stat] = value
+			if overrides[stat] > 0 {
+				merged[stat] = overrides[stat]
+			}
 		}
+	}
+	return merged
+}
+
+func statsToGoString(statlist Stats, overrides Stats) string {
+	statsStr := "stats.Stats{"
+
+	for stat, value := range mergeStats(statlist, overrides) {
 		if value > 0 {
-			statsStr += fmt.Sprintf("stats.%s:%.0f,", stats.Stat(stat).StatName(), val)
+			statsStr += fmt.Sprintf("stats.%s:%.0f,", stats.Stat(stat).StatName(), value)
 		}
 	}
 
@@ -0,0 +1,164 @@
+// Command-line runner for sim requests, so sims can be run without starting
+// the web server.
+//
+// Example:
+//
+//	go run ./sim/cli -input=request.json -output=result.json -iterations=10000
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/wowsims/tbc/sim"
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func init() {
+	sim.RegisterAll()
+}
+
+type cliOptions struct {
+	requestType string
+	iterations  int
+	seed        int64
+	debugLog    string
+}
+
+func main() {
+	var input = flag.String("input", "-", "Path to the request file, as protojson or binary proto. Use '-' for stdin.")
+	var output = flag.String("output", "-", "Path to write the JSON result to. Use '-' for stdout.")
+	var requestType = flag.String("type", "raidSim", "Type of request in the input file: raidSim, statWeights, computeStats or gearOptimize.")
+	var iterations = flag.Int("iterations", 0, "If set, overrides the number of iterations in the request.")
+	var seed = flag.Int64("seed", 0, "If set, overrides the random seed in the request.")
+	var debugLog = flag.String("debugLog", "", "If set, writes the debug log for the first iteration of a raidSim to this file.")
+	flag.Parse()
+
+	inputBytes, err := readInput(*input)
+	if err != nil {
+		log.Fatalf("Failed to read input: %s", err.Error())
+	}
+
+	result, err := runRequest(inputBytes, cliOptions{
+		requestType: *requestType,
+		iterations:  *iterations,
+		seed:        *seed,
+		debugLog:    *debugLog,
+	})
+	if err != nil {
+		log.Fatalf("%s", err.Error())
+	}
+
+	if *output == "-" {
+		_, err = os.Stdout.Write(result)
+	} else {
+		err = ioutil.WriteFile(*output, result, 0644)
+	}
+	if err != nil {
+		log.Fatalf("Failed to write result: %s", err.Error())
+	}
+}
+
+func readInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// Runs the request in input and returns the result as JSON, or an error if
+// the request was invalid.
+func runRequest(input []byte, options cliOptions) ([]byte, error) {
+	var result googleProto.Message
+	switch options.requestType {
+	case "raidSim":
+		rsr := &proto.RaidSimRequest{}
+		if err := unmarshalRequest(input, rsr); err != nil {
+			return nil, err
+		}
+		if rsr.SimOptions == nil {
+			rsr.SimOptions = &proto.SimOptions{}
+		}
+		applySimOptions(rsr.SimOptions, options)
+		if options.debugLog != "" {
+			rsr.SimOptions.DebugFirstIteration = true
+		}
+
+		raidSimResult := core.RunRaidSim(rsr)
+		if raidSimResult.ErrorResult != "" {
+			return nil, fmt.Errorf("raid sim failed: %s", raidSimResult.ErrorResult)
+		}
+		if options.debugLog != "" {
+			if err := ioutil.WriteFile(options.debugLog, []byte(raidSimResult.Logs), 0644); err != nil {
+				return nil, fmt.Errorf("failed to write debug log: %s", err.Error())
+			}
+			raidSimResult.Logs = ""
+		}
+		result = raidSimResult
+	case "statWeights":
+		swr := &proto.StatWeightsRequest{}
+		if err := unmarshalRequest(input, swr); err != nil {
+			return nil, err
+		}
+		if swr.SimOptions == nil {
+			swr.SimOptions = &proto.SimOptions{}
+		}
+		applySimOptions(swr.SimOptions, options)
+		result = core.StatWeights(swr)
+	case "computeStats":
+		csr := &proto.ComputeStatsRequest{}
+		if err := unmarshalRequest(input, csr); err != nil {
+			return nil, err
+		}
+		result = core.ComputeStats(csr)
+	case "gearOptimize":
+		gor := &proto.GearOptimizeRequest{}
+		if err := unmarshalRequest(input, gor); err != nil {
+			return nil, err
+		}
+		if gor.SimOptions == nil {
+			gor.SimOptions = &proto.SimOptions{}
+		}
+		applySimOptions(gor.SimOptions, options)
+		gearOptimizeResult := core.OptimizeGear(gor)
+		if gearOptimizeResult.ErrorResult != "" {
+			return nil, fmt.Errorf("gear optimizer failed: %s", gearOptimizeResult.ErrorResult)
+		}
+		result = gearOptimizeResult
+	default:
+		return nil, fmt.Errorf("unknown request type: %s", options.requestType)
+	}
+
+	return protojson.MarshalOptions{Multiline: true}.Marshal(result)
+}
+
+// Requests starting with '{' are parsed as protojson, anything else as binary.
+func unmarshalRequest(input []byte, msg googleProto.Message) error {
+	var err error
+	if trimmed := bytes.TrimSpace(input); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = protojson.Unmarshal(trimmed, msg)
+	} else {
+		err = googleProto.Unmarshal(input, msg)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse request: %s", err.Error())
+	}
+	return nil
+}
+
+func applySimOptions(simOptions *proto.SimOptions, options cliOptions) {
+	if options.iterations > 0 {
+		simOptions.Iterations = int32(options.iterations)
+	}
+	if options.seed != 0 {
+		simOptions.RandomSeed = options.seed
+	}
+}
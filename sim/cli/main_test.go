@@ -0,0 +1,95 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	elementalShaman "github.com/wowsims/tbc/sim/shaman/elemental"
+	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var raidSimRequest = &proto.RaidSimRequest{
+	Raid: core.SinglePlayerRaidProto(
+		&proto.Player{
+			Race:      proto.Race_RaceOrc,
+			Class:     proto.Class_ClassShaman,
+			Equipment: elementalShaman.P1Gear,
+			Consumes:  elementalShaman.FullConsumes,
+			Spec:      elementalShaman.PlayerOptionsAdaptive,
+			Buffs:     elementalShaman.FullIndividualBuffs,
+		},
+		&proto.PartyBuffs{},
+		&proto.RaidBuffs{},
+		&proto.Debuffs{}),
+	Encounter: &proto.Encounter{
+		Duration: 60,
+		Targets: []*proto.Target{
+			core.NewDefaultTarget(),
+		},
+	},
+	SimOptions: &proto.SimOptions{
+		Iterations: 1000,
+		IsTest:     true,
+	},
+}
+
+func TestRaidSimJSON(t *testing.T) {
+	input, err := protojson.Marshal(raidSimRequest)
+	if err != nil {
+		t.Fatalf("Failed to encode request: %s", err.Error())
+	}
+	debugLog := filepath.Join(t.TempDir(), "debug.log")
+
+	output, err := runRequest(input, cliOptions{requestType: "raidSim", iterations: 10, debugLog: debugLog})
+	if err != nil {
+		t.Fatalf("Failed to run request: %s", err.Error())
+	}
+
+	result := &proto.RaidSimResult{}
+	if err := protojson.Unmarshal(output, result); err != nil {
+		t.Fatalf("Failed to parse result: %s", err.Error())
+	}
+	if result.RaidMetrics.Dps.Avg <= 0 {
+		t.Fatalf("Expected positive DPS in result")
+	}
+
+	logs, err := os.ReadFile(debugLog)
+	if err != nil || len(logs) == 0 {
+		t.Fatalf("Expected debug log to be written to %s", debugLog)
+	}
+}
+
+func TestComputeStatsBinary(t *testing.T) {
+	input, err := googleProto.Marshal(&proto.ComputeStatsRequest{Raid: raidSimRequest.Raid})
+	if err != nil {
+		t.Fatalf("Failed to encode request: %s", err.Error())
+	}
+
+	output, err := runRequest(input, cliOptions{requestType: "computeStats"})
+	if err != nil {
+		t.Fatalf("Failed to run request: %s", err.Error())
+	}
+
+	result := &proto.ComputeStatsResult{}
+	if err := protojson.Unmarshal(output, result); err != nil {
+		t.Fatalf("Failed to parse result: %s", err.Error())
+	}
+	if len(result.RaidStats.Parties) == 0 {
+		t.Fatalf("Expected raid stats in result")
+	}
+}
+
+func TestRaidSimErrorResult(t *testing.T) {
+	input, err := protojson.Marshal(&proto.RaidSimRequest{Raid: raidSimRequest.Raid})
+	if err != nil {
+		t.Fatalf("Failed to encode request: %s", err.Error())
+	}
+
+	if _, err := runRequest(input, cliOptions{requestType: "raidSim"}); err == nil {
+		t.Fatalf("Expected an error for a request without an encounter")
+	}
+}
@@ -0,0 +1,568 @@
+// Parser for WoW 2.4 combat logs (WoWCombatLog.txt), which extracts the
+// results for a single player in the same format as sim results, so the two
+// can be compared action by action.
+//
+// Example line:
+//
+//	5/14 20:31:27.345  SPELL_DAMAGE,0x0000000000A1B2C3,"Player",0x514,0xF130004A4E0003B2,"Target",0xa48,25449,"Lightning Bolt",0x8,1234,8,0,0,0,1,nil,nil
+package combatlog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+type Options struct {
+	// Name of the player whose results should be extracted.
+	PlayerName string
+
+	// Names of the player's pets, whose results are added to the player's pet
+	// metrics. 2.4 logs don't record pet owners, so these need to be provided.
+	PetNames []string
+
+	// Overrides for the ActionID used for a spell ID. By default every spell
+	// uses its own spell ID, but the sim uses item IDs for some item effects.
+	ActionIDs map[int32]core.ActionID
+}
+
+// Parses the combat log file at path. See Parse().
+func ParseFile(path string, options Options) (*proto.UnitMetrics, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file, options)
+}
+
+// Parses a combat log and returns the metrics for options.PlayerName.
+//
+// The fight duration is the time between the first and last events caused
+// by the player or their pets, and is used for DPS and for closing auras
+// which are still active at the end of the log.
+func Parse(reader io.Reader, options Options) (*proto.UnitMetrics, error) {
+	if options.PlayerName == "" {
+		return nil, fmt.Errorf("no player name provided")
+	}
+
+	parser := &logParser{
+		actionIDs: options.ActionIDs,
+		units:     make(map[string]*unitLog),
+	}
+	player := parser.addUnit(options.PlayerName)
+	var pets []*unitLog
+	for _, petName := range options.PetNames {
+		pets = append(pets, parser.addUnit(petName))
+	}
+
+	scanner := bufio.NewScanner(reader)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		event, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNum, err.Error())
+		}
+		if err := parser.handleEvent(event); err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNum, err.Error())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !parser.foundEvents {
+		return nil, fmt.Errorf("no events found for %s", options.PlayerName)
+	}
+
+	metrics := player.toProto(parser.startTime, parser.endTime)
+	for _, pet := range pets {
+		petMetrics := pet.toProto(parser.startTime, parser.endTime)
+		metrics.Pets = append(metrics.Pets, petMetrics)
+
+		// Like in the sim, player DPS includes their pets.
+		player.damage += pet.damage
+	}
+	metrics.Dps = singleDistribution(player.damage, parser.endTime-parser.startTime)
+	return metrics, nil
+}
+
+// A single parsed line of the combat log.
+type logEvent struct {
+	timestamp time.Duration
+	eventType string
+
+	sourceGUID string
+	sourceName string
+	destGUID   string
+	destName   string
+
+	// Event-specific parameters, after the source and destination fields.
+	params []string
+}
+
+// Timestamps don't include a year, so times are relative to the start of
+// the year. This is only a problem for logs which span New Year's.
+const timestampLayout = "1/2 15:04:05.000"
+
+func parseLine(line string) (logEvent, error) {
+	splitIdx := strings.Index(line, "  ")
+	if splitIdx == -1 {
+		return logEvent{}, fmt.Errorf("missing timestamp")
+	}
+
+	timestamp, err := time.Parse(timestampLayout, line[:splitIdx])
+	if err != nil {
+		return logEvent{}, fmt.Errorf("invalid timestamp: %s", err.Error())
+	}
+
+	fields := splitFields(strings.TrimSpace(line[splitIdx:]))
+	if len(fields) < 7 {
+		return logEvent{}, fmt.Errorf("expected at least 7 fields but found %d", len(fields))
+	}
+
+	return logEvent{
+		timestamp:  timestamp.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)),
+		eventType:  fields[0],
+		sourceGUID: fields[1],
+		sourceName: fields[2],
+		destGUID:   fields[4],
+		destName:   fields[5],
+		params:     fields[7:],
+	}, nil
+}
+
+// Splits a comma-separated list of fields, where fields may be quoted.
+func splitFields(s string) []string {
+	var fields []string
+	var field strings.Builder
+	inQuotes := false
+	for _, c := range s {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == ',' && !inQuotes:
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteRune(c)
+		}
+	}
+	return append(fields, field.String())
+}
+
+// Spell info from the prefix parameters of SPELL_*, RANGE_* and similar events.
+type spellInfo struct {
+	id     int32
+	school int64
+}
+
+func parseSpellInfo(params []string) (spellInfo, []string, error) {
+	if len(params) < 3 {
+		return spellInfo{}, nil, fmt.Errorf("missing spell parameters")
+	}
+	id, err := strconv.ParseInt(params[0], 10, 32)
+	if err != nil {
+		return spellInfo{}, nil, fmt.Errorf("invalid spell ID %s", params[0])
+	}
+	school, err := strconv.ParseInt(strings.TrimPrefix(params[2], "0x"), 16, 64)
+	if err != nil {
+		return spellInfo{}, nil, fmt.Errorf("invalid spell school %s", params[2])
+	}
+	return spellInfo{id: int32(id), school: school}, params[3:], nil
+}
+
+type logParser struct {
+	actionIDs map[int32]core.ActionID
+
+	// Units being tracked, by name.
+	units map[string]*unitLog
+
+	foundEvents bool
+	startTime   time.Duration
+	endTime     time.Duration
+}
+
+func (parser *logParser) addUnit(name string) *unitLog {
+	unit := newUnitLog(name)
+	parser.units[name] = unit
+	return unit
+}
+
+func (parser *logParser) actionID(spellID int32) core.ActionID {
+	if actionID, ok := parser.actionIDs[spellID]; ok {
+		return actionID
+	}
+	return core.ActionID{SpellID: spellID}
+}
+
+func (parser *logParser) handleEvent(event logEvent) error {
+	source := parser.units[event.sourceName]
+	dest := parser.units[event.destName]
+	if source == nil && dest == nil {
+		return nil
+	}
+
+	if source != nil {
+		if !parser.foundEvents {
+			parser.startTime = event.timestamp
+			parser.foundEvents = true
+		}
+		parser.endTime = event.timestamp
+	}
+
+	eventType := event.eventType
+	if strings.HasPrefix(eventType, "SWING_") {
+		if source == nil {
+			return nil
+		}
+		// Logs don't distinguish between main hand and off hand swings, so all
+		// swings are counted as main hand.
+		action := source.getAction(core.ActionID{OtherID: proto.OtherAction_OtherActionAttack, Tag: 1}, true)
+		return source.handleHit(action, event, strings.TrimPrefix(eventType, "SWING_"), event.params)
+	}
+
+	if !strings.HasPrefix(eventType, "SPELL_") && !strings.HasPrefix(eventType, "RANGE_") &&
+		eventType != "DAMAGE_SHIELD" && eventType != "DAMAGE_SPLIT" {
+		return nil
+	}
+
+	spell, params, err := parseSpellInfo(event.params)
+	if err != nil {
+		return err
+	}
+	actionID := parser.actionID(spell.id)
+
+	isRanged := strings.HasPrefix(eventType, "RANGE_")
+	eventType = strings.TrimPrefix(eventType, "RANGE_")
+	eventType = strings.TrimPrefix(eventType, "SPELL_")
+	eventType = strings.TrimPrefix(eventType, "PERIODIC_")
+	if eventType == "DAMAGE_SHIELD" || eventType == "DAMAGE_SPLIT" {
+		eventType = "DAMAGE"
+	}
+
+	// Auras and resources belong to the unit receiving them.
+	switch eventType {
+	case "AURA_APPLIED":
+		if dest != nil {
+			dest.auraApplied(actionID, event.timestamp)
+		}
+		return nil
+	case "AURA_REMOVED":
+		if dest != nil {
+			windowStart := event.timestamp
+			if parser.foundEvents {
+				windowStart = parser.startTime
+			}
+			dest.auraRemoved(actionID, event.timestamp, windowStart)
+		}
+		return nil
+	case "ENERGIZE":
+		if dest != nil {
+			return dest.handleEnergize(actionID, params)
+		}
+		return nil
+	}
+
+	if source == nil {
+		return nil
+	}
+	action := source.getAction(actionID, isRanged || spell.school == 1)
+
+	switch eventType {
+	case "CAST_SUCCESS":
+		action.target(source.targetIndex(event.destGUID)).Casts++
+		action.hasCasts = true
+	case "DAMAGE", "MISSED":
+		return source.handleHit(action, event, eventType, params)
+	case "HEAL":
+		return source.handleHeal(action, event, params)
+	}
+	return nil
+}
+
+// Results for a single unit.
+type unitLog struct {
+	name string
+
+	actions     map[core.ActionID]*actionLog
+	actionOrder []core.ActionID
+
+	// Indices of targets, in order of first appearance.
+	targets map[string]int
+
+	auras     map[core.ActionID]*auraLog
+	auraOrder []core.ActionID
+
+	resources     map[core.ResourceKey]*proto.ResourceMetrics
+	resourceOrder []core.ResourceKey
+
+	damage  float64
+	healing float64
+}
+
+type actionLog struct {
+	isMelee bool
+	targets []*proto.TargetedActionMetrics
+
+	// Whether this action had any cast events. Actions without them (e.g.
+	// auto attacks and procs) count each hit as a cast instead.
+	hasCasts bool
+}
+
+func (action *actionLog) target(index int) *proto.TargetedActionMetrics {
+	for len(action.targets) <= index {
+		action.targets = append(action.targets, &proto.TargetedActionMetrics{})
+	}
+	return action.targets[index]
+}
+
+type auraLog struct {
+	active    bool
+	seen      bool
+	startTime time.Duration
+	uptime    time.Duration
+}
+
+func newUnitLog(name string) *unitLog {
+	return &unitLog{
+		name:      name,
+		actions:   make(map[core.ActionID]*actionLog),
+		targets:   make(map[string]int),
+		auras:     make(map[core.ActionID]*auraLog),
+		resources: make(map[core.ResourceKey]*proto.ResourceMetrics),
+	}
+}
+
+func (unit *unitLog) getAction(actionID core.ActionID, isMelee bool) *actionLog {
+	action, ok := unit.actions[actionID]
+	if !ok {
+		action = &actionLog{isMelee: isMelee}
+		unit.actions[actionID] = action
+		unit.actionOrder = append(unit.actionOrder, actionID)
+	}
+	return action
+}
+
+// Untargeted actions use the first target, like in the sim.
+func (unit *unitLog) targetIndex(guid string) int {
+	if guid == "" || guid == "0x0000000000000000" {
+		return 0
+	}
+	index, ok := unit.targets[guid]
+	if !ok {
+		index = len(unit.targets)
+		unit.targets[guid] = index
+	}
+	return index
+}
+
+// Handles the suffix of a damage or miss event.
+func (unit *unitLog) handleHit(action *actionLog, event logEvent, suffix string, params []string) error {
+	tam := action.target(unit.targetIndex(event.destGUID))
+
+	switch suffix {
+	case "DAMAGE":
+		// amount, school, resisted, blocked, absorbed, critical, glancing, crushing
+		if len(params) < 8 {
+			return fmt.Errorf("missing damage parameters")
+		}
+		amount, err := strconv.ParseFloat(params[0], 64)
+		if err != nil {
+			return fmt.Errorf("invalid damage amount %s", params[0])
+		}
+		tam.Damage += amount
+		unit.damage += amount
+
+		if params[5] == "1" {
+			tam.Crits++
+		} else if params[6] == "1" {
+			tam.Glances++
+		} else if params[7] == "1" {
+			tam.Crushes++
+		} else if params[3] != "0" && params[3] != "nil" {
+			tam.Blocks++
+		} else {
+			tam.Hits++
+		}
+	case "MISSED":
+		if len(params) < 1 {
+			return fmt.Errorf("missing miss type")
+		}
+		switch params[0] {
+		case "DODGE":
+			tam.Dodges++
+		case "PARRY":
+			tam.Parries++
+		case "BLOCK":
+			tam.Blocks++
+		default:
+			// Includes resists, immunes, etc.
+			tam.Misses++
+		}
+	}
+	return nil
+}
+
+func (unit *unitLog) handleHeal(action *actionLog, event logEvent, params []string) error {
+	// amount, critical
+	if len(params) < 2 {
+		return fmt.Errorf("missing heal parameters")
+	}
+	amount, err := strconv.ParseFloat(params[0], 64)
+	if err != nil {
+		return fmt.Errorf("invalid heal amount %s", params[0])
+	}
+
+	tam := action.target(unit.targetIndex(event.destGUID))
+	tam.Healing += amount
+	unit.healing += amount
+	if params[1] == "1" {
+		tam.Crits++
+	} else {
+		tam.Hits++
+	}
+	return nil
+}
+
+// Power types used in ENERGIZE events.
+var powerTypes = map[string]proto.ResourceType{
+	"0": proto.ResourceType_ResourceTypeMana,
+	"1": proto.ResourceType_ResourceTypeRage,
+	"2": proto.ResourceType_ResourceTypeFocus,
+	"3": proto.ResourceType_ResourceTypeEnergy,
+}
+
+func (unit *unitLog) handleEnergize(actionID core.ActionID, params []string) error {
+	// amount, powerType
+	if len(params) < 2 {
+		return fmt.Errorf("missing energize parameters")
+	}
+	amount, err := strconv.ParseFloat(params[0], 64)
+	if err != nil {
+		return fmt.Errorf("invalid energize amount %s", params[0])
+	}
+	resourceType, ok := powerTypes[params[1]]
+	if !ok {
+		return nil
+	}
+
+	key := core.ResourceKey{ActionID: actionID, Type: resourceType}
+	resource, ok := unit.resources[key]
+	if !ok {
+		resource = &proto.ResourceMetrics{
+			Id:   actionID.ToProto(),
+			Type: resourceType,
+		}
+		unit.resources[key] = resource
+		unit.resourceOrder = append(unit.resourceOrder, key)
+	}
+
+	// Logs don't include the amount lost to the resource cap.
+	resource.Events++
+	resource.Gain += amount
+	resource.ActualGain += amount
+	return nil
+}
+
+func (unit *unitLog) getAura(actionID core.ActionID) *auraLog {
+	aura, ok := unit.auras[actionID]
+	if !ok {
+		aura = &auraLog{}
+		unit.auras[actionID] = aura
+		unit.auraOrder = append(unit.auraOrder, actionID)
+	}
+	return aura
+}
+
+func (unit *unitLog) auraApplied(actionID core.ActionID, timestamp time.Duration) {
+	aura := unit.getAura(actionID)
+	aura.seen = true
+	if !aura.active {
+		aura.active = true
+		aura.startTime = timestamp
+	}
+}
+
+func (unit *unitLog) auraRemoved(actionID core.ActionID, timestamp time.Duration, windowStart time.Duration) {
+	aura := unit.getAura(actionID)
+	if aura.active {
+		aura.active = false
+		aura.uptime += timestamp - aura.startTime
+	} else if !aura.seen {
+		// Auras which are removed without being applied were already active
+		// when the log started.
+		aura.uptime += core.MaxDuration(0, timestamp-windowStart)
+	}
+	aura.seen = true
+}
+
+func (unit *unitLog) toProto(startTime time.Duration, endTime time.Duration) *proto.UnitMetrics {
+	duration := endTime - startTime
+	metrics := &proto.UnitMetrics{
+		Name: unit.name,
+		Dps:  singleDistribution(unit.damage, duration),
+		Hps:  singleDistribution(unit.healing, duration),
+	}
+
+	for _, actionID := range unit.actionOrder {
+		action := unit.actions[actionID]
+		if !action.hasCasts {
+			for _, tam := range action.targets {
+				tam.Casts = tam.Hits + tam.Crits + tam.Crushes + tam.Misses + tam.Dodges + tam.Parries + tam.Blocks + tam.Glances
+			}
+		}
+		metrics.Actions = append(metrics.Actions, &proto.ActionMetrics{
+			Id:      actionID.ToProto(),
+			IsMelee: action.isMelee,
+			Targets: action.targets,
+		})
+	}
+
+	for _, actionID := range unit.auraOrder {
+		aura := unit.auras[actionID]
+		uptime := aura.uptime
+		if aura.active {
+			uptime += core.MaxDuration(0, endTime-core.MaxDuration(aura.startTime, startTime))
+		}
+		metrics.Auras = append(metrics.Auras, &proto.AuraMetrics{
+			Id:               actionID.ToProto(),
+			UptimeSecondsAvg: uptime.Seconds(),
+		})
+	}
+
+	for _, key := range unit.resourceOrder {
+		metrics.Resources = append(metrics.Resources, unit.resources[key])
+	}
+
+	return metrics
+}
+
+// Returns the distribution for a single iteration, as the sim would.
+func singleDistribution(total float64, duration time.Duration) *proto.DistributionMetrics {
+	perSecond := 0.0
+	if duration > 0 {
+		perSecond = total / duration.Seconds()
+	}
+	return &proto.DistributionMetrics{
+		Avg: perSecond,
+		Max: perSecond,
+		Hist: map[int32]int32{
+			int32(math.Round(perSecond/10) * 10): 1,
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package combatlog
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func findAction(metrics *proto.UnitMetrics, actionID core.ActionID) *proto.ActionMetrics {
+	for _, action := range metrics.Actions {
+		if core.ProtoToActionID(*action.Id).SameAction(actionID) {
+			return action
+		}
+	}
+	return nil
+}
+
+func findAuraUptime(metrics *proto.UnitMetrics, actionID core.ActionID) float64 {
+	for _, aura := range metrics.Auras {
+		if core.ProtoToActionID(*aura.Id).SameAction(actionID) {
+			return aura.UptimeSecondsAvg
+		}
+	}
+	return -1
+}
+
+func TestParseFile(t *testing.T) {
+	metrics, err := ParseFile("testdata/elemental_shaman.txt", Options{
+		PlayerName: "Shamy",
+		PetNames:   []string{"Spirit Wolf"},
+		ActionIDs: map[int32]core.ActionID{
+			37661: {ItemID: 28785}, // The Lightning Capacitor
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to parse log: %s", err.Error())
+	}
+
+	// 4400 damage from the player and 300 from the pet over 20s.
+	if math.Abs(metrics.Dps.Avg-235) > 0.001 {
+		t.Fatalf("Expected 235 dps but got %0.3f", metrics.Dps.Avg)
+	}
+
+	lightningBolt := findAction(metrics, core.ActionID{SpellID: 25449})
+	if lightningBolt == nil {
+		t.Fatalf("Missing Lightning Bolt metrics")
+	}
+	lb := lightningBolt.Targets[0]
+	if lb.Casts != 4 || lb.Hits != 1 || lb.Crits != 1 || lb.Misses != 1 || lb.Damage != 3000 {
+		t.Fatalf("Unexpected Lightning Bolt metrics: %v", lb)
+	}
+
+	capacitor := findAction(metrics, core.ActionID{ItemID: 28785})
+	if capacitor == nil || capacitor.Targets[0].Casts != 1 || capacitor.Targets[0].Damage != 800 {
+		t.Fatalf("Expected procs without casts to count hits as casts, got %v", capacitor)
+	}
+
+	flameShock := findAction(metrics, core.ActionID{SpellID: 25457})
+	if flameShock == nil || flameShock.Targets[0].Casts != 1 || flameShock.Targets[0].Hits != 2 || flameShock.Targets[0].Damage != 600 {
+		t.Fatalf("Unexpected Flame Shock metrics: %v", flameShock)
+	}
+
+	if uptime := findAuraUptime(metrics, core.ActionID{SpellID: 2825}); uptime != 10 {
+		t.Fatalf("Expected 10s of Bloodlust uptime but got %0.3f", uptime)
+	}
+	// Active from the start of the log, and again from 7s until the end.
+	if uptime := findAuraUptime(metrics, core.ActionID{SpellID: 33736}); uptime != 19 {
+		t.Fatalf("Expected 19s of Water Shield uptime but got %0.3f", uptime)
+	}
+
+	if len(metrics.Resources) != 1 || metrics.Resources[0].Type != proto.ResourceType_ResourceTypeMana || metrics.Resources[0].Gain != 204 {
+		t.Fatalf("Unexpected resource metrics: %v", metrics.Resources)
+	}
+
+	if len(metrics.Pets) != 1 {
+		t.Fatalf("Expected 1 pet but got %d", len(metrics.Pets))
+	}
+	petMelee := findAction(metrics.Pets[0], core.ActionID{OtherID: proto.OtherAction_OtherActionAttack, Tag: 1})
+	if petMelee == nil || !petMelee.IsMelee || petMelee.Targets[0].Casts != 2 || petMelee.Targets[0].Glances != 1 || petMelee.Targets[0].Dodges != 1 {
+		t.Fatalf("Unexpected pet melee metrics: %v", petMelee)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	if _, err := Parse(strings.NewReader("5/14 20:00:00.000  SPELL_DAMAGE,0x1\n"), Options{PlayerName: "Shamy"}); err == nil {
+		t.Fatalf("Expected an error for a truncated line")
+	}
+
+	if _, err := ParseFile("testdata/elemental_shaman.txt", Options{PlayerName: "Nobody"}); err == nil {
+		t.Fatalf("Expected an error when the player has no events")
+	}
+}
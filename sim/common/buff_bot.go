@@ -0,0 +1,155 @@
+package common
+
+import (
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func RegisterBuffBot() {
+	core.RegisterSpeclessAgentFactory(
+		proto.Player_BuffBot{},
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewBuffBot(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_BuffBot)
+			if !ok {
+				panic("Invalid spec value for Buff Bot!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+// A player which contributes buffs from its position in the raid, but
+// otherwise does nothing.
+type BuffBot struct {
+	core.Character
+
+	raidBuffs       proto.RaidBuffs
+	partyBuffs      proto.PartyBuffs
+	individualBuffs proto.IndividualBuffs
+
+	innervateTarget     proto.RaidTarget
+	powerInfusionTarget proto.RaidTarget
+}
+
+func NewBuffBot(character core.Character, options proto.Player) *BuffBot {
+	botOptions := options.GetBuffBot()
+
+	bot := &BuffBot{
+		Character:           character,
+		innervateTarget:     proto.RaidTarget{TargetIndex: -1},
+		powerInfusionTarget: proto.RaidTarget{TargetIndex: -1},
+	}
+	if botOptions.RaidBuffs != nil {
+		bot.raidBuffs = *botOptions.RaidBuffs
+	}
+	if botOptions.PartyBuffs != nil {
+		bot.partyBuffs = *botOptions.PartyBuffs
+	}
+	if botOptions.IndividualBuffs != nil {
+		bot.individualBuffs = *botOptions.IndividualBuffs
+	}
+	if botOptions.InnervateTarget != nil {
+		bot.innervateTarget = *botOptions.InnervateTarget
+	}
+	if botOptions.PowerInfusionTarget != nil {
+		bot.powerInfusionTarget = *botOptions.PowerInfusionTarget
+	}
+	return bot
+}
+
+func (bot *BuffBot) GetCharacter() *core.Character {
+	return &bot.Character
+}
+
+func (bot *BuffBot) Initialize() {}
+
+func (bot *BuffBot) ApplyTalents() {}
+
+func (bot *BuffBot) Reset(sim *core.Simulation) {}
+
+func (bot *BuffBot) OnGCDReady(sim *core.Simulation) {}
+
+func (bot *BuffBot) AddRaidBuffs(raidBuffs *proto.RaidBuffs) {
+	raidBuffs.ArcaneBrilliance = raidBuffs.ArcaneBrilliance || bot.raidBuffs.ArcaneBrilliance
+	raidBuffs.PowerWordFortitude = core.MaxTristate(raidBuffs.PowerWordFortitude, bot.raidBuffs.PowerWordFortitude)
+	raidBuffs.ShadowProtection = raidBuffs.ShadowProtection || bot.raidBuffs.ShadowProtection
+	raidBuffs.DivineSpirit = core.MaxTristate(raidBuffs.DivineSpirit, bot.raidBuffs.DivineSpirit)
+	raidBuffs.GiftOfTheWild = core.MaxTristate(raidBuffs.GiftOfTheWild, bot.raidBuffs.GiftOfTheWild)
+	raidBuffs.Thorns = core.MaxTristate(raidBuffs.Thorns, bot.raidBuffs.Thorns)
+}
+
+// Counted buffs (e.g. Bloodlust, Mana Tide) stack with those from other party
+// members, everything else uses the strongest version.
+func (bot *BuffBot) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
+	botBuffs := &bot.partyBuffs
+
+	partyBuffs.Bloodlust += botBuffs.Bloodlust
+	partyBuffs.FerociousInspiration += botBuffs.FerociousInspiration
+	partyBuffs.AtieshMage += botBuffs.AtieshMage
+	partyBuffs.AtieshWarlock += botBuffs.AtieshWarlock
+	partyBuffs.ManaTideTotems += botBuffs.ManaTideTotems
+	partyBuffs.TotemOfWrath += botBuffs.TotemOfWrath
+
+	partyBuffs.BloodPact = core.MaxTristate(partyBuffs.BloodPact, botBuffs.BloodPact)
+	partyBuffs.MoonkinAura = core.MaxTristate(partyBuffs.MoonkinAura, botBuffs.MoonkinAura)
+	partyBuffs.LeaderOfThePack = core.MaxTristate(partyBuffs.LeaderOfThePack, botBuffs.LeaderOfThePack)
+	partyBuffs.SanctityAura = core.MaxTristate(partyBuffs.SanctityAura, botBuffs.SanctityAura)
+	partyBuffs.DevotionAura = core.MaxTristate(partyBuffs.DevotionAura, botBuffs.DevotionAura)
+	partyBuffs.RetributionAura = core.MaxTristate(partyBuffs.RetributionAura, botBuffs.RetributionAura)
+	partyBuffs.ManaSpringTotem = core.MaxTristate(partyBuffs.ManaSpringTotem, botBuffs.ManaSpringTotem)
+	partyBuffs.WrathOfAirTotem = core.MaxTristate(partyBuffs.WrathOfAirTotem, botBuffs.WrathOfAirTotem)
+	partyBuffs.GraceOfAirTotem = core.MaxTristate(partyBuffs.GraceOfAirTotem, botBuffs.GraceOfAirTotem)
+	partyBuffs.BattleShout = core.MaxTristate(partyBuffs.BattleShout, botBuffs.BattleShout)
+	partyBuffs.CommandingShout = core.MaxTristate(partyBuffs.CommandingShout, botBuffs.CommandingShout)
+
+	partyBuffs.TrueshotAura = partyBuffs.TrueshotAura || botBuffs.TrueshotAura
+	partyBuffs.DraeneiRacialMelee = partyBuffs.DraeneiRacialMelee || botBuffs.DraeneiRacialMelee
+	partyBuffs.DraeneiRacialCaster = partyBuffs.DraeneiRacialCaster || botBuffs.DraeneiRacialCaster
+	partyBuffs.BraidedEterniumChain = partyBuffs.BraidedEterniumChain || botBuffs.BraidedEterniumChain
+	partyBuffs.EyeOfTheNight = partyBuffs.EyeOfTheNight || botBuffs.EyeOfTheNight
+	partyBuffs.ChainOfTheTwilightOwl = partyBuffs.ChainOfTheTwilightOwl || botBuffs.ChainOfTheTwilightOwl
+	partyBuffs.JadePendantOfBlasting = partyBuffs.JadePendantOfBlasting || botBuffs.JadePendantOfBlasting
+	partyBuffs.SnapshotImprovedWrathOfAirTotem = partyBuffs.SnapshotImprovedWrathOfAirTotem || botBuffs.SnapshotImprovedWrathOfAirTotem
+	partyBuffs.SnapshotImprovedStrengthOfEarthTotem = partyBuffs.SnapshotImprovedStrengthOfEarthTotem || botBuffs.SnapshotImprovedStrengthOfEarthTotem
+	partyBuffs.TranquilAirTotem = partyBuffs.TranquilAirTotem || botBuffs.TranquilAirTotem
+	partyBuffs.BsSolarianSapphire = partyBuffs.BsSolarianSapphire || botBuffs.BsSolarianSapphire
+	partyBuffs.SnapshotBsSolarianSapphire = partyBuffs.SnapshotBsSolarianSapphire || botBuffs.SnapshotBsSolarianSapphire
+	partyBuffs.SnapshotBsT2 = partyBuffs.SnapshotBsT2 || botBuffs.SnapshotBsT2
+
+	if botBuffs.Drums != proto.Drums_DrumsUnknown {
+		partyBuffs.Drums = botBuffs.Drums
+	}
+	if botBuffs.StrengthOfEarthTotem > partyBuffs.StrengthOfEarthTotem {
+		partyBuffs.StrengthOfEarthTotem = botBuffs.StrengthOfEarthTotem
+	}
+	partyBuffs.WindfuryTotemRank = core.MaxInt32(partyBuffs.WindfuryTotemRank, botBuffs.WindfuryTotemRank)
+	partyBuffs.WindfuryTotemIwt = core.MaxInt32(partyBuffs.WindfuryTotemIwt, botBuffs.WindfuryTotemIwt)
+	partyBuffs.SnapshotBsBoomingVoiceRank = core.MaxInt32(partyBuffs.SnapshotBsBoomingVoiceRank, botBuffs.SnapshotBsBoomingVoiceRank)
+}
+
+// Counted buffs (e.g. Innervates) stack with those from other players,
+// everything else uses the strongest version. VT mana only reaches this bot's
+// party, and Innervates and Power Infusions only reach their assigned targets.
+func (bot *BuffBot) AddIndividualBuffs(recipient core.Agent, individualBuffs *proto.IndividualBuffs) {
+	botBuffs := &bot.individualBuffs
+
+	individualBuffs.BlessingOfKings = individualBuffs.BlessingOfKings || botBuffs.BlessingOfKings
+	individualBuffs.BlessingOfSalvation = individualBuffs.BlessingOfSalvation || botBuffs.BlessingOfSalvation
+	individualBuffs.BlessingOfSanctuary = individualBuffs.BlessingOfSanctuary || botBuffs.BlessingOfSanctuary
+	individualBuffs.BlessingOfWisdom = core.MaxTristate(individualBuffs.BlessingOfWisdom, botBuffs.BlessingOfWisdom)
+	individualBuffs.BlessingOfMight = core.MaxTristate(individualBuffs.BlessingOfMight, botBuffs.BlessingOfMight)
+	individualBuffs.UnleashedRage = individualBuffs.UnleashedRage || botBuffs.UnleashedRage
+
+	if recipient.GetCharacter().Party == bot.Party {
+		individualBuffs.ShadowPriestDps = core.MaxInt32(individualBuffs.ShadowPriestDps, botBuffs.ShadowPriestDps)
+	}
+	if bot.Party.Raid.GetPlayerFromRaidTarget(bot.innervateTarget) == recipient {
+		individualBuffs.Innervates += botBuffs.Innervates
+	}
+	if bot.Party.Raid.GetPlayerFromRaidTarget(bot.powerInfusionTarget) == recipient {
+		individualBuffs.PowerInfusions += botBuffs.PowerInfusions
+	}
+}
@@ -45,6 +45,15 @@ type GCDScheduler struct {
 
 	nextAbilityIndex int
 
+	// How late abilities are cast because of the player's latency. The player
+	// reacts late to each ability which waits for a known time, and everything
+	// scheduled after it is delayed with it, so cooldowns planned by the schedule
+	// are still ready.
+	latency time.Duration
+
+	// Whether the reaction time to the next ability has been sampled.
+	reacted bool
+
 	managedMCDIDs []core.ActionID
 	managedMCDs   []*core.MajorCooldown
 }
@@ -263,6 +272,8 @@ func (gs *GCDScheduler) ScheduleMCD(character *core.Character, mcdID core.Action
 
 func (gs *GCDScheduler) Reset(sim *core.Simulation, character *core.Character) {
 	gs.nextAbilityIndex = 0
+	gs.latency = 0
+	gs.reacted = false
 
 	for i, mcdID := range gs.managedMCDIDs {
 		gs.managedMCDs[i] = character.GetMajorCooldown(mcdID)
@@ -272,6 +283,14 @@ func (gs *GCDScheduler) Reset(sim *core.Simulation, character *core.Character) {
 
 // Returns whether the cast was a success.
 func (gs *GCDScheduler) DoNextAbility(sim *core.Simulation, character *core.Character) bool {
+	if sim.Encounter.HasDowntime() {
+		// Abilities scheduled during encounter downtime are skipped.
+		for gs.nextAbilityIndex < len(gs.schedule) && gs.schedule[gs.nextAbilityIndex].castAt+gs.latency < sim.CurrentTime {
+			gs.nextAbilityIndex++
+			gs.reacted = false
+		}
+	}
+
 	if gs.nextAbilityIndex >= len(gs.schedule) {
 		// It's possible for this function to get called near the end of the iteration,
 		// after the final scheduled ability.
@@ -279,18 +298,28 @@ func (gs *GCDScheduler) DoNextAbility(sim *core.Simulation, character *core.Char
 	}
 
 	expectedCastAt := gs.schedule[gs.nextAbilityIndex].castAt
-	if expectedCastAt > sim.CurrentTime {
-		character.SetGCDTimer(sim, expectedCastAt)
+	if !gs.reacted {
+		// Abilities right after the previous one are queued, the rest are a wait
+		// until a known time which the player reacts to.
+		if gs.nextAbilityIndex == 0 || expectedCastAt > gs.schedule[gs.nextAbilityIndex-1].doneAt {
+			gs.latency += character.ReactionTime(sim)
+		}
+		gs.reacted = true
+	}
+
+	if reactAt := expectedCastAt + gs.latency; reactAt > sim.CurrentTime {
+		character.SetGCDTimer(sim, reactAt)
 		return true
-	} else if expectedCastAt < sim.CurrentTime {
+	} else if reactAt < sim.CurrentTime {
 		panic(fmt.Sprintf("Missed scheduled cast! Expected %s but is now %s", expectedCastAt, sim.CurrentTime))
 	}
 
 	success := gs.schedule[gs.nextAbilityIndex].TryCast(sim)
 	gs.nextAbilityIndex++
+	gs.reacted = false
 
 	if gs.nextAbilityIndex < len(gs.schedule) {
-		nextCastAt := gs.schedule[gs.nextAbilityIndex].castAt
+		nextCastAt := gs.schedule[gs.nextAbilityIndex].castAt + gs.latency
 		if nextCastAt > character.NextGCDAt() {
 			character.SetGCDTimer(sim, nextCastAt)
 		}
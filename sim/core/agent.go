@@ -45,6 +45,28 @@ type Agent interface {
 	OnAutoAttack(sim *Simulation, spell *Spell)
 }
 
+// Optionally implemented by Agents which give individual buffs to other
+// players in the raid, e.g. buff bots providing Greater Blessings.
+type IndividualBuffsProvider interface {
+	// Updates the input Buffs to include individual buffs provided by this Agent
+	// to the recipient.
+	AddIndividualBuffs(recipient Agent, individualBuffs *proto.IndividualBuffs)
+}
+
+// Optionally implemented by Agents which apply debuffs that sims otherwise
+// approximate with an uptime, e.g. Warlocks with Improved Shadow Bolt.
+type DebuffApproximationProvider interface {
+	// Clears the approximated debuffs which this Agent applies itself.
+	ClearDebuffApproximations(debuffs *proto.Debuffs)
+}
+
+// Optionally implemented by Agents which give their party effects that sims
+// otherwise approximate with an individual buff, e.g. Vampiric Touch mana.
+type PartyBuffApproximationProvider interface {
+	// Clears the approximated buffs which this Agent gives its party itself.
+	ClearPartyBuffApproximations(individualBuffs *proto.IndividualBuffs)
+}
+
 type ActionID struct {
 	// Only one of these should be set.
 	SpellID int32
@@ -145,7 +167,21 @@ func PlayerProtoToSpec(player proto.Player) proto.Spec {
 	return configSpecs[typeName]
 }
 
+// Returns true if the player is registered with the given spec. Unlike
+// PlayerProtoToSpec, this is false for agents without a spec.
+func PlayerProtoIsSpec(player proto.Player, spec proto.Spec) bool {
+	typeName := reflect.TypeOf(player.GetSpec()).Elem().Name()
+	playerSpec, ok := configSpecs[typeName]
+	return ok && playerSpec == spec
+}
+
 func RegisterAgentFactory(emptyOptions interface{}, spec proto.Spec, factory AgentFactory, specSetter SpecSetter) {
+	RegisterSpeclessAgentFactory(emptyOptions, factory, specSetter)
+	configSpecs[reflect.TypeOf(emptyOptions).Name()] = spec
+}
+
+// Registers an agent which doesn't correspond to a Spec, e.g. buff bots.
+func RegisterSpeclessAgentFactory(emptyOptions interface{}, factory AgentFactory, specSetter SpecSetter) {
 	typeName := reflect.TypeOf(emptyOptions).Name()
 	if _, ok := agentFactories[typeName]; ok {
 		panic("Aleady registered agent factory: " + typeName)
@@ -154,7 +190,6 @@ func RegisterAgentFactory(emptyOptions interface{}, spec proto.Spec, factory Age
 
 	agentFactories[typeName] = factory
 	specSetters[typeName] = specSetter
-	configSpecs[typeName] = spec
 }
 
 // Constructs a new Agent.
@@ -2,13 +2,17 @@
 package core
 
 import (
+	"context"
+	"strings"
+
 	"github.com/wowsims/tbc/sim/core/items"
 	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
 )
 
 /**
- * Returns all items, enchants, and gems recognized by the sim.
+ * Returns all items, enchants, and gems recognized by the sim. Items can be
+ * filtered by phase, source zone, and badge cost.
  */
 func GetGearList(request *proto.GearListRequest) *proto.GearListResult {
 	result := &proto.GearListResult{
@@ -17,7 +21,17 @@ func GetGearList(request *proto.GearListRequest) *proto.GearListResult {
 
 	for i := range items.Items {
 		item := items.Items[i]
-		result.Items = append(result.Items, item.ToProto())
+		if !itemFilterMatches(request.Filter, item) {
+			continue
+		}
+		// Base random suffix items are hidden until the UI can pick their suffix.
+		// The legacy items with the suffix baked in are still listed.
+		if len(item.RandomSuffixOptions) > 0 {
+			continue
+		}
+		itemProto := item.ToProto()
+		itemProto.EffectImplemented = HasItemEffect(item.ID) || HasWeaponEffect(item.ID)
+		result.Items = append(result.Items, itemProto)
 	}
 	for i := range items.Gems {
 		gem := items.Gems[i]
@@ -27,10 +41,31 @@ func GetGearList(request *proto.GearListRequest) *proto.GearListResult {
 		enchant := items.Enchants[i]
 		result.Enchants = append(result.Enchants, enchant.ToProto())
 	}
+	for i := range items.RandomSuffixes {
+		suffix := items.RandomSuffixes[i]
+		result.RandomSuffixes = append(result.RandomSuffixes, suffix.ToProto())
+	}
 
 	return result
 }
 
+// Returns whether item passes filter. A nil filter matches every item.
+func itemFilterMatches(filter *proto.ItemFilter, item items.Item) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.MaxPhase != 0 && int32(item.Phase) > filter.MaxPhase {
+		return false
+	}
+	if filter.SourceZone != "" && !strings.EqualFold(item.SourceZone, filter.SourceZone) {
+		return false
+	}
+	if filter.MaxBadgeCost != 0 && item.BadgeCost > filter.MaxBadgeCost {
+		return false
+	}
+	return true
+}
+
 /**
  * Returns character stats taking into account gear / buffs / consumes / etc
  */
@@ -48,28 +83,59 @@ func ComputeStats(csr *proto.ComputeStatsRequest) *proto.ComputeStatsResult {
 func StatWeights(request *proto.StatWeightsRequest) *proto.StatWeightsResult {
 	statsToWeigh := stats.ProtoArrayToStatsList(request.StatsToWeigh)
 
-	result := CalcStatWeight(*request, statsToWeigh, stats.Stat(request.EpReferenceStat), nil)
+	result := CalcStatWeight(context.Background(), *request, statsToWeigh, stats.Stat(request.EpReferenceStat), nil)
 
 	return result.ToProto()
 }
 
-func StatWeightsAsync(request *proto.StatWeightsRequest, progress chan *proto.ProgressMetrics) {
+// Runs stat weights on a new goroutine, reporting progress and the final result
+// on the progress channel. Cancelling ctx stops the sims early.
+func StatWeightsAsync(ctx context.Context, request *proto.StatWeightsRequest, progress chan *proto.ProgressMetrics) {
 	statsToWeigh := stats.ProtoArrayToStatsList(request.StatsToWeigh)
 	go func() {
-		result := CalcStatWeight(*request, statsToWeigh, stats.Stat(request.EpReferenceStat), progress)
+		result := CalcStatWeight(ctx, *request, statsToWeigh, stats.Stat(request.EpReferenceStat), progress)
 		progress <- &proto.ProgressMetrics{
 			FinalWeightResult: result.ToProto(),
 		}
 	}()
 }
 
+/**
+ * Finds the best gear for a player from per-slot candidate items, enchants and gems.
+ */
+func OptimizeGear(request *proto.GearOptimizeRequest) *proto.GearOptimizeResult {
+	return runGearOptimizer(request)
+}
+
+/**
+ * Sims variants of a player's gear, talents or consumes against a baseline variant.
+ */
+func BatchCompare(request *proto.BatchCompareRequest) *proto.BatchCompareResult {
+	return runBatchCompare(context.Background(), request, nil)
+}
+
+// Runs a batch comparison on a new goroutine, reporting progress and the final
+// result on the progress channel. Cancelling ctx stops the sims early.
+func BatchCompareAsync(ctx context.Context, request *proto.BatchCompareRequest, progress chan *proto.ProgressMetrics) {
+	go runBatchCompare(ctx, request, progress)
+}
+
 /**
  * Runs multiple iterations of the sim with a full raid.
  */
 func RunRaidSim(request *proto.RaidSimRequest) *proto.RaidSimResult {
-	return RunSim(*request, nil)
+	return RunSim(context.Background(), *request, nil)
+}
+
+/**
+ * Sims two raids with the same random numbers, and returns the difference in DPS.
+ */
+func RunRaidSimCompare(request *proto.RaidSimCompareRequest) *proto.RaidSimCompareResult {
+	return runRaidSimCompare(context.Background(), request)
 }
 
-func RunRaidSimAsync(request *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) {
-	go RunSim(*request, progress)
+// Runs a raid sim on a new goroutine, reporting progress and the final result
+// on the progress channel. Cancelling ctx stops the sim early.
+func RunRaidSimAsync(ctx context.Context, request *proto.RaidSimRequest, progress chan *proto.ProgressMetrics) {
+	go RunSim(ctx, *request, progress)
 }
@@ -0,0 +1,57 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestGearListFilters(t *testing.T) {
+	all := core.GetGearList(&proto.GearListRequest{})
+
+	blackTemple := core.GetGearList(&proto.GearListRequest{Filter: &proto.ItemFilter{SourceZone: "black temple"}})
+	if len(blackTemple.Items) == 0 || len(blackTemple.Items) >= len(all.Items) {
+		t.Fatalf("Expected a subset of items from Black Temple, but got %d of %d", len(blackTemple.Items), len(all.Items))
+	}
+	for _, item := range blackTemple.Items {
+		if item.SourceZone != "Black Temple" {
+			t.Fatalf("Expected only Black Temple items, but got %s from %s", item.Name, item.SourceZone)
+		}
+	}
+
+	phase1 := core.GetGearList(&proto.GearListRequest{Filter: &proto.ItemFilter{MaxPhase: 1}})
+	for _, item := range phase1.Items {
+		if item.Phase > 1 {
+			t.Fatalf("Expected only phase 1 items, but got %s from phase %d", item.Name, item.Phase)
+		}
+	}
+
+	shattrath := core.GetGearList(&proto.GearListRequest{Filter: &proto.ItemFilter{SourceZone: "Shattrath City", MaxBadgeCost: 40}})
+	hasBadgeItem := false
+	hasReputationItem := false
+	for _, item := range shattrath.Items {
+		if item.BadgeCost > 40 {
+			t.Fatalf("Expected no items over 40 badges, but got %s for %d", item.Name, item.BadgeCost)
+		}
+		hasBadgeItem = hasBadgeItem || item.BadgeCost > 0
+		hasReputationItem = hasReputationItem || item.ReputationLevel != proto.RepLevel_RepLevelUnknown
+	}
+	if !hasBadgeItem || !hasReputationItem {
+		t.Fatalf("Expected badge and reputation items from Shattrath City, but got %v", shattrath.Items)
+	}
+}
+
+func TestGearListHidesBaseRandomSuffixItems(t *testing.T) {
+	hasLegacyItem := false
+	for _, item := range core.GetGearList(&proto.GearListRequest{}).Items {
+		if item.Id == 24692 {
+			t.Fatalf("Expected base random suffix items to be hidden, but got %s", item.Name)
+		}
+		// Elementalist Bracelets of Shadow Wrath, with the suffix baked in.
+		hasLegacyItem = hasLegacyItem || item.Id == -19
+	}
+	if !hasLegacyItem {
+		t.Fatalf("Expected legacy random suffix items to be listed")
+	}
+}
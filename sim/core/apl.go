@@ -0,0 +1,381 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// How long to wait before re-evaluating the priority list when nothing could
+// be cast, e.g. while waiting for resources or for a condition to pass.
+const aplRetryDelay = time.Millisecond * 100
+
+type aplCondition func(sim *Simulation) bool
+
+type aplAction struct {
+	actionID   ActionID
+	conditions []aplCondition
+
+	spell *Spell
+
+	// Major cooldowns are recreated every iteration, so this is set in reset().
+	mcd *MajorCooldown
+}
+
+func (action *aplAction) conditionsPass(sim *Simulation) bool {
+	for _, condition := range action.conditions {
+		if !condition(sim) {
+			return false
+		}
+	}
+	return true
+}
+
+// Replaces a player's rotation with a priority list of actions, each with a
+// set of conditions.
+//
+// Like cast sequences, spec hooks which would run the rotation are skipped, see
+// Character.RotationOverridden. Major cooldowns in the list are only used by
+// the list, but other major cooldowns are still used automatically.
+type aplRotation struct {
+	rotationProto *proto.APLRotation
+	actions       []*aplAction
+}
+
+func newAPLRotation(rotationProto *proto.APLRotation) *aplRotation {
+	if rotationProto == nil {
+		return nil
+	}
+
+	return &aplRotation{
+		rotationProto: rotationProto,
+	}
+}
+
+// Resolves spells and builds conditions. Needs to happen after all spells and
+// auras have been registered. Returns an error if the APL is invalid for this
+// character.
+func (apl *aplRotation) init(character *Character) error {
+	apl.actions = nil
+	for i, actionProto := range apl.rotationProto.PriorityList {
+		if actionProto.ActionId == nil {
+			return fmt.Errorf("APL action %d has no action ID", i+1)
+		}
+		action := &aplAction{
+			actionID: ProtoToActionID(*actionProto.ActionId),
+		}
+		action.spell = aplGetSpell(character, action.actionID)
+		if action.spell == nil {
+			return fmt.Errorf("%s has no spell or cooldown for APL action %s", character.Label, action.actionID)
+		}
+
+		for _, conditionProto := range actionProto.Conditions {
+			condition, err := newAPLCondition(character, conditionProto)
+			if err != nil {
+				return fmt.Errorf("APL action %s: %w", action.actionID, err)
+			}
+			action.conditions = append(action.conditions, condition)
+		}
+		apl.actions = append(apl.actions, action)
+	}
+	return nil
+}
+
+// Returns an error if any player's APL is invalid. Spells and auras are only
+// known once the players are constructed, so this builds an environment to
+// check against.
+func validateAPLRotations(rsr proto.RaidSimRequest) error {
+	if !raidHasAPL(*rsr.Raid) {
+		return nil
+	}
+
+	env := NewEnvironment(*rsr.Raid, *rsr.Encounter)
+	for _, party := range env.Raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			if character.apl == nil {
+				continue
+			}
+			if err := character.apl.init(character); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func raidHasAPL(raid proto.Raid) bool {
+	for _, party := range raid.Parties {
+		if party == nil {
+			continue
+		}
+		for _, player := range party.Players {
+			if player != nil && player.AplRotation != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the spell for a major cooldown or regular spell, or nil.
+func aplGetSpell(character *Character, actionID ActionID) *Spell {
+	if mcd := character.GetInitialMajorCooldown(actionID); mcd.Spell != nil {
+		return mcd.Spell
+	}
+	return character.GetSpell(actionID)
+}
+
+func (apl *aplRotation) reset(character *Character) {
+	for _, action := range apl.actions {
+		action.mcd = character.GetMajorCooldown(action.actionID)
+		if action.mcd != nil {
+			action.mcd.disabled = true
+		}
+	}
+}
+
+func (apl *aplRotation) onGCDReady(sim *Simulation, character *Character) {
+	// Off-GCD casts leave the GCD ready, so keep evaluating after them. Capped
+	// to avoid looping forever on actions which don't go on cooldown.
+	for i := 0; i <= len(apl.actions); i++ {
+		action := apl.castNext(sim, character)
+		if action == nil {
+			apl.wait(sim, character)
+			return
+		}
+		if !character.GCD.IsReady(sim) {
+			return
+		}
+	}
+	apl.wait(sim, character)
+}
+
+// Casts the first action whose conditions pass and which is ready, and
+// returns it. Returns nil if nothing was cast.
+func (apl *aplRotation) castNext(sim *Simulation, character *Character) *aplAction {
+	for _, action := range apl.actions {
+		if !action.spell.IsReady(sim) || !action.conditionsPass(sim) {
+			continue
+		}
+
+		var success bool
+		if action.mcd != nil {
+			success = action.mcd.forceActivate(sim, character)
+		} else {
+			success = action.spell.Cast(sim, character.CurrentTarget)
+		}
+		if success {
+			return action
+		}
+	}
+	return nil
+}
+
+// Waits until the next listed spell comes off cooldown, re-checking
+// periodically in case a condition starts passing before then.
+func (apl *aplRotation) wait(sim *Simulation, character *Character) {
+	if !character.GCD.IsReady(sim) {
+		return
+	}
+
+	waitUntil := sim.CurrentTime + aplRetryDelay
+	for _, action := range apl.actions {
+		if readyAt := action.spell.ReadyAt(); readyAt > sim.CurrentTime && readyAt < waitUntil {
+			waitUntil = readyAt
+		}
+	}
+	character.WaitUntil(sim, waitUntil)
+}
+
+func validateAPLComparison(comparison proto.APLComparison) error {
+	switch comparison {
+	case proto.APLComparison_APLCompareLessThan, proto.APLComparison_APLCompareLessThanOrEqual,
+		proto.APLComparison_APLCompareGreaterThan, proto.APLComparison_APLCompareGreaterThanOrEqual,
+		proto.APLComparison_APLCompareEqual:
+		return nil
+	}
+	return fmt.Errorf("invalid APL comparison: %s", comparison)
+}
+
+// Comparisons are checked with validateAPLComparison when conditions are built.
+func aplCompare(comparison proto.APLComparison, a float64, b float64) bool {
+	switch comparison {
+	case proto.APLComparison_APLCompareLessThan:
+		return a < b
+	case proto.APLComparison_APLCompareLessThanOrEqual:
+		return a <= b
+	case proto.APLComparison_APLCompareGreaterThan:
+		return a > b
+	case proto.APLComparison_APLCompareGreaterThanOrEqual:
+		return a >= b
+	case proto.APLComparison_APLCompareEqual:
+		return a == b
+	}
+	panic("Invalid APL comparison: " + comparison.String())
+}
+
+func newAPLCondition(character *Character, conditionProto *proto.APLCondition) (aplCondition, error) {
+	var condition aplCondition
+	var err error
+	switch c := conditionProto.Condition.(type) {
+	case *proto.APLCondition_Resource:
+		condition, err = newAPLResourceCondition(character, c.Resource)
+	case *proto.APLCondition_Aura:
+		condition, err = newAPLAuraCondition(character, c.Aura)
+	case *proto.APLCondition_Cooldown:
+		condition, err = newAPLCooldownCondition(character, c.Cooldown)
+	case *proto.APLCondition_ExecutePhase:
+		condition = func(sim *Simulation) bool {
+			return sim.IsExecutePhase()
+		}
+	case *proto.APLCondition_RemainingTime:
+		condition, err = newAPLRemainingTimeCondition(c.RemainingTime)
+	default:
+		err = fmt.Errorf("APL condition without a type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if conditionProto.Negate {
+		inner := condition
+		condition = func(sim *Simulation) bool {
+			return !inner(sim)
+		}
+	}
+	return condition, nil
+}
+
+func newAPLResourceCondition(character *Character, resource *proto.APLResourceCondition) (aplCondition, error) {
+	comparison := resource.Comparison
+	value := resource.Value
+	if err := validateAPLComparison(comparison); err != nil {
+		return nil, err
+	}
+
+	if resource.Percent && resource.ResourceType != proto.ResourceType_ResourceTypeMana {
+		return nil, fmt.Errorf("APL resource percent is only valid for mana")
+	}
+
+	var current func() float64
+	switch resource.ResourceType {
+	case proto.ResourceType_ResourceTypeMana:
+		if resource.Percent {
+			current = character.CurrentManaPercent
+		} else {
+			current = character.CurrentMana
+		}
+	case proto.ResourceType_ResourceTypeRage:
+		current = character.CurrentRage
+	case proto.ResourceType_ResourceTypeEnergy:
+		current = character.CurrentEnergy
+	case proto.ResourceType_ResourceTypeComboPoints:
+		current = func() float64 {
+			return float64(character.ComboPoints())
+		}
+	default:
+		return nil, fmt.Errorf("unsupported APL resource type: %s", resource.ResourceType)
+	}
+
+	return func(sim *Simulation) bool {
+		return aplCompare(comparison, current(), value)
+	}, nil
+}
+
+func newAPLAuraCondition(character *Character, auraCondition *proto.APLAuraCondition) (aplCondition, error) {
+	if auraCondition.AuraId == nil {
+		return nil, fmt.Errorf("APL aura condition without an aura ID")
+	}
+	auraID := ProtoToActionID(*auraCondition.AuraId)
+	onTarget := auraCondition.OnTarget
+	comparison := auraCondition.Comparison
+	value := auraCondition.Value
+
+	// Auras which don't exist are treated as inactive, since e.g. debuffs from
+	// other raid members might not be present in every setup. Target auras are
+	// looked up each time, since the current target can change.
+	var selfAura *Aura
+	if !onTarget {
+		selfAura = character.GetAuraByID(auraID)
+	}
+	getAura := func() *Aura {
+		if onTarget {
+			return character.CurrentTarget.GetAuraByID(auraID)
+		}
+		return selfAura
+	}
+
+	if auraCondition.Property != proto.APLAuraCondition_Active {
+		if err := validateAPLComparison(comparison); err != nil {
+			return nil, err
+		}
+	}
+
+	switch auraCondition.Property {
+	case proto.APLAuraCondition_Active:
+		return func(sim *Simulation) bool {
+			aura := getAura()
+			return aura != nil && aura.IsActive()
+		}, nil
+	case proto.APLAuraCondition_Stacks:
+		return func(sim *Simulation) bool {
+			stacks := 0.0
+			if aura := getAura(); aura != nil && aura.IsActive() {
+				stacks = float64(aura.GetStacks())
+			}
+			return aplCompare(comparison, stacks, value)
+		}, nil
+	case proto.APLAuraCondition_RemainingSeconds:
+		return func(sim *Simulation) bool {
+			remaining := 0.0
+			if aura := getAura(); aura != nil && aura.IsActive() {
+				remaining = aura.RemainingDuration(sim).Seconds()
+			}
+			return aplCompare(comparison, remaining, value)
+		}, nil
+	}
+	return nil, fmt.Errorf("invalid APL aura property: %s", auraCondition.Property)
+}
+
+func newAPLCooldownCondition(character *Character, cooldown *proto.APLCooldownCondition) (aplCondition, error) {
+	if cooldown.SpellId == nil {
+		return nil, fmt.Errorf("APL cooldown condition without a spell ID")
+	}
+	spellID := ProtoToActionID(*cooldown.SpellId)
+	spell := aplGetSpell(character, spellID)
+	if spell == nil {
+		return nil, fmt.Errorf("%s has no spell or cooldown for APL cooldown condition %s", character.Label, spellID)
+	}
+
+	if cooldown.Comparison == proto.APLComparison_APLCompareUnknown {
+		return func(sim *Simulation) bool {
+			return spell.IsReady(sim)
+		}, nil
+	}
+	comparison := cooldown.Comparison
+	value := cooldown.Value
+	if err := validateAPLComparison(comparison); err != nil {
+		return nil, err
+	}
+	return func(sim *Simulation) bool {
+		return aplCompare(comparison, spell.TimeToReady(sim).Seconds(), value)
+	}, nil
+}
+
+func newAPLRemainingTimeCondition(remainingTime *proto.APLRemainingTimeCondition) (aplCondition, error) {
+	comparison := remainingTime.Comparison
+	value := remainingTime.Value
+	if err := validateAPLComparison(comparison); err != nil {
+		return nil, err
+	}
+	if remainingTime.Percent {
+		return func(sim *Simulation) bool {
+			return aplCompare(comparison, sim.GetRemainingDurationPercent(), value)
+		}, nil
+	}
+	return func(sim *Simulation) bool {
+		return aplCompare(comparison, sim.GetRemainingDuration().Seconds(), value)
+	}, nil
+}
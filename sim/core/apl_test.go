@@ -0,0 +1,97 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestAPLRotation(t *testing.T) {
+	runSim := func(emConditions []*proto.APLCondition) map[int32]int32 {
+		player := googleProto.Clone(testElementalShaman).(*proto.Player)
+		player.AplRotation = &proto.APLRotation{
+			PriorityList: []*proto.APLAction{
+				{ActionId: spellActionID(16166), Conditions: emConditions}, // Elemental Mastery
+				{
+					ActionId: spellActionID(25442), // Chain Lightning
+					Conditions: []*proto.APLCondition{
+						{Condition: &proto.APLCondition_Resource{Resource: &proto.APLResourceCondition{
+							ResourceType: proto.ResourceType_ResourceTypeMana,
+							Percent:      true,
+							Comparison:   proto.APLComparison_APLCompareGreaterThan,
+							Value:        0.5,
+						}}},
+					},
+				},
+				{ActionId: spellActionID(25449)}, // Lightning Bolt
+			},
+		}
+
+		result := runTestSim(singlePlayerRaid(player), testEncounter, nil)
+		return castsBySpell(result.RaidMetrics.Parties[0].Players[0])
+	}
+
+	casts := runSim(nil)
+	if casts[16166] == 0 || casts[25442] == 0 || casts[25449] == 0 {
+		t.Fatalf("Expected Elemental Mastery, Chain Lightning and Lightning Bolt casts, but got %v", casts)
+	}
+
+	// Listed cooldowns shouldn't be used automatically when their conditions fail.
+	casts = runSim([]*proto.APLCondition{
+		{Condition: &proto.APLCondition_ExecutePhase{ExecutePhase: &proto.APLExecutePhaseCondition{}}, Negate: true},
+		{Condition: &proto.APLCondition_RemainingTime{RemainingTime: &proto.APLRemainingTimeCondition{
+			Comparison: proto.APLComparison_APLCompareGreaterThan,
+			Value:      1000,
+		}}},
+	})
+	if casts[16166] != 0 {
+		t.Fatalf("Expected no Elemental Mastery casts, but got %d", casts[16166])
+	}
+}
+
+func TestInvalidAPLRotation(t *testing.T) {
+	runSim := func(action *proto.APLAction) *proto.RaidSimResult {
+		player := googleProto.Clone(testElementalShaman).(*proto.Player)
+		player.AplRotation = &proto.APLRotation{
+			PriorityList: []*proto.APLAction{action},
+		}
+		return runTestSim(singlePlayerRaid(player), testEncounter, nil)
+	}
+
+	for name, action := range map[string]*proto.APLAction{
+		"MissingActionID": {},
+		"UnknownSpell":    {ActionId: spellActionID(1)},
+		"MissingConditionType": {
+			ActionId:   spellActionID(25449),
+			Conditions: []*proto.APLCondition{{}},
+		},
+		"RagePercent": {
+			ActionId: spellActionID(25449),
+			Conditions: []*proto.APLCondition{
+				{Condition: &proto.APLCondition_Resource{Resource: &proto.APLResourceCondition{
+					ResourceType: proto.ResourceType_ResourceTypeRage,
+					Percent:      true,
+					Comparison:   proto.APLComparison_APLCompareGreaterThan,
+				}}},
+			},
+		},
+		"MissingComparison": {
+			ActionId: spellActionID(25449),
+			Conditions: []*proto.APLCondition{
+				{Condition: &proto.APLCondition_RemainingTime{RemainingTime: &proto.APLRemainingTimeCondition{}}},
+			},
+		},
+	} {
+		if result := runSim(action); result.ErrorResult == "" {
+			t.Errorf("%s: expected an error result", name)
+		}
+	}
+
+	player := googleProto.Clone(testElementalShaman).(*proto.Player)
+	player.AplRotation = &proto.APLRotation{}
+	player.CastSequence = &proto.CastSequence{}
+	if result := runTestSim(singlePlayerRaid(player), testEncounter, nil); result.ErrorResult == "" {
+		t.Errorf("Expected an error result when using both an APL and a cast sequence")
+	}
+}
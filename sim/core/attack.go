@@ -180,6 +180,9 @@ type AutoAttacks struct {
 	// PendingAction which handles auto attacks.
 	autoSwingAction    *PendingAction
 	autoSwingCancelled bool
+
+	// PendingAction which handles ranged auto attacks, if AutoSwingRanged is set.
+	rangedSwingAction *PendingAction
 }
 
 // Options for initializing auto attacks.
@@ -329,6 +332,9 @@ func (aa *AutoAttacks) reset(sim *Simulation) {
 
 	aa.RangedSwingAt = 0
 	aa.RangedSwingInProgress = false
+
+	aa.rangedSwingAction = nil
+	aa.resetAutoSwingRanged(sim)
 }
 
 func (aa *AutoAttacks) resetAutoSwing(sim *Simulation) {
@@ -359,6 +365,32 @@ func (aa *AutoAttacks) resetAutoSwing(sim *Simulation) {
 	sim.AddPendingAction(pa)
 }
 
+// Ranged autos wait for any hardcast to finish, since the windup would
+// interrupt it.
+func (aa *AutoAttacks) resetAutoSwingRanged(sim *Simulation) {
+	if aa.autoSwingCancelled || !aa.AutoSwingRanged || aa.RangedAuto == nil || aa.Ranged.SwingSpeed == 0 {
+		return
+	}
+
+	pa := &PendingAction{
+		NextActionAt: MaxDuration(aa.RangedSwingAt, sim.CurrentTime),
+		Priority:     ActionPriorityAuto,
+	}
+
+	pa.OnAction = func(sim *Simulation) {
+		if aa.unit.Hardcast.Expires > sim.CurrentTime {
+			pa.NextActionAt = aa.unit.Hardcast.Expires
+		} else {
+			aa.SwingRanged(sim, aa.unit.CurrentTarget)
+			pa.NextActionAt = MaxDuration(aa.RangedSwingAt, sim.CurrentTime+aa.RangedAuto.CurCast.CastTime)
+		}
+		sim.AddPendingAction(pa)
+	}
+
+	aa.rangedSwingAction = pa
+	sim.AddPendingAction(pa)
+}
+
 // Stops the auto swing action for the rest of the iteration. Used for pets
 // after being disabled.
 func (aa *AutoAttacks) CancelAutoSwing(sim *Simulation) {
@@ -367,6 +399,11 @@ func (aa *AutoAttacks) CancelAutoSwing(sim *Simulation) {
 		aa.autoSwingAction = nil
 		aa.autoSwingCancelled = true
 	}
+	if aa.rangedSwingAction != nil {
+		aa.rangedSwingAction.Cancel(sim)
+		aa.rangedSwingAction = nil
+		aa.autoSwingCancelled = true
+	}
 }
 
 // Renables the auto swing action for the iteration
@@ -391,6 +428,9 @@ func (aa *AutoAttacks) EnableAutoSwing(sim *Simulation) {
 
 	aa.autoSwingCancelled = false
 	aa.resetAutoSwing(sim)
+	if aa.rangedSwingAction == nil {
+		aa.resetAutoSwingRanged(sim)
+	}
 }
 
 // The amount of time between two MH swings.
@@ -490,9 +530,12 @@ func (aa *AutoAttacks) TrySwingRanged(sim *Simulation, target *Unit) {
 		return
 	}
 
+	gcdReadyAt := aa.unit.NextGCDAt()
 	aa.RangedAuto.Cast(sim, target)
 	aa.RangedSwingAt = sim.CurrentTime + aa.RangedSwingSpeed()
 	aa.RangedSwingInProgress = true
+	// Auto Shot doesn't trigger the GCD, so cancelling it restores the old one.
+	aa.unit.Hardcast.gcdReadyAt = gcdReadyAt
 
 	// It's important that we update the GCD timer AFTER starting the ranged auto.
 	// Otherwise the hardcast action won't be created separately.
@@ -159,6 +159,9 @@ func (aura *Aura) SetStacks(sim *Simulation, newStacks int32) {
 	if sim.Log != nil {
 		aura.Unit.Log(sim, "%s stacks: %d --> %d", aura.ActionID, oldStacks, newStacks)
 	}
+	if sim.RecordingTimeline() {
+		sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventAuraStacksChanged, aura.Unit, aura.ActionID).Stacks = newStacks
+	}
 	aura.stacks = newStacks
 	if aura.OnStacksChange != nil {
 		aura.OnStacksChange(aura, sim, oldStacks, newStacks)
@@ -245,6 +248,14 @@ func (at *auraTracker) GetAura(label string) *Aura {
 	}
 	return nil
 }
+func (at *auraTracker) GetAuraByID(actionID ActionID) *Aura {
+	for _, aura := range at.auras {
+		if aura.ActionID.SameAction(actionID) {
+			return aura
+		}
+	}
+	return nil
+}
 func (at *auraTracker) HasAura(label string) bool {
 	aura := at.GetAura(label)
 	return aura != nil
@@ -409,6 +420,9 @@ func (aura *Aura) Activate(sim *Simulation) {
 		if sim.Log != nil && !aura.ActionID.IsEmptyAction() {
 			aura.Unit.Log(sim, "Aura refreshed: %s", aura.ActionID)
 		}
+		if sim.RecordingTimeline() && !aura.ActionID.IsEmptyAction() {
+			sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventAuraRefreshed, aura.Unit, aura.ActionID)
+		}
 		aura.Refresh(sim)
 		return
 	}
@@ -473,6 +487,9 @@ func (aura *Aura) Activate(sim *Simulation) {
 	if sim.Log != nil && !aura.ActionID.IsEmptyAction() {
 		aura.Unit.Log(sim, "Aura gained: %s", aura.ActionID)
 	}
+	if sim.RecordingTimeline() && !aura.ActionID.IsEmptyAction() {
+		sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventAuraGained, aura.Unit, aura.ActionID)
+	}
 
 	if aura.OnGain != nil {
 		aura.OnGain(aura, sim)
@@ -547,6 +564,9 @@ func (aura *Aura) Deactivate(sim *Simulation) {
 	if sim.Log != nil && !aura.ActionID.IsEmptyAction() {
 		aura.Unit.Log(sim, "Aura faded: %s", aura.ActionID)
 	}
+	if sim.RecordingTimeline() && !aura.ActionID.IsEmptyAction() {
+		sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventAuraFaded, aura.Unit, aura.ActionID)
+	}
 
 	if aura.activeIndex != Inactive {
 		removeActiveIndex := aura.activeIndex
@@ -0,0 +1,141 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Sims each variant of a player with paired random numbers, and compares them
+// to the baseline variant. Variants are simmed one at a time, each using all
+// the available workers.
+func runBatchCompare(ctx context.Context, request *proto.BatchCompareRequest, progress chan *proto.ProgressMetrics) *proto.BatchCompareResult {
+	simRequests, err := batchCompareSimRequests(request)
+	if err != nil {
+		result := &proto.BatchCompareResult{ErrorResult: err.Error()}
+		if progress != nil {
+			progress <- &proto.ProgressMetrics{FinalBatchCompareResult: result}
+		}
+		return result
+	}
+
+	numVariants := len(simRequests)
+	totalIterations := int32(0)
+	for _, simRequest := range simRequests {
+		totalIterations += maxSimIterations(*simRequest.SimOptions)
+	}
+	var completedIterations int32
+
+	results := make([]*proto.RaidSimResult, numVariants)
+	iterationDps := make([][]float64, numVariants)
+	for i, simRequest := range simRequests {
+		if progress == nil {
+			results[i], iterationDps[i] = runSim(ctx, simRequest, nil, true, nil)
+			continue
+		}
+
+		// Forward progress for this variant, as part of the whole batch.
+		reporter := make(chan *proto.ProgressMetrics, 10)
+		done := make(chan struct{})
+		go func(simIdx int) {
+			defer close(done)
+			for metrics := range reporter {
+				progress <- &proto.ProgressMetrics{
+					TotalIterations:     totalIterations,
+					CompletedIterations: completedIterations + metrics.CompletedIterations,
+					CompletedSims:       int32(simIdx),
+					TotalSims:           int32(numVariants),
+					Dps:                 metrics.Dps,
+				}
+				if metrics.FinalRaidResult != nil {
+					return
+				}
+			}
+		}(i)
+		results[i], iterationDps[i] = runSim(ctx, simRequest, reporter, true, nil)
+		<-done
+		completedIterations += results[i].Iterations
+	}
+
+	z := zScore(request.Confidence)
+	result := &proto.BatchCompareResult{}
+	for i, variant := range request.Variants {
+		variantResult := &proto.BatchCompareVariantResult{
+			Name:    variant.Name,
+			Metrics: results[i].RaidMetrics.Parties[0].Players[0],
+		}
+		if i != int(request.BaselineIndex) {
+			diffs, n := pairedDifference(iterationDps[request.BaselineIndex], iterationDps[i])
+			variantResult.DpsDelta = diffs.ToProto(n)
+			variantResult.DpsDeltaHalfWidth = diffs.confidenceHalfWidth(n, z)
+			variantResult.Significant = math.Abs(variantResult.DpsDelta.Avg) > variantResult.DpsDeltaHalfWidth
+		}
+		result.Variants = append(result.Variants, variantResult)
+	}
+
+	if progress != nil {
+		progress <- &proto.ProgressMetrics{
+			TotalIterations:         completedIterations,
+			CompletedIterations:     completedIterations,
+			CompletedSims:           int32(numVariants),
+			TotalSims:               int32(numVariants),
+			FinalBatchCompareResult: result,
+		}
+	}
+	return result
+}
+
+// Returns the sim request for each variant, or an error if the request is
+// invalid.
+func batchCompareSimRequests(request *proto.BatchCompareRequest) ([]proto.RaidSimRequest, error) {
+	if request.Player == nil {
+		return nil, fmt.Errorf("Missing player")
+	}
+	if request.SimOptions == nil {
+		return nil, fmt.Errorf("Missing sim options")
+	}
+	numVariants := len(request.Variants)
+	if numVariants > 0 && (request.BaselineIndex < 0 || int(request.BaselineIndex) >= numVariants) {
+		return nil, fmt.Errorf("Invalid baseline index %d for %d variants", request.BaselineIndex, numVariants)
+	}
+
+	simOptions := googleProto.Clone(request.SimOptions).(*proto.SimOptions)
+	simOptions.PairedRandom = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	simRequests := make([]proto.RaidSimRequest, numVariants)
+	for i, variant := range request.Variants {
+		raidProto := SinglePlayerRaidProto(applyBatchCompareVariant(request.Player, variant), request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+		raidProto.Tanks = request.Tanks
+		simRequests[i] = proto.RaidSimRequest{
+			Raid:       raidProto,
+			Encounter:  request.Encounter,
+			SimOptions: simOptions,
+		}
+		if err := validateRaidSimRequest(simRequests[i]); err != nil {
+			return nil, fmt.Errorf("Variant %q: %s", variant.Name, err)
+		}
+	}
+	return simRequests, nil
+}
+
+// Returns a copy of player with the variant's changes.
+func applyBatchCompareVariant(player *proto.Player, variant *proto.BatchCompareVariant) *proto.Player {
+	player = googleProto.Clone(player).(*proto.Player)
+	if variant.Equipment != nil {
+		player.Equipment = variant.Equipment
+	}
+	if variant.TalentsString != "" {
+		player.TalentsString = variant.TalentsString
+	}
+	if variant.Consumes != nil {
+		player.Consumes = variant.Consumes
+	}
+	return player
+}
@@ -0,0 +1,96 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestBatchCompare(t *testing.T) {
+	request := &proto.BatchCompareRequest{
+		Player:     testElementalShaman,
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations: 20,
+			IsTest:     true,
+			RandomSeed: 1,
+		},
+		Variants: []*proto.BatchCompareVariant{
+			{Name: "Current"},
+			{Name: "Same Gear", Equipment: testElementalShaman.Equipment},
+			{Name: "No Gear", Equipment: &proto.EquipmentSpec{}},
+		},
+	}
+
+	progress := make(chan *proto.ProgressMetrics, 100)
+	core.BatchCompareAsync(context.Background(), request, progress)
+	var result *proto.BatchCompareResult
+	for metrics := range progress {
+		if metrics.FinalBatchCompareResult != nil {
+			result = metrics.FinalBatchCompareResult
+			break
+		}
+	}
+
+	if len(result.Variants) != 3 {
+		t.Fatalf("Expected 3 variant results, but got %d", len(result.Variants))
+	}
+	if baseline := result.Variants[0]; baseline.Name != "Current" || baseline.DpsDelta != nil {
+		t.Fatalf("Expected the baseline without a delta, but got %v", baseline)
+	}
+	if same := result.Variants[1]; same.DpsDelta.Avg != 0 || same.Significant {
+		t.Fatalf("Expected no difference for the same gear, but got %0.3f +/- %0.3f", same.DpsDelta.Avg, same.DpsDeltaHalfWidth)
+	}
+	if noGear := result.Variants[2]; noGear.DpsDelta.Avg >= 0 || !noGear.Significant {
+		t.Fatalf("Expected a significant loss without gear, but got %0.3f +/- %0.3f", noGear.DpsDelta.Avg, noGear.DpsDeltaHalfWidth)
+	}
+	if result.Variants[2].Metrics.Dps.Avg >= result.Variants[0].Metrics.Dps.Avg {
+		t.Fatalf("Expected lower dps without gear")
+	}
+}
+
+func TestBatchCompareInvalidRequest(t *testing.T) {
+	newRequest := func() *proto.BatchCompareRequest {
+		return &proto.BatchCompareRequest{
+			Player:     testElementalShaman,
+			RaidBuffs:  &proto.RaidBuffs{},
+			PartyBuffs: &proto.PartyBuffs{},
+			Debuffs:    &proto.Debuffs{},
+			Encounter:  testEncounter,
+			SimOptions: testSimOptions,
+			Variants: []*proto.BatchCompareVariant{
+				{Name: "Current"},
+				{Name: "No Gear", Equipment: &proto.EquipmentSpec{}},
+			},
+		}
+	}
+
+	badBaselineIndex := newRequest()
+	badBaselineIndex.BaselineIndex = 2
+	noSimOptions := newRequest()
+	noSimOptions.SimOptions = nil
+	noEncounter := newRequest()
+	noEncounter.Encounter = nil
+
+	for name, request := range map[string]*proto.BatchCompareRequest{
+		"BadBaselineIndex": badBaselineIndex,
+		"NoSimOptions":     noSimOptions,
+		"NoEncounter":      noEncounter,
+	} {
+		if result := core.BatchCompare(request); result.ErrorResult == "" {
+			t.Errorf("%s: expected an error result", name)
+		}
+	}
+
+	// Async callers wait for a final result, so errors need to be reported too.
+	progress := make(chan *proto.ProgressMetrics, 1)
+	core.BatchCompareAsync(context.Background(), badBaselineIndex, progress)
+	if metrics := <-progress; metrics.FinalBatchCompareResult == nil || metrics.FinalBatchCompareResult.ErrorResult == "" {
+		t.Fatalf("Expected a final error result, but got %v", metrics)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
 )
 
@@ -18,6 +19,11 @@ type Hardcast struct {
 	Expires    time.Duration
 	OnComplete func(*Simulation, *Unit)
 	Target     *Unit
+
+	// Set only for spell casts, so they can be cancelled by movement.
+	spell      *Spell
+	startedAt  time.Duration
+	gcdReadyAt time.Duration
 }
 
 func (hc *Hardcast) OnExpire(sim *Simulation) {
@@ -94,6 +100,9 @@ func (spell *Spell) wrapCastFuncInit(config CastConfig, onCastComplete CastSucce
 	if config.ModifyCast == nil {
 		return func(sim *Simulation, target *Unit) bool {
 			spell.CurCast = spell.DefaultCast
+			if spell.Unit.castBlockedByMovement(sim, spell) {
+				return false
+			}
 			return onCastComplete(sim, target)
 		}
 	} else {
@@ -101,6 +110,9 @@ func (spell *Spell) wrapCastFuncInit(config CastConfig, onCastComplete CastSucce
 		return func(sim *Simulation, target *Unit) bool {
 			spell.CurCast = spell.DefaultCast
 			modifyCast(sim, spell, &spell.CurCast)
+			if spell.Unit.castBlockedByMovement(sim, spell) {
+				return false
+			}
 			return onCastComplete(sim, target)
 		}
 	}
@@ -192,7 +204,16 @@ func (spell *Spell) wrapCastFuncGCD(config CastConfig, onCastComplete CastFunc)
 		}
 
 		fullCastTime := spell.CurCast.CastTime + spell.CurCast.ChannelTime + spell.CurCast.AfterCastDelay
-		spell.Unit.SetGCDTimer(sim, sim.CurrentTime+MaxDuration(gcd, fullCastTime))
+		if spell.CurCast.ChannelTime > 0 {
+			spell.Unit.startChannel(sim.CurrentTime+fullCastTime, sim.CurrentTime+gcd)
+		}
+		if fullCastTime > gcd {
+			// The next action can't be queued until the cast ends, so the player
+			// has to react to it.
+			spell.Unit.SetGCDTimer(sim, sim.CurrentTime+fullCastTime+spell.Unit.ReactionTime(sim))
+		} else {
+			spell.Unit.SetGCDTimer(sim, sim.CurrentTime+gcd)
+		}
 
 		onCastComplete(sim, target)
 	}
@@ -281,6 +302,10 @@ func (spell *Spell) makeCastFuncWait(config CastConfig, onCastComplete CastFunc)
 						spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
 					}
 				}
+				if sim.RecordingTimeline() && !spell.ActionID.IsEmptyAction() {
+					sim.addCastTimelineEvent(proto.TimelineEventType_TimelineEventCastStart, spell, target)
+					sim.addCastTimelineEvent(proto.TimelineEventType_TimelineEventCastComplete, spell, target)
+				}
 				onCastComplete(sim, target)
 			}
 		}
@@ -294,6 +319,9 @@ func (spell *Spell) makeCastFuncWait(config CastConfig, onCastComplete CastFunc)
 						spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
 					}
 				}
+				if sim.RecordingTimeline() && !spell.ActionID.IsEmptyAction() {
+					sim.addCastTimelineEvent(proto.TimelineEventType_TimelineEventCastComplete, spell, target)
+				}
 				oldOnCastComplete3(sim, target)
 			}
 		}
@@ -303,14 +331,22 @@ func (spell *Spell) makeCastFuncWait(config CastConfig, onCastComplete CastFunc)
 				spell.Unit.Log(sim, "Casting %s (Cost = %0.03f, Cast Time = %s)",
 					spell.ActionID, MaxFloat(0, spell.CurCast.Cost), spell.CurCast.CastTime)
 			}
+			if sim.RecordingTimeline() && !spell.SpellExtras.Matches(SpellExtrasNoLogs) {
+				sim.addCastTimelineEvent(proto.TimelineEventType_TimelineEventCastStart, spell, target).Amount = spell.CurCast.CastTime.Seconds()
+			}
 
 			// For instant-cast spells we can skip creating an aura.
 			if spell.CurCast.CastTime == 0 {
 				onCastComplete(sim, target)
 			} else {
-				spell.Unit.Hardcast.Expires = sim.CurrentTime + spell.CurCast.CastTime
-				spell.Unit.Hardcast.OnComplete = onCastComplete
-				spell.Unit.Hardcast.Target = target
+				spell.Unit.Hardcast = Hardcast{
+					Expires:    sim.CurrentTime + spell.CurCast.CastTime,
+					OnComplete: onCastComplete,
+					Target:     target,
+					spell:      spell,
+					startedAt:  sim.CurrentTime,
+					gcdReadyAt: sim.CurrentTime + MaxDuration(GCDMin, spell.CurCast.GCD),
+				}
 
 				// If hardcast and GCD happen at the same time then we don't need a separate action.
 				if spell.Unit.Hardcast.Expires != spell.Unit.NextGCDAt() {
@@ -0,0 +1,198 @@
+package core
+
+import (
+	"sort"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// How long to wait before retrying a cast which failed due to resources.
+const castSequenceRetryDelay = time.Millisecond * 100
+
+type sequencedCast struct {
+	actionID ActionID
+	at       time.Duration
+}
+
+type castSequenceBlockKey struct {
+	index  int
+	reason proto.CastSequenceBlockReason
+}
+
+type castSequenceBlockMetrics struct {
+	iterations int32
+	blocked    time.Duration
+}
+
+// Replaces a player's rotation with a fixed list of casts.
+//
+// Major cooldowns are only used when they appear in the sequence. Spec hooks
+// which would run the rotation check Character.RotationOverridden, and auto
+// attacks (including ranged autos) are handled by the core engine.
+type castSequence struct {
+	casts []sequencedCast
+
+	// Index of the next cast to attempt.
+	nextIndex int
+
+	// State of the current block, if the next cast is blocked.
+	blocked      bool
+	blockReason  proto.CastSequenceBlockReason
+	blockedSince time.Duration
+
+	// Blocks in the current iteration.
+	iterationBlocks map[castSequenceBlockKey]time.Duration
+
+	metrics    map[castSequenceBlockKey]*castSequenceBlockMetrics
+	blockOrder []castSequenceBlockKey
+}
+
+func newCastSequence(sequenceProto *proto.CastSequence) *castSequence {
+	if sequenceProto == nil {
+		return nil
+	}
+
+	cs := &castSequence{
+		iterationBlocks: make(map[castSequenceBlockKey]time.Duration),
+		metrics:         make(map[castSequenceBlockKey]*castSequenceBlockMetrics),
+	}
+	for _, cast := range sequenceProto.Casts {
+		// Casts without an action are reported as unavailable.
+		var actionID ActionID
+		if cast.ActionId != nil {
+			actionID = ProtoToActionID(*cast.ActionId)
+		}
+		cs.casts = append(cs.casts, sequencedCast{
+			actionID: actionID,
+			at:       DurationFromSeconds(cast.AtSeconds),
+		})
+	}
+	return cs
+}
+
+func (cs *castSequence) reset() {
+	cs.nextIndex = 0
+	cs.blocked = false
+	for key := range cs.iterationBlocks {
+		delete(cs.iterationBlocks, key)
+	}
+}
+
+func (cs *castSequence) onGCDReady(sim *Simulation, character *Character) {
+	for cs.nextIndex < len(cs.casts) {
+		cast := cs.casts[cs.nextIndex]
+		if sim.CurrentTime < cast.at {
+			character.SetGCDTimer(sim, cast.at)
+			return
+		}
+
+		var mcd *MajorCooldown
+		var spell *Spell
+		if !cast.actionID.IsEmptyAction() {
+			mcd = character.GetMajorCooldown(cast.actionID)
+			if mcd != nil {
+				spell = mcd.Spell
+			} else {
+				spell = character.GetSpell(cast.actionID)
+			}
+		}
+
+		if spell == nil {
+			cs.block(sim, proto.CastSequenceBlockReason_CastSequenceBlockUnavailable)
+			cs.finishCast(sim)
+			continue
+		}
+
+		if !spell.IsReady(sim) {
+			cs.block(sim, proto.CastSequenceBlockReason_CastSequenceBlockCooldown)
+			character.WaitUntil(sim, spell.ReadyAt())
+			return
+		}
+
+		var success bool
+		if mcd != nil {
+			success = mcd.forceActivate(sim, character)
+		} else {
+			success = spell.Cast(sim, character.CurrentTarget)
+		}
+		if !success && character.blockedByMovement {
+			character.blockedByMovement = false
+			cs.block(sim, proto.CastSequenceBlockReason_CastSequenceBlockMovement)
+			character.WaitUntil(sim, sim.movingUntil)
+			return
+		}
+		if !success {
+			cs.block(sim, proto.CastSequenceBlockReason_CastSequenceBlockResources)
+			character.WaitUntil(sim, sim.CurrentTime+castSequenceRetryDelay)
+			return
+		}
+		cs.finishCast(sim)
+
+		// Off-GCD casts leave the GCD ready, so keep going.
+		if !character.GCD.IsReady(sim) {
+			return
+		}
+	}
+}
+
+// Marks the next cast as blocked for the given reason.
+func (cs *castSequence) block(sim *Simulation, reason proto.CastSequenceBlockReason) {
+	if cs.blocked && cs.blockReason == reason {
+		return
+	}
+	cs.endBlock(sim.CurrentTime)
+
+	cs.blocked = true
+	cs.blockReason = reason
+	cs.blockedSince = sim.CurrentTime
+}
+
+func (cs *castSequence) endBlock(endTime time.Duration) {
+	if !cs.blocked {
+		return
+	}
+	key := castSequenceBlockKey{index: cs.nextIndex, reason: cs.blockReason}
+	cs.iterationBlocks[key] += endTime - cs.blockedSince
+	cs.blocked = false
+}
+
+func (cs *castSequence) finishCast(sim *Simulation) {
+	cs.endBlock(sim.CurrentTime)
+	cs.nextIndex++
+}
+
+func (cs *castSequence) doneIteration(sim *Simulation) {
+	cs.endBlock(sim.Duration)
+
+	for key, blocked := range cs.iterationBlocks {
+		metrics, ok := cs.metrics[key]
+		if !ok {
+			metrics = &castSequenceBlockMetrics{}
+			cs.metrics[key] = metrics
+			cs.blockOrder = append(cs.blockOrder, key)
+		}
+		metrics.iterations++
+		metrics.blocked += blocked
+	}
+}
+
+func (cs *castSequence) getMetricsProto() []*proto.CastSequenceBlockMetrics {
+	sort.Slice(cs.blockOrder, func(i, j int) bool {
+		a, b := cs.blockOrder[i], cs.blockOrder[j]
+		return a.index < b.index || (a.index == b.index && a.reason < b.reason)
+	})
+
+	var blocks []*proto.CastSequenceBlockMetrics
+	for _, key := range cs.blockOrder {
+		metrics := cs.metrics[key]
+		blocks = append(blocks, &proto.CastSequenceBlockMetrics{
+			Index:             int32(key.index),
+			ActionId:          cs.casts[key.index].actionID.ToProto(),
+			Reason:            key.reason,
+			Iterations:        metrics.iterations,
+			BlockedSecondsAvg: metrics.blocked.Seconds() / float64(metrics.iterations),
+		})
+	}
+	return blocks
+}
@@ -0,0 +1,92 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func spellActionID(id int32) *proto.ActionID {
+	return &proto.ActionID{RawId: &proto.ActionID_SpellId{SpellId: id}}
+}
+
+func TestCastSequence(t *testing.T) {
+	player := googleProto.Clone(testElementalShaman).(*proto.Player)
+	player.CastSequence = &proto.CastSequence{
+		Casts: []*proto.SequencedCast{
+			{ActionId: spellActionID(16166)}, // Elemental Mastery
+			{ActionId: spellActionID(25442)}, // Chain Lightning
+			{ActionId: spellActionID(25442)},
+			{ActionId: spellActionID(1)},
+			{ActionId: spellActionID(25449), AtSeconds: 20}, // Lightning Bolt
+		},
+	}
+
+	metrics := runTestSim(singlePlayerRaid(player), testEncounter, nil).RaidMetrics.Parties[0].Players[0]
+
+	casts := castsBySpell(metrics)
+	if casts[16166] != 1 || casts[25442] != 2 || casts[25449] != 1 || len(casts) != 3 {
+		t.Fatalf("Expected only the sequenced casts, but got %v", casts)
+	}
+
+	blocks := metrics.CastSequenceBlocks
+	if len(blocks) != 2 {
+		t.Fatalf("Expected 2 blocks, but got %d", len(blocks))
+	}
+	if blocks[0].Index != 2 || blocks[0].Reason != proto.CastSequenceBlockReason_CastSequenceBlockCooldown || blocks[0].BlockedSecondsAvg <= 0 {
+		t.Fatalf("Expected the second Chain Lightning to be blocked by its cooldown, but got %v", blocks[0])
+	}
+	if blocks[1].Index != 3 || blocks[1].Reason != proto.CastSequenceBlockReason_CastSequenceBlockUnavailable {
+		t.Fatalf("Expected the unknown spell to be unavailable, but got %v", blocks[1])
+	}
+}
+
+func TestCastSequenceReplacesHunterRotation(t *testing.T) {
+	player := googleProto.Clone(testHunter).(*proto.Player)
+	player.CastSequence = &proto.CastSequence{
+		Casts: []*proto.SequencedCast{
+			{ActionId: spellActionID(27019)}, // Arcane Shot
+		},
+	}
+
+	metrics := runTestSim(singlePlayerRaid(player), testEncounter, nil).RaidMetrics.Parties[0].Players[0]
+
+	casts := castsBySpell(metrics)
+	delete(casts, 0) // Auto attacks
+	if casts[27019] != 1 || len(casts) != 1 {
+		t.Fatalf("Expected only the sequenced casts, but got %v", casts)
+	}
+
+	numShots := int32(0)
+	for _, action := range metrics.Actions {
+		if action.Id.GetOtherId() == proto.OtherAction_OtherActionShoot {
+			for _, target := range action.Targets {
+				numShots += target.Casts
+			}
+		}
+	}
+	if numShots == 0 {
+		t.Fatalf("Expected ranged autos to be started")
+	}
+}
+
+func TestCastSequenceMissingActionID(t *testing.T) {
+	player := googleProto.Clone(testElementalShaman).(*proto.Player)
+	player.CastSequence = &proto.CastSequence{
+		Casts: []*proto.SequencedCast{
+			{},
+			{ActionId: spellActionID(25449)}, // Lightning Bolt
+		},
+	}
+
+	metrics := runTestSim(singlePlayerRaid(player), testEncounter, nil).RaidMetrics.Parties[0].Players[0]
+
+	if casts := castsBySpell(metrics); casts[25449] != 1 {
+		t.Fatalf("Expected the rest of the sequence to be cast, but got %v", casts)
+	}
+	blocks := metrics.CastSequenceBlocks
+	if len(blocks) != 1 || blocks[0].Index != 0 || blocks[0].Reason != proto.CastSequenceBlockReason_CastSequenceBlockUnavailable {
+		t.Fatalf("Expected the cast without an action to be unavailable, but got %v", blocks)
+	}
+}
@@ -57,6 +57,12 @@ type Character struct {
 	defensiveTrinketCD *Timer
 	offensiveTrinketCD *Timer
 	conjuredCD         *Timer
+
+	// If set, replaces the rotation. See cast_sequence.go.
+	castSequence *castSequence
+
+	// If set, replaces the rotation. See apl.go.
+	apl *aplRotation
 }
 
 func NewCharacter(party *Party, partyIndex int, player proto.Player) Character {
@@ -74,16 +80,30 @@ func NewCharacter(party *Party, partyIndex int, player proto.Player) Character {
 		Race:         player.Race,
 		ShattFaction: player.ShattFaction,
 		Class:        player.Class,
-		Equip:        items.ProtoToEquipment(*player.Equipment),
 
 		Party:      party,
 		PartyIndex: partyIndex,
 
 		majorCooldownManager: newMajorCooldownManager(player.Cooldowns),
+
+		castSequence: newCastSequence(player.CastSequence),
+		apl:          newAPLRotation(player.AplRotation),
+	}
+
+	if character.castSequence != nil && character.apl != nil {
+		panic("Cannot use both a cast sequence and an APL rotation")
+	}
+
+	if player.Equipment != nil {
+		character.Equip = items.ProtoToEquipment(*player.Equipment)
 	}
 
 	character.GCD = character.NewTimer()
 
+	if player.Latency != nil {
+		character.SetLatency(NewLatency(player.Latency))
+	}
+
 	character.Label = fmt.Sprintf("%s (#%d)", character.Name, character.Index+1)
 
 	if player.Consumes != nil {
@@ -231,6 +251,11 @@ func (character *Character) DefaultMeleeCritMultiplier() float64 {
 	return character.MeleeCritMultiplier(1, 0)
 }
 
+// Crit damage meta gems don't apply to heals.
+func (character *Character) DefaultHealingCritMultiplier() float64 {
+	return 1.5
+}
+
 func (character *Character) AddRaidBuffs(raidBuffs *proto.RaidBuffs) {
 }
 func (character *Character) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
@@ -275,17 +300,43 @@ func (character *Character) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
 func (character *Character) initialize(agent Agent) {
 	character.majorCooldownManager.initialize(character)
 
+	if character.RotationOverridden() && character.AutoAttacks.Ranged.SwingSpeed != 0 {
+		// Specs start ranged autos from their rotation, which won't run.
+		character.AutoAttacks.AutoSwingRanged = true
+	}
+
 	character.gcdAction = &PendingAction{
 		Priority: ActionPriorityGCD,
 		OnAction: func(sim *Simulation) {
+			// Other actions, e.g. a cast finishing, can restart the GCD timer.
+			if character.IsDead() {
+				return
+			}
+			if character.castSequence != nil {
+				character.castSequence.onGCDReady(sim, character)
+				return
+			}
+
 			character.TryUseCooldowns(sim)
 			if character.GCD.IsReady(sim) {
-				agent.OnGCDReady(sim)
+				if character.apl != nil {
+					character.apl.onGCDReady(sim, character)
+				} else {
+					agent.OnGCDReady(sim)
+				}
 			}
+			character.waitIfBlockedByMovement(sim)
 		},
 	}
 }
 
+// Returns true if a cast sequence or APL replaces the spec's rotation. Spec
+// hooks which run the rotation, e.g. from OnAutoAttack or OnManaTick, should
+// do nothing in this case.
+func (character *Character) RotationOverridden() bool {
+	return character.castSequence != nil || character.apl != nil
+}
+
 func (character *Character) Finalize() {
 	if character.Env.IsFinalized() {
 		return
@@ -303,12 +354,24 @@ func (character *Character) Finalize() {
 
 func (character *Character) init(sim *Simulation, agent Agent) {
 	character.Unit.init(sim)
+	if character.apl != nil {
+		// APLs are checked by validateAPLRotations before the sim runs.
+		if err := character.apl.init(character); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (character *Character) reset(sim *Simulation, agent Agent) {
 	character.ExpectedBonusMana = 0
 	character.majorCooldownManager.reset(sim)
 	character.Unit.reset(sim, agent)
+	if character.castSequence != nil {
+		character.castSequence.reset()
+	}
+	if character.apl != nil {
+		character.apl.reset(character)
+	}
 
 	if character.Type == PlayerUnit {
 		character.SetGCDTimer(sim, 0)
@@ -419,6 +482,10 @@ func (character *Character) doneIteration(sim *Simulation) {
 		}
 	}
 
+	if character.castSequence != nil {
+		character.castSequence.doneIteration(sim)
+	}
+
 	character.Unit.doneIteration(sim)
 }
 
@@ -440,6 +507,9 @@ func (character *Character) GetMetricsProto(numIterations int32) *proto.UnitMetr
 	metrics := character.Metrics.ToProto(numIterations)
 	metrics.Name = character.Name
 	metrics.Auras = character.auraTracker.GetMetricsProto(numIterations)
+	if character.castSequence != nil {
+		metrics.CastSequenceBlocks = character.castSequence.getMetricsProto()
+	}
 
 	metrics.Pets = []*proto.UnitMetrics{}
 	for _, petAgent := range character.Pets {
@@ -529,7 +529,7 @@ func registerPotionCD(agent Agent, consumes proto.Consumes) {
 		}
 		mageManaGemMCD = character.GetMajorCooldown(MageManaGemMCDActionID)
 
-		remainingUsages = int(1 + (MaxDuration(0, sim.Duration))/(time.Minute*2))
+		remainingUsages = int(1 + (MaxDuration(0, sim.GetMaxIterationDuration()))/(time.Minute*2))
 		if isStartingPotion {
 			remainingManaPotionUsages = MinInt(numStartingPotions, remainingUsages)
 		} else {
@@ -934,7 +934,7 @@ func registerConjuredCD(agent Agent, consumes proto.Consumes) {
 			ActivationFactory: func(sim *Simulation) CooldownActivation {
 				expectedManaPerUsage := float64((900 + 600) / 2)
 
-				remainingUsages := int(1 + (MaxDuration(0, sim.Duration))/(time.Minute*2))
+				remainingUsages := int(1 + (MaxDuration(0, sim.GetMaxIterationDuration()))/(time.Minute*2))
 
 				if consumes.DefaultConjured == proto.Conjured_ConjuredDarkRune {
 					character.ExpectedBonusMana += expectedManaPerUsage * float64(remainingUsages)
@@ -8,6 +8,19 @@ import (
 	"github.com/wowsims/tbc/sim/core/stats"
 )
 
+// Some debuffs are approximated with an uptime, for sims where the player
+// providing them isn't simmed. When a provider is in the raid the debuff comes
+// from them instead, e.g. ISB charges from a Warlock's Shadow Bolt crits.
+func clearDebuffApproximations(debuffs *proto.Debuffs, raid *Raid) {
+	for _, party := range raid.Parties {
+		for _, player := range party.Players {
+			if provider, ok := player.(DebuffApproximationProvider); ok {
+				provider.ClearDebuffApproximations(debuffs)
+			}
+		}
+	}
+}
+
 func applyDebuffEffects(target *Unit, debuffs proto.Debuffs) {
 	if debuffs.Misery {
 		MakePermanent(MiseryAura(target, 5))
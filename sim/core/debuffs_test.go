@@ -0,0 +1,41 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestDebuffApproximationsOnlyWithoutProvider(t *testing.T) {
+	runSim := func(players []*proto.Player, isbUptime float64) float64 {
+		raid := &proto.Raid{
+			Parties: []*proto.Party{{Players: players}},
+			Debuffs: &proto.Debuffs{IsbUptime: isbUptime},
+		}
+		return runTestSim(raid, testEncounter, nil).RaidMetrics.Dps.Avg
+	}
+
+	individual := []*proto.Player{testShadowPriest}
+	if runSim(individual, 1) <= runSim(individual, 0) {
+		t.Fatalf("Expected ISB uptime to increase dps in an individual sim")
+	}
+
+	noWarlock := []*proto.Player{testShadowPriest, testElementalShaman}
+	if runSim(noWarlock, 1) <= runSim(noWarlock, 0) {
+		t.Fatalf("Expected ISB uptime to increase dps in a raid without a Warlock")
+	}
+
+	withWarlock := []*proto.Player{testShadowPriest, testWarlock}
+	if withIsb, withoutIsb := runSim(withWarlock, 1), runSim(withWarlock, 0); withIsb != withoutIsb {
+		t.Fatalf("Expected ISB uptime to be ignored in a raid with a Warlock, but got %0.2f vs %0.2f", withIsb, withoutIsb)
+	}
+
+	// Incinerate doesn't proc ISB, so the approximation is still needed.
+	incinerateWarlock := googleProto.Clone(testWarlock).(*proto.Player)
+	incinerateWarlock.GetWarlock().Rotation.PrimarySpell = proto.Warlock_Rotation_Incinerate
+	withIncinerate := []*proto.Player{testShadowPriest, incinerateWarlock}
+	if runSim(withIncinerate, 1) <= runSim(withIncinerate, 0) {
+		t.Fatalf("Expected ISB uptime to increase dps in a raid with an Incinerate Warlock")
+	}
+}
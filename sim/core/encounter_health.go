@@ -0,0 +1,101 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Targets in health-based encounters enter execute range at this proportion of health.
+const executeHealthThreshold = 0.2
+
+// Hard limit on the length of a health-based iteration, in case the raid can't
+// kill the targets.
+const maxHealthEncounterDuration = time.Minute * 30
+
+// How often the estimated fight length is updated in health-based encounters.
+const healthEstimateInterval = time.Second
+
+// Health-based encounters need at least one target with a health value.
+func (encounter *Encounter) hasTargetHealth() bool {
+	for _, target := range encounter.Targets {
+		if target.GetStat(stats.Health) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (sim *Simulation) resetHealth() {
+	sim.targetsDead = false
+	if !sim.Encounter.UseHealth {
+		return
+	}
+
+	// The configured duration serves as the initial estimate until the raid has
+	// done some damage.
+	sim.Duration = sim.BaseDuration
+	if sim.Duration <= 0 {
+		sim.Duration = time.Minute * 3
+	}
+
+	pa := &PendingAction{
+		NextActionAt: healthEstimateInterval,
+		Priority:     ActionPriorityLow,
+	}
+	pa.OnAction = func(sim *Simulation) {
+		sim.estimateDuration()
+		pa.NextActionAt = sim.CurrentTime + healthEstimateInterval
+		sim.AddPendingAction(pa)
+	}
+	sim.AddPendingAction(pa)
+}
+
+// Updates sim.Duration based on the raid's damage so far, so that rotations
+// checking the remaining fight length see a sensible value.
+func (sim *Simulation) estimateDuration() {
+	totalHealth := 0.0
+	damageTaken := 0.0
+	for _, target := range sim.Encounter.Targets {
+		if health := target.GetStat(stats.Health); health > 0 {
+			totalHealth += health
+			damageTaken += MinFloat(health, target.damageTaken)
+		}
+	}
+	if damageTaken == 0 {
+		return
+	}
+
+	damagePerSecond := damageTaken / sim.CurrentTime.Seconds()
+	remaining := DurationFromSeconds((totalHealth - damageTaken) / damagePerSecond)
+	sim.Duration = MinDuration(sim.CurrentTime+MaxDuration(remaining, time.Millisecond), maxHealthEncounterDuration)
+}
+
+// Called whenever a target takes damage in a health-based encounter.
+func (sim *Simulation) checkTargetHealth(target *Unit) {
+	if !sim.executePhase && target == &sim.Encounter.Targets[0].Unit && target.CurrentHealthPercent() <= executeHealthThreshold {
+		sim.startExecutePhase()
+	}
+
+	if target.CurrentHealthPercent() > 0 {
+		return
+	}
+	for _, t := range sim.Encounter.Targets {
+		if t.GetStat(stats.Health) > 0 && t.CurrentHealthPercent() > 0 {
+			return
+		}
+	}
+
+	if sim.Log != nil {
+		sim.Log("All targets dead")
+	}
+	sim.targetsDead = true
+}
+
+// Returns true once the current iteration is over.
+func (sim *Simulation) isDone(pa *PendingAction) bool {
+	if sim.Encounter.UseHealth {
+		return sim.targetsDead || pa.NextActionAt > maxHealthEncounterDuration
+	}
+	return pa.NextActionAt > sim.Duration
+}
@@ -0,0 +1,71 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestHealthBasedEncounter(t *testing.T) {
+	target := &proto.Target{
+		Stats:   stats.Stats{stats.Armor: 7684, stats.Health: 500000}.ToFloatArray(),
+		MobType: proto.MobType_MobTypeDemon,
+	}
+	encounter := &proto.Encounter{
+		Duration:  300,
+		UseHealth: true,
+		Targets: []*proto.Target{
+			target,
+		},
+	}
+
+	result := runTestSim(testRaid, encounter, nil)
+
+	duration := result.DurationMetrics.Avg
+	if duration <= 0 || duration >= 300 {
+		t.Fatalf("Expected the fight to end when the target died, but lasted %0.2fs", duration)
+	}
+
+	damageDone := result.EncounterMetrics.Targets[0].Dtps.Avg * duration
+	if damageDone < 500000 {
+		t.Fatalf("Expected the target to take at least its health in damage, but took %0.0f", damageDone)
+	}
+}
+
+// Rotations which are planned ahead of time need to cover the whole fight,
+// even when it lasts longer than the configured duration.
+func TestHealthBasedEncounterScheduledRotation(t *testing.T) {
+	target := &proto.Target{
+		Stats:   stats.Stats{stats.Armor: 7684, stats.Health: 300000}.ToFloatArray(),
+		MobType: proto.MobType_MobTypeDemon,
+	}
+	encounter := &proto.Encounter{
+		Duration:  30,
+		UseHealth: true,
+		Targets: []*proto.Target{
+			target,
+		},
+	}
+
+	result := runTestSim(singlePlayerRaid(testEnhancementShaman), encounter, &proto.SimOptions{
+		Iterations: 1,
+		IsTest:     true,
+		Timeline:   &proto.TimelineOptions{},
+	})
+
+	duration := result.DurationMetrics.Avg
+	if duration <= 60 {
+		t.Fatalf("Expected the fight to outlast the configured duration, but lasted %0.2fs", duration)
+	}
+
+	lastStormstrike := 0.0
+	for _, event := range result.Timelines[0].Events {
+		if event.Type == proto.TimelineEventType_TimelineEventCastComplete && event.ActionId.GetSpellId() == 17364 {
+			lastStormstrike = event.Timestamp
+		}
+	}
+	if lastStormstrike < duration-30 {
+		t.Fatalf("Expected Stormstrike to be used until the end of the %0.2fs fight, but last cast was at %0.2fs", duration, lastStormstrike)
+	}
+}
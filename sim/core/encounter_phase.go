@@ -0,0 +1,310 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// A single phase of an encounter, e.g. a transition with adds or an
+// untargetable boss.
+type EncounterPhase struct {
+	Name string
+
+	// Time after the start of the previous phase at which this phase begins.
+	StartTime time.Duration
+
+	// Proportion of health of the previous phase's first active target at which
+	// this phase begins.
+	HealthThreshold float64
+
+	// Targets which can be attacked during this phase.
+	ActiveTargets []*Target
+
+	DamageTakenMultiplier float64
+
+	// Window, relative to the start of this phase, during which no targets can
+	// be attacked.
+	DowntimeStart    time.Duration
+	DowntimeDuration time.Duration
+}
+
+func newEncounterPhase(config *proto.EncounterPhase, targets []*Target) EncounterPhase {
+	phase := EncounterPhase{
+		Name:                  config.Name,
+		StartTime:             DurationFromSeconds(config.StartTime),
+		HealthThreshold:       config.HealthThreshold,
+		DamageTakenMultiplier: config.DamageTakenMultiplier,
+		DowntimeStart:         DurationFromSeconds(config.DowntimeStart),
+		DowntimeDuration:      DurationFromSeconds(config.DowntimeDuration),
+	}
+	if phase.DamageTakenMultiplier == 0 {
+		phase.DamageTakenMultiplier = 1
+	}
+
+	for _, targetIndex := range config.ActiveTargets {
+		if targetIndex >= 0 && targetIndex < int32(len(targets)) {
+			phase.ActiveTargets = append(phase.ActiveTargets, targets[targetIndex])
+		}
+	}
+	if len(phase.ActiveTargets) == 0 {
+		phase.ActiveTargets = targets
+	}
+
+	return phase
+}
+
+// Returns whether any phase of this encounter has a downtime window.
+func (encounter *Encounter) HasDowntime() bool {
+	for _, phase := range encounter.Phases {
+		if phase.DowntimeDuration > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (phase *EncounterPhase) isActive(target *Target) bool {
+	for _, activeTarget := range phase.ActiveTargets {
+		if activeTarget == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (sim *Simulation) RegisterPhaseChangeCallback(callback func(sim *Simulation, phaseIndex int32)) {
+	sim.phaseChangeCallbacks = append(sim.phaseChangeCallbacks, callback)
+}
+
+// Returns the index of the current encounter phase. Always 0 for encounters
+// without phases.
+func (sim *Simulation) CurrentPhase() int32 {
+	return sim.phaseIndex
+}
+
+// Returns the targets which can be attacked during the given phase. For
+// encounters without phases, this is all targets.
+func (encounter *Encounter) phaseTargets(phaseIndex int32) []*Target {
+	if len(encounter.Phases) == 0 {
+		return encounter.Targets
+	}
+	return encounter.Phases[phaseIndex].ActiveTargets
+}
+
+// Returns the targets which can be attacked in the current phase.
+func (sim *Simulation) GetActiveTargets() []*Target {
+	return sim.Encounter.phaseTargets(sim.phaseIndex)
+}
+
+// Like GetNumTargets, but only counts targets which can be attacked in the
+// current phase. AoE and cleave logic should use this, so inactive targets
+// (e.g. adds which haven't spawned yet) aren't counted.
+func (sim *Simulation) GetNumActiveTargets() int32 {
+	return int32(len(sim.GetActiveTargets()))
+}
+
+// Returns the active target after the given one, for effects which jump to a
+// second target such as Blade Flurry.
+func (sim *Simulation) NextActiveTargetUnit(target *Unit) *Unit {
+	activeTargets := sim.GetActiveTargets()
+	for i, activeTarget := range activeTargets {
+		if &activeTarget.Unit == target {
+			return &activeTargets[(i+1)%len(activeTargets)].Unit
+		}
+	}
+	return &activeTargets[0].Unit
+}
+
+// Returns effects for a spell which hits up to maxHits targets, which only
+// hits the targets that are active in the current phase.
+//
+// makeApplyEffects is called during construction, once for each distinct list
+// of targets which can be hit in some phase of the encounter. The targets are
+// in order, so the first one is the primary target, and there are at most
+// maxHits of them.
+func ApplyEffectFuncActiveTargets(env *Environment, maxHits int32, makeApplyEffects func(targets []*Unit) ApplySpellEffects) ApplySpellEffects {
+	numPhases := MaxInt(len(env.Encounter.Phases), 1)
+	applyByPhase := make([]ApplySpellEffects, numPhases)
+	applyByTargets := make(map[string]ApplySpellEffects)
+	for phaseIndex := range applyByPhase {
+		phaseTargets := env.Encounter.phaseTargets(int32(phaseIndex))
+		targets := make([]*Unit, MinInt(int(maxHits), len(phaseTargets)))
+		key := ""
+		for i := range targets {
+			targets[i] = &phaseTargets[i].Unit
+			key += fmt.Sprintf("%d,", targets[i].Index)
+		}
+
+		if _, ok := applyByTargets[key]; !ok {
+			applyByTargets[key] = makeApplyEffects(targets)
+		}
+		applyByPhase[phaseIndex] = applyByTargets[key]
+	}
+
+	if numPhases == 1 {
+		return applyByPhase[0]
+	}
+	return func(sim *Simulation, target *Unit, spell *Spell) {
+		applyByPhase[sim.phaseIndex](sim, target, spell)
+	}
+}
+
+// Returns true while no targets can be attacked due to an encounter phase.
+func (sim *Simulation) IsDowntime() bool {
+	return sim.CurrentTime < sim.downtimeEndsAt
+}
+
+func (sim *Simulation) resetPhases() {
+	sim.phaseIndex = 0
+	sim.phaseHealthTarget = nil
+	sim.nextPhaseAction = nil
+	sim.downtimeAction = nil
+	sim.downtimeEndsAt = 0
+
+	if len(sim.Encounter.Phases) == 0 {
+		return
+	}
+
+	for _, unit := range sim.Raid.AllUnits {
+		unit.CurrentTarget = &sim.Encounter.Targets[0].Unit
+	}
+	sim.startPhase(0)
+}
+
+func (sim *Simulation) startPhase(phaseIndex int32) {
+	if phaseIndex > 0 {
+		prevPhase := &sim.Encounter.Phases[sim.phaseIndex]
+		for _, target := range prevPhase.ActiveTargets {
+			target.PseudoStats.DamageTakenMultiplier /= prevPhase.DamageTakenMultiplier
+		}
+	}
+
+	sim.phaseIndex = phaseIndex
+	phase := &sim.Encounter.Phases[phaseIndex]
+	if sim.Log != nil {
+		sim.Log("Starting phase %d: %s", phaseIndex+1, phase.Name)
+	}
+
+	for _, target := range sim.Encounter.Targets {
+		if phase.isActive(target) {
+			target.PseudoStats.DamageTakenMultiplier *= phase.DamageTakenMultiplier
+			target.activate(sim)
+		} else {
+			target.deactivate(sim)
+		}
+	}
+
+	primaryTarget := &phase.ActiveTargets[0].Unit
+	for _, unit := range sim.Raid.AllUnits {
+		if unit.CurrentTarget.Type == EnemyUnit && !phase.isActive(sim.Encounter.Targets[unit.CurrentTarget.Index]) {
+			unit.CurrentTarget = primaryTarget
+		}
+	}
+
+	// Downtime belongs to the phase it was scheduled in, so drop it if this
+	// phase ends before the downtime starts.
+	if sim.downtimeAction != nil {
+		sim.downtimeAction.Cancel(sim)
+		sim.downtimeAction = nil
+	}
+	if phase.DowntimeDuration > 0 {
+		downtimeStart := sim.CurrentTime + phase.DowntimeStart
+		sim.downtimeAction = &PendingAction{
+			NextActionAt: downtimeStart,
+			Priority:     ActionPriorityAuto,
+			OnAction: func(sim *Simulation) {
+				sim.downtimeAction = nil
+				sim.startDowntime(downtimeStart + phase.DowntimeDuration)
+			},
+		}
+		sim.AddPendingAction(sim.downtimeAction)
+	}
+
+	sim.phaseHealthTarget = nil
+	sim.nextPhaseAction = nil
+	if int(phaseIndex)+1 < len(sim.Encounter.Phases) {
+		nextPhase := &sim.Encounter.Phases[phaseIndex+1]
+		if nextPhase.HealthThreshold > 0 {
+			sim.phaseHealthTarget = primaryTarget
+		}
+		if nextPhase.StartTime > 0 {
+			sim.nextPhaseAction = &PendingAction{
+				NextActionAt: sim.CurrentTime + nextPhase.StartTime,
+				Priority:     ActionPriorityAuto,
+				OnAction: func(sim *Simulation) {
+					sim.startPhase(phaseIndex + 1)
+				},
+			}
+			sim.AddPendingAction(sim.nextPhaseAction)
+		}
+	}
+
+	if phaseIndex > 0 {
+		for _, callback := range sim.phaseChangeCallbacks {
+			callback(sim, phaseIndex)
+		}
+	}
+}
+
+func (sim *Simulation) checkPhaseHealthThreshold() {
+	nextPhaseIndex := sim.phaseIndex + 1
+	if sim.phaseHealthTarget.CurrentHealthPercent() > sim.Encounter.Phases[nextPhaseIndex].HealthThreshold {
+		return
+	}
+
+	if sim.nextPhaseAction != nil {
+		sim.nextPhaseAction.Cancel(sim)
+	}
+	sim.startPhase(nextPhaseIndex)
+}
+
+// Pauses all players and their pets until the downtime ends.
+func (sim *Simulation) startDowntime(endsAt time.Duration) {
+	if sim.Log != nil {
+		sim.Log("Downtime for %s", endsAt-sim.CurrentTime)
+	}
+	sim.downtimeEndsAt = endsAt
+
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			character.pauseUntil(sim, endsAt)
+			for _, petAgent := range character.Pets {
+				if pet := petAgent.GetPet(); pet.IsEnabled() {
+					pet.pauseUntil(sim, endsAt)
+				}
+			}
+		}
+	}
+}
+
+func (unit *Unit) pauseUntil(sim *Simulation, readyAt time.Duration) {
+	if unit.gcdAction != nil && !unit.gcdAction.cancelled && unit.NextGCDAt() < readyAt {
+		unit.SetGCDTimer(sim, readyAt)
+	}
+	if unit.AutoAttacks.IsEnabled() && !unit.AutoAttacks.RangedSwingInProgress {
+		unit.AutoAttacks.DelayAllUntil(sim, readyAt)
+	}
+}
+
+// Resumes auto attacks and abilities for a target which becomes active.
+func (target *Target) activate(sim *Simulation) {
+	if target.AutoAttacks.IsEnabled() {
+		target.AutoAttacks.EnableAutoSwing(sim)
+	}
+	if target.gcdAction != nil && target.gcdAction.cancelled {
+		target.SetGCDTimer(sim, sim.CurrentTime)
+	}
+}
+
+// Stops auto attacks and abilities for a target which is no longer active.
+func (target *Target) deactivate(sim *Simulation) {
+	if target.AutoAttacks.IsEnabled() {
+		target.AutoAttacks.CancelAutoSwing(sim)
+	}
+	if target.gcdAction != nil {
+		target.CancelGCDTimer(sim)
+	}
+}
@@ -0,0 +1,93 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestEncounterPhases(t *testing.T) {
+	encounter := &proto.Encounter{
+		Duration: 300,
+		Targets: []*proto.Target{
+			testTarget,
+			testTarget,
+		},
+		Phases: []*proto.EncounterPhase{
+			{
+				Name:          "Phase 1",
+				ActiveTargets: []int32{0},
+			},
+			{
+				Name:                  "Phase 2",
+				StartTime:             150,
+				ActiveTargets:         []int32{1},
+				DamageTakenMultiplier: 1.5,
+				DowntimeDuration:      10,
+			},
+		},
+	}
+
+	result := runTestSim(testRaid, encounter, nil)
+	for i, targetMetrics := range result.EncounterMetrics.Targets {
+		if targetMetrics.Dtps.Avg == 0 {
+			t.Fatalf("Expected target %d to take damage during its phase", i+1)
+		}
+	}
+}
+
+func TestInactiveTargetsAvoidAoE(t *testing.T) {
+	encounter := &proto.Encounter{
+		Duration: 300,
+		Targets: []*proto.Target{
+			testTarget,
+			testTarget,
+			testTarget,
+		},
+		Phases: []*proto.EncounterPhase{
+			{
+				Name:          "Phase 1",
+				ActiveTargets: []int32{0},
+			},
+		},
+	}
+
+	result := runTestSim(singlePlayerRaid(testElementalShaman), encounter, nil)
+	if castsBySpell(result.RaidMetrics.Parties[0].Players[0])[25442] == 0 {
+		t.Fatalf("Expected Chain Lightning to be cast")
+	}
+	for i, targetMetrics := range result.EncounterMetrics.Targets[1:] {
+		if targetMetrics.Dtps.Avg != 0 {
+			t.Fatalf("Expected inactive target %d to take no damage, but took %0.03f DTPS", i+2, targetMetrics.Dtps.Avg)
+		}
+	}
+}
+
+func TestDowntimeCancelledByPhaseChange(t *testing.T) {
+	makeEncounter := func(downtimeDuration float64) *proto.Encounter {
+		return &proto.Encounter{
+			Duration: 300,
+			Targets: []*proto.Target{
+				testTarget,
+			},
+			Phases: []*proto.EncounterPhase{
+				{
+					Name:             "Phase 1",
+					DowntimeStart:    100,
+					DowntimeDuration: downtimeDuration,
+				},
+				{
+					Name:      "Phase 2",
+					StartTime: 50,
+				},
+			},
+		}
+	}
+
+	// Phase 2 starts before phase 1's downtime would, so it should never happen.
+	withDowntime := runTestSim(singlePlayerRaid(testElementalShaman), makeEncounter(30), nil)
+	withoutDowntime := runTestSim(singlePlayerRaid(testElementalShaman), makeEncounter(0), nil)
+	if withDowntime.RaidMetrics.Dps.Avg != withoutDowntime.RaidMetrics.Dps.Avg {
+		t.Fatalf("Expected no downtime after the phase changed, but DPS went from %0.03f to %0.03f", withoutDowntime.RaidMetrics.Dps.Avg, withDowntime.RaidMetrics.Dps.Avg)
+	}
+}
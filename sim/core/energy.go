@@ -61,6 +61,9 @@ func (eb *energyBar) addEnergyInternal(sim *Simulation, amount float64, actionID
 	if sim.Log != nil {
 		eb.unit.Log(sim, "Gained %0.3f energy from %s (%0.3f --> %0.3f).", amount, actionID, eb.currentEnergy, newEnergy)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(eb.unit, actionID, proto.ResourceType_ResourceTypeEnergy, eb.currentEnergy, newEnergy)
+	}
 
 	eb.currentEnergy = newEnergy
 }
@@ -80,6 +83,9 @@ func (eb *energyBar) SpendEnergy(sim *Simulation, amount float64, actionID Actio
 	if sim.Log != nil {
 		eb.unit.Log(sim, "Spent %0.3f energy from %s (%0.3f --> %0.3f).", amount, actionID, eb.currentEnergy, newEnergy)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(eb.unit, actionID, proto.ResourceType_ResourceTypeEnergy, eb.currentEnergy, newEnergy)
+	}
 
 	eb.currentEnergy = newEnergy
 }
@@ -95,6 +101,9 @@ func (eb *energyBar) AddComboPoints(sim *Simulation, pointsToAdd int32, actionID
 	if sim.Log != nil {
 		eb.unit.Log(sim, "Gained %d combo points from %s (%d --> %d)", pointsToAdd, actionID, eb.comboPoints, newComboPoints)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(eb.unit, actionID, proto.ResourceType_ResourceTypeComboPoints, float64(eb.comboPoints), float64(newComboPoints))
+	}
 
 	eb.comboPoints = newComboPoints
 }
@@ -114,6 +123,9 @@ func (eb *energyBar) SpendComboPoints(sim *Simulation, actionID ActionID) {
 	if sim.Log != nil {
 		eb.unit.Log(sim, "Spent %d combo points from %s (%d --> %d).", eb.comboPoints, actionID, eb.comboPoints, 0)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(eb.unit, actionID, proto.ResourceType_ResourceTypeComboPoints, float64(eb.comboPoints), 0)
+	}
 	eb.unit.Metrics.AddResourceEvent(actionID, proto.ResourceType_ResourceTypeComboPoints, float64(-eb.comboPoints), float64(-eb.comboPoints))
 	eb.comboPoints = 0
 }
@@ -59,7 +59,9 @@ func (env *Environment) construct(raidProto proto.Raid, encounterProto proto.Enc
 
 	// Apply extra debuffs from raid.
 	if raidProto.Debuffs != nil && len(env.Encounter.Targets) > 0 {
-		applyDebuffEffects(&env.Encounter.Targets[0].Unit, *raidProto.Debuffs)
+		debuffs := *raidProto.Debuffs
+		clearDebuffApproximations(&debuffs, env.Raid)
+		applyDebuffEffects(&env.Encounter.Targets[0].Unit, debuffs)
 	}
 
 	// Assign target or target using Tanks field.
@@ -144,6 +146,9 @@ func (env *Environment) IsFinalized() bool {
 
 // The maximum possible duration for any iteration.
 func (env *Environment) GetMaxDuration() time.Duration {
+	if env.Encounter.UseHealth {
+		return maxHealthEncounterDuration
+	}
 	return env.BaseDuration + env.DurationVariation
 }
 
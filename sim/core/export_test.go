@@ -0,0 +1,5 @@
+package core
+
+// Exposes internals to the external core_test package.
+
+var RunSimConcurrent = runSimConcurrent
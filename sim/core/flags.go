@@ -51,6 +51,7 @@ const (
 	ProcMaskRangedSpecial
 	ProcMaskSpellDamage
 	ProcMaskPeriodicDamage
+	ProcMaskSpellHealing
 )
 
 const (
@@ -158,6 +159,45 @@ func (ho HitOutcome) PartialResistString() string {
 	}
 }
 
+func (ho HitOutcome) ToProto() proto.HitOutcome {
+	if ho.Matches(OutcomeMiss) {
+		return proto.HitOutcome_HitOutcomeMiss
+	} else if ho.Matches(OutcomeDodge) {
+		return proto.HitOutcome_HitOutcomeDodge
+	} else if ho.Matches(OutcomeParry) {
+		return proto.HitOutcome_HitOutcomeParry
+	} else if ho.Matches(OutcomeGlance) {
+		return proto.HitOutcome_HitOutcomeGlance
+	} else if ho.Matches(OutcomeBlock) {
+		if ho.Matches(OutcomeCrit) {
+			return proto.HitOutcome_HitOutcomeCriticalBlock
+		} else {
+			return proto.HitOutcome_HitOutcomeBlock
+		}
+	} else if ho.Matches(OutcomeCrit) {
+		return proto.HitOutcome_HitOutcomeCrit
+	} else if ho.Matches(OutcomeHit) {
+		return proto.HitOutcome_HitOutcomeHit
+	} else if ho.Matches(OutcomeCrush) {
+		return proto.HitOutcome_HitOutcomeCrush
+	} else {
+		return proto.HitOutcome_HitOutcomeEmpty
+	}
+}
+
+// Number of quarters of a partial resist, from 0-3.
+func (ho HitOutcome) PartialResistQuarters() int32 {
+	if ho.Matches(OutcomePartial1_4) {
+		return 1
+	} else if ho.Matches(OutcomePartial2_4) {
+		return 2
+	} else if ho.Matches(OutcomePartial3_4) {
+		return 3
+	} else {
+		return 0
+	}
+}
+
 // Other flags
 type SpellExtras uint16
 
@@ -77,6 +77,7 @@ func (unit *Unit) FinishedWaitingForManaAndGCDReady(sim *Simulation) bool {
 
 func (unit *Unit) WaitUntil(sim *Simulation, readyTime time.Duration) {
 	unit.waitStartTime = sim.CurrentTime
+	readyTime += unit.ReactionTime(sim)
 	unit.SetGCDTimer(sim, readyTime)
 	if sim.Log != nil {
 		unit.Log(sim, "Pausing GCD for %s due to rotation / CDs.", readyTime-sim.CurrentTime)
@@ -95,6 +96,11 @@ func (unit *Unit) HardcastWaitUntil(sim *Simulation, readyTime time.Duration, on
 }
 
 func (unit *Unit) WaitForMana(sim *Simulation, desiredMana float64) {
+	// A cast blocked by movement isn't out of mana, so wait for movement instead.
+	if unit.CurrentMana() >= desiredMana && unit.waitIfBlockedByMovement(sim) {
+		return
+	}
+
 	if !unit.IsWaitingForMana() {
 		unit.waitStartTime = sim.CurrentTime
 	}
@@ -0,0 +1,577 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Default number of candidates which are verified with full sims.
+const defaultGearOptimizerSims = 5
+
+// Number of partial gear sets kept after each slot is filled. The best set for
+// each combination of set pieces (and meta gem colors) is always kept as well,
+// so that set bonuses and meta gems aren't pruned before they are complete.
+const gearOptimizerBeamWidth = 200
+
+const numItemSlots = len(items.Equipment{})
+
+// One way of filling a slot: an item with its enchant and gems.
+type gearOption struct {
+	item items.Item
+	ep   float64
+}
+
+func (option *gearOption) isEmpty() bool {
+	return option.item.ID == 0
+}
+
+// A full or partial gear set.
+type gearSet struct {
+	slots [numItemSlots]*gearOption
+	ep    float64
+}
+
+func (set *gearSet) equipment() items.Equipment {
+	equipment := items.Equipment{}
+	for i, option := range set.slots {
+		if option != nil {
+			equipment[i] = option.item
+		}
+	}
+	return equipment
+}
+
+type gearOptimizer struct {
+	epValues stats.Stats
+
+	gems     []items.Gem
+	metaGems []items.Gem
+
+	// Whether meta gem colors need to be tracked during the search.
+	hasMetaRequirements bool
+}
+
+// Candidate gear sets are scored by EP and pruned with a beam search, then the
+// best few are simmed so that procs, meta gem effects and set bonuses are
+// accounted for.
+func runGearOptimizer(request *proto.GearOptimizeRequest) *proto.GearOptimizeResult {
+	if request.Player == nil {
+		return &proto.GearOptimizeResult{ErrorResult: "Missing player"}
+	}
+	if request.SimOptions == nil {
+		return &proto.GearOptimizeResult{ErrorResult: "Missing sim options"}
+	}
+
+	optimizer := &gearOptimizer{}
+	for _, gemID := range request.Gems {
+		gem, ok := items.GemsByID[gemID]
+		if !ok {
+			return &proto.GearOptimizeResult{ErrorResult: fmt.Sprintf("No gem with id: %d", gemID)}
+		}
+		if gem.Color == proto.GemColor_GemColorMeta {
+			optimizer.metaGems = append(optimizer.metaGems, gem)
+			if _, ok := items.MetaGemRequirements[gemID]; ok {
+				optimizer.hasMetaRequirements = true
+			}
+		} else {
+			optimizer.gems = append(optimizer.gems, gem)
+		}
+	}
+
+	slotOptions, err := optimizer.getSlotOptions(request)
+	if err != nil {
+		return &proto.GearOptimizeResult{ErrorResult: err.Error()}
+	}
+	if len(request.EpValues) > 0 {
+		optimizer.epValues = stats.FromFloatArray(request.EpValues)
+	} else {
+		optimizer.epValues, err = optimizer.computeEpValues(request, slotOptions)
+		if err != nil {
+			return &proto.GearOptimizeResult{ErrorResult: err.Error()}
+		}
+	}
+	for _, options := range slotOptions {
+		for _, option := range options {
+			option.ep = optimizer.itemEP(option.item)
+		}
+	}
+
+	numSims := int(request.NumSims)
+	if numSims <= 0 {
+		numSims = defaultGearOptimizerSims
+	}
+	candidates := optimizer.search(slotOptions, numSims)
+
+	result := &proto.GearOptimizeResult{}
+	for _, candidate := range candidates {
+		equipment := candidate.equipment()
+		player := googleProto.Clone(request.Player).(*proto.Player)
+		player.Equipment = equipment.ToEquipmentSpecProto()
+
+		raidProto := SinglePlayerRaidProto(player, request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+		raidProto.Tanks = request.Tanks
+		simResult := RunRaidSim(&proto.RaidSimRequest{
+			Raid:       raidProto,
+			Encounter:  request.Encounter,
+			SimOptions: request.SimOptions,
+		})
+
+		result.Candidates = append(result.Candidates, &proto.GearOptimizeCandidate{
+			Equipment: player.Equipment,
+			Ep:        candidate.ep,
+			Dps:       simResult.RaidMetrics.Parties[0].Players[0].Dps,
+		})
+	}
+
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		return result.Candidates[i].Dps.Avg > result.Candidates[j].Dps.Avg
+	})
+	if len(result.Candidates) > 0 {
+		result.Equipment = result.Candidates[0].Equipment
+	}
+	return result
+}
+
+// Returns all the options for each slot. Slots without candidates only have
+// the player's current item.
+func (optimizer *gearOptimizer) getSlotOptions(request *proto.GearOptimizeRequest) ([numItemSlots][]*gearOption, error) {
+	slotOptions := [numItemSlots][]*gearOption{}
+
+	currentEquipment := items.Equipment{}
+	if request.Player.Equipment != nil {
+		currentEquipment = items.ProtoToEquipment(*request.Player.Equipment)
+	}
+	for slot := range slotOptions {
+		slotOptions[slot] = []*gearOption{{item: currentEquipment[slot]}}
+	}
+
+	for _, candidates := range request.Slots {
+		slot := items.ItemSlot(candidates.Slot)
+		enchantIDs := candidates.Enchants
+		if len(enchantIDs) == 0 {
+			enchantIDs = []int32{0}
+		}
+
+		itemIDs := candidates.Items
+		if len(itemIDs) == 0 && request.ItemFilter != nil {
+			for _, item := range items.Items {
+				itemIDs = append(itemIDs, item.ID)
+			}
+		}
+
+		options := []*gearOption{}
+		for _, itemID := range itemIDs {
+			item, ok := items.ByID[itemID]
+			if !ok {
+				return slotOptions, fmt.Errorf("No item with id: %d", itemID)
+			}
+			if !itemFitsSlot(item, slot) || !itemFilterMatches(request.ItemFilter, item) {
+				continue
+			}
+
+			// Random suffix items are tried with each of their suffixes.
+			suffixedItems := []items.Item{item}
+			if len(item.RandomSuffixOptions) > 0 {
+				suffixedItems = nil
+				for _, suffixID := range item.RandomSuffixOptions {
+					suffixedItem, err := item.WithRandomSuffix(suffixID)
+					if err != nil {
+						return slotOptions, err
+					}
+					suffixedItems = append(suffixedItems, suffixedItem)
+				}
+			}
+
+			for _, suffixedItem := range suffixedItems {
+				for _, enchantID := range enchantIDs {
+					enchantedItem := suffixedItem
+					if enchantID != 0 {
+						enchant, ok := items.EnchantsByID[enchantID]
+						if !ok {
+							return slotOptions, fmt.Errorf("No enchant with id: %d", enchantID)
+						}
+						if !enchantAppliesToItem(enchant, item) {
+							continue
+						}
+						enchantedItem.Enchant = enchant
+					}
+
+					for _, gems := range optimizer.gemVariants(item) {
+						option := &gearOption{item: enchantedItem}
+						option.item.Gems = gems
+						options = append(options, option)
+					}
+				}
+			}
+		}
+		slotOptions[slot] = options
+	}
+
+	// Two-handers need an empty off hand.
+	for _, option := range slotOptions[items.ItemSlotMainHand] {
+		if option.item.HandType == proto.HandType_HandTypeTwoHand {
+			slotOptions[items.ItemSlotOffHand] = append(slotOptions[items.ItemSlotOffHand], &gearOption{})
+			break
+		}
+	}
+
+	return slotOptions, nil
+}
+
+func itemFitsSlot(item items.Item, slot items.ItemSlot) bool {
+	switch item.Type {
+	case proto.ItemType_ItemTypeFinger:
+		return slot == items.ItemSlotFinger1 || slot == items.ItemSlotFinger2
+	case proto.ItemType_ItemTypeTrinket:
+		return slot == items.ItemSlotTrinket1 || slot == items.ItemSlotTrinket2
+	case proto.ItemType_ItemTypeWeapon:
+		switch item.HandType {
+		case proto.HandType_HandTypeMainHand, proto.HandType_HandTypeTwoHand:
+			return slot == items.ItemSlotMainHand
+		case proto.HandType_HandTypeOffHand:
+			return slot == items.ItemSlotOffHand
+		default:
+			return slot == items.ItemSlotMainHand || slot == items.ItemSlotOffHand
+		}
+	}
+	return items.ItemTypeToSlot(item.Type) == slot
+}
+
+func enchantAppliesToItem(enchant items.Enchant, item items.Item) bool {
+	if enchant.ItemType != item.Type {
+		return false
+	}
+	if enchant.EnchantType == proto.EnchantType_EnchantTypeTwoHand && item.HandType != proto.HandType_HandTypeTwoHand {
+		return false
+	}
+	if (enchant.EnchantType == proto.EnchantType_EnchantTypeShield) != (item.WeaponType == proto.WeaponType_WeaponTypeShield) {
+		return false
+	}
+	if item.WeaponType == proto.WeaponType_WeaponTypeOffHand {
+		return false
+	}
+	if item.Type == proto.ItemType_ItemTypeRanged {
+		switch item.RangedWeaponType {
+		case proto.RangedWeaponType_RangedWeaponTypeBow, proto.RangedWeaponType_RangedWeaponTypeCrossbow, proto.RangedWeaponType_RangedWeaponTypeGun:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the ways of gemming an item which are worth considering: matching
+// the socket colors, ignoring them, and using a single color so that meta gem
+// requirements can be met. Each is tried with and without unique gems.
+func (optimizer *gearOptimizer) gemVariants(item items.Item) [][]items.Gem {
+	if len(item.GemSockets) == 0 || (len(optimizer.gems) == 0 && len(optimizer.metaGems) == 0) {
+		return [][]items.Gem{nil}
+	}
+
+	colorFilters := []func(gem items.Gem, socketColor proto.GemColor) bool{
+		func(gem items.Gem, socketColor proto.GemColor) bool {
+			return items.ColorIntersects(gem.Color, socketColor)
+		},
+		func(gem items.Gem, socketColor proto.GemColor) bool {
+			return true
+		},
+	}
+	for _, color := range []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue} {
+		color := color
+		colorFilters = append(colorFilters, func(gem items.Gem, _ proto.GemColor) bool {
+			return items.ColorIntersects(gem.Color, color)
+		})
+	}
+
+	metaOptions := []items.Gem{{}}
+	for _, socketColor := range item.GemSockets {
+		if socketColor == proto.GemColor_GemColorMeta {
+			metaOptions = append(metaOptions, optimizer.metaGems...)
+			break
+		}
+	}
+
+	variants := [][]items.Gem{}
+	seen := map[string]struct{}{}
+	for _, allowUnique := range []bool{true, false} {
+		for _, filter := range colorFilters {
+			for _, meta := range metaOptions {
+				gems := make([]items.Gem, len(item.GemSockets))
+				usedUnique := map[int32]struct{}{}
+				for i, socketColor := range item.GemSockets {
+					if socketColor == proto.GemColor_GemColorMeta {
+						gems[i] = meta
+						continue
+					}
+					if best, ok := optimizer.bestGem(socketColor, filter, allowUnique, usedUnique); ok {
+						gems[i] = best
+						if best.Unique {
+							usedUnique[best.ID] = struct{}{}
+						}
+					}
+				}
+
+				key := gemsKey(gems)
+				if _, ok := seen[key]; !ok {
+					seen[key] = struct{}{}
+					variants = append(variants, gems)
+				}
+			}
+		}
+	}
+	return variants
+}
+
+func (optimizer *gearOptimizer) bestGem(socketColor proto.GemColor, filter func(items.Gem, proto.GemColor) bool, allowUnique bool, usedUnique map[int32]struct{}) (items.Gem, bool) {
+	best := items.Gem{}
+	bestEP := 0.0
+	found := false
+	for _, gem := range optimizer.gems {
+		if gem.Unique {
+			if _, used := usedUnique[gem.ID]; used || !allowUnique {
+				continue
+			}
+		}
+		if !filter(gem, socketColor) {
+			continue
+		}
+		if ep := optimizer.statsEP(gem.Stats); !found || ep > bestEP {
+			best = gem
+			bestEP = ep
+			found = true
+		}
+	}
+	return best, found
+}
+
+func gemsKey(gems []items.Gem) string {
+	ids := make([]string, len(gems))
+	for i, gem := range gems {
+		ids[i] = fmt.Sprintf("%d", gem.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func (optimizer *gearOptimizer) statsEP(s stats.Stats) float64 {
+	ep := 0.0
+	for _, value := range s.DotProduct(optimizer.epValues) {
+		ep += value
+	}
+	return ep
+}
+
+// EP of an item, including enchant, gems and socket bonus.
+func (optimizer *gearOptimizer) itemEP(item items.Item) float64 {
+	equipment := items.Equipment{item}
+	return optimizer.statsEP(equipment.Stats())
+}
+
+// Computes EP values for every stat which appears on the candidates, relative
+// to the request's reference stat.
+func (optimizer *gearOptimizer) computeEpValues(request *proto.GearOptimizeRequest, slotOptions [numItemSlots][]*gearOption) (stats.Stats, error) {
+	referenceStat := stats.Stat(request.EpReferenceStat)
+
+	var candidateStats [stats.Len]bool
+	candidateStats[referenceStat] = true
+	addStats := func(s stats.Stats) {
+		for stat, value := range s {
+			if value != 0 {
+				candidateStats[stat] = true
+			}
+		}
+	}
+	for _, options := range slotOptions {
+		for _, option := range options {
+			addStats(option.item.Stats)
+			addStats(option.item.Enchant.Bonus)
+			addStats(option.item.SocketBonus)
+		}
+	}
+	for _, gem := range append(optimizer.gems, optimizer.metaGems...) {
+		addStats(gem.Stats)
+	}
+
+	statsToWeigh := []stats.Stat{}
+	for stat, present := range candidateStats {
+		if present {
+			statsToWeigh = append(statsToWeigh, stats.Stat(stat))
+		}
+	}
+
+	swr := proto.StatWeightsRequest{
+		Player:     request.Player,
+		RaidBuffs:  request.RaidBuffs,
+		PartyBuffs: request.PartyBuffs,
+		Debuffs:    request.Debuffs,
+		Encounter:  request.Encounter,
+		SimOptions: request.SimOptions,
+		Tanks:      request.Tanks,
+	}
+	result := CalcStatWeight(context.Background(), swr, statsToWeigh, referenceStat, nil)
+	if !(result.Dps.Weights[referenceStat] > 0) {
+		return stats.Stats{}, fmt.Errorf("%s does not increase DPS, so it can't be used as the EP reference stat", referenceStat.StatName())
+	}
+	return result.Dps.EpValues, nil
+}
+
+// Beam search over all slots, returning up to numSims of the best valid gear
+// sets, plus the best set for each combination of active set bonuses.
+func (optimizer *gearOptimizer) search(slotOptions [numItemSlots][]*gearOption, numSims int) []*gearSet {
+	sets := []*gearSet{{}}
+	for slot, options := range slotOptions {
+		next := []*gearSet{}
+		for _, set := range sets {
+			for _, option := range options {
+				if !optimizer.isCompatible(set, items.ItemSlot(slot), option) {
+					continue
+				}
+				newSet := &gearSet{slots: set.slots, ep: set.ep + option.ep}
+				newSet.slots[slot] = option
+				next = append(next, newSet)
+			}
+		}
+		sets = optimizer.prune(next, gearOptimizerBeamWidth, optimizer.searchSignature)
+	}
+
+	valid := []*gearSet{}
+	seen := map[string]struct{}{}
+	for _, set := range sets {
+		if !set.equipment().MetaGemIsActive() {
+			continue
+		}
+		key := gearSetKey(set)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		valid = append(valid, set)
+	}
+
+	return optimizer.prune(valid, numSims, activeSetBonusesSignature)
+}
+
+// Sorts sets by EP, and keeps the best n plus the best set for each signature.
+func (optimizer *gearOptimizer) prune(sets []*gearSet, n int, signature func(*gearSet) string) []*gearSet {
+	sort.SliceStable(sets, func(i, j int) bool {
+		return sets[i].ep > sets[j].ep
+	})
+
+	kept := []*gearSet{}
+	seenSignatures := map[string]struct{}{}
+	for i, set := range sets {
+		sig := signature(set)
+		_, seen := seenSignatures[sig]
+		if i < n || !seen {
+			kept = append(kept, set)
+		}
+		seenSignatures[sig] = struct{}{}
+	}
+	return kept
+}
+
+// Partial sets with different set pieces (or meta gem colors) could end up
+// with different bonuses once complete, so they are never pruned in favor of
+// each other.
+func (optimizer *gearOptimizer) searchSignature(set *gearSet) string {
+	sig := setPiecesSignature(set, false)
+	if optimizer.hasMetaRequirements {
+		counts := set.equipment().GemColorCounts()
+		sig += fmt.Sprintf("|%d,%d,%d", MinInt(counts.Red, 5), MinInt(counts.Yellow, 5), MinInt(counts.Blue, 5))
+	}
+	return sig
+}
+
+func activeSetBonusesSignature(set *gearSet) string {
+	return setPiecesSignature(set, true)
+}
+
+// Lists the number of pieces from each item set. If activeOnly is set, only
+// counts which activate a bonus are included.
+func setPiecesSignature(set *gearSet, activeOnly bool) string {
+	pieces := map[*ItemSet]int32{}
+	for _, option := range set.slots {
+		if option == nil {
+			continue
+		}
+		if itemSet := itemSetLookup[option.item.ID]; itemSet != nil {
+			pieces[itemSet]++
+		}
+	}
+
+	parts := []string{}
+	for itemSet, count := range pieces {
+		if activeOnly {
+			activeCount := int32(0)
+			for numPieces := range itemSet.Bonuses {
+				if numPieces <= count && numPieces > activeCount {
+					activeCount = numPieces
+				}
+			}
+			count = activeCount
+		}
+		if count > 0 {
+			parts = append(parts, fmt.Sprintf("%s:%d", itemSet.Name, count))
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+// Returns a key identifying the gear in a set, treating both ring and both
+// trinket slots as interchangeable.
+func gearSetKey(set *gearSet) string {
+	keys := make([]string, numItemSlots)
+	for i, option := range set.slots {
+		if option != nil {
+			keys[i] = fmt.Sprintf("%d/%d/%d/%s", option.item.ID, option.item.RandomSuffix.ID, option.item.Enchant.ID, gemsKey(option.item.Gems))
+		}
+	}
+	if keys[items.ItemSlotFinger1] > keys[items.ItemSlotFinger2] {
+		keys[items.ItemSlotFinger1], keys[items.ItemSlotFinger2] = keys[items.ItemSlotFinger2], keys[items.ItemSlotFinger1]
+	}
+	if keys[items.ItemSlotTrinket1] > keys[items.ItemSlotTrinket2] {
+		keys[items.ItemSlotTrinket1], keys[items.ItemSlotTrinket2] = keys[items.ItemSlotTrinket2], keys[items.ItemSlotTrinket1]
+	}
+	return strings.Join(keys, "|")
+}
+
+// Checks unique items, unique gems and weapon combinations.
+func (optimizer *gearOptimizer) isCompatible(set *gearSet, slot items.ItemSlot, option *gearOption) bool {
+	if option.isEmpty() {
+		return true
+	}
+
+	if slot == items.ItemSlotOffHand {
+		mainHand := set.slots[items.ItemSlotMainHand]
+		if mainHand != nil && mainHand.item.HandType == proto.HandType_HandTypeTwoHand {
+			return false
+		}
+	}
+
+	for _, other := range set.slots {
+		if other == nil || other.isEmpty() {
+			continue
+		}
+		if option.item.Unique && other.item.ID == option.item.ID {
+			return false
+		}
+		for _, gem := range option.item.Gems {
+			if !gem.Unique {
+				continue
+			}
+			for _, otherGem := range other.item.Gems {
+				if otherGem.ID == gem.ID {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
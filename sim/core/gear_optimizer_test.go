@@ -0,0 +1,165 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestGearOptimizer(t *testing.T) {
+	request := &proto.GearOptimizeRequest{
+		Player:     testElementalShaman,
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  testEncounter,
+		SimOptions: testSimOptions,
+		Slots: []*proto.GearSlotCandidates{
+			{Slot: proto.ItemSlot_ItemSlotHead, Items: []int32{29035}},
+			{Slot: proto.ItemSlot_ItemSlotShoulder, Items: []int32{29037}},
+			{Slot: proto.ItemSlot_ItemSlotChest, Items: []int32{29519}},
+			{Slot: proto.ItemSlot_ItemSlotHands, Items: []int32{28780}},
+			{Slot: proto.ItemSlot_ItemSlotWaist, Items: []int32{29520}},
+		},
+		// Chaotic Skyfire Diamond, Runed Living Ruby, Glowing Nightseye, Runed Ornate Ruby
+		Gems:     []int32{34220, 24030, 24056, 28118},
+		EpValues: stats.Stats{stats.SpellPower: 1, stats.SpellCrit: 0.8, stats.Stamina: 0.1}.ToFloatArray(),
+		NumSims:  2,
+	}
+
+	result := core.OptimizeGear(request)
+	if len(result.Candidates) == 0 || result.Candidates[0].Dps.Avg <= 0 {
+		t.Fatalf("Expected simmed candidates")
+	}
+
+	numBlue := 0
+	numOrnate := 0
+	hasMeta := false
+	for _, item := range result.Equipment.Items {
+		for _, gem := range item.Gems {
+			switch gem {
+			case 34220:
+				hasMeta = true
+			case 24056:
+				numBlue++
+			case 28118:
+				numOrnate++
+			}
+		}
+	}
+	if !hasMeta || numBlue < 2 {
+		t.Fatalf("Expected an active meta gem, but got %d blue gems", numBlue)
+	}
+	if numOrnate > 1 {
+		t.Fatalf("Expected unique gem to be used at most once, but got %d", numOrnate)
+	}
+}
+
+func TestGearOptimizerComputesEpValues(t *testing.T) {
+	request := &proto.GearOptimizeRequest{
+		Player:     testElementalShaman,
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  testEncounter,
+		SimOptions: testSimOptions,
+		Slots: []*proto.GearSlotCandidates{
+			{Slot: proto.ItemSlot_ItemSlotHands, Items: []int32{28780, 27465}},
+		},
+		// Chaotic Skyfire Diamond, Runed Living Ruby, Glowing Nightseye
+		Gems:            []int32{34220, 24030, 24056},
+		EpReferenceStat: proto.Stat_StatSpellPower,
+		NumSims:         2,
+	}
+
+	result := core.OptimizeGear(request)
+	if result.ErrorResult != "" {
+		t.Fatalf("Unexpected error: %s", result.ErrorResult)
+	}
+	if len(result.Candidates) == 0 || result.Candidates[0].Dps.Avg <= 0 {
+		t.Fatalf("Expected simmed candidates")
+	}
+	if result.Candidates[0].Ep <= 0 {
+		t.Fatalf("Expected a positive EP for the best candidate, got %0.2f", result.Candidates[0].Ep)
+	}
+}
+
+func TestGearOptimizerItemFilter(t *testing.T) {
+	request := &proto.GearOptimizeRequest{
+		Player:     testElementalShaman,
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  testEncounter,
+		SimOptions: testSimOptions,
+		Slots: []*proto.GearSlotCandidates{
+			// No items, so every Black Temple item for the slot is a candidate.
+			{Slot: proto.ItemSlot_ItemSlotHands},
+			// Only the Black Temple item is a candidate.
+			{Slot: proto.ItemSlot_ItemSlotFinger1, Items: []int32{29305, 32497}},
+		},
+		// Chaotic Skyfire Diamond, Runed Living Ruby, Glowing Nightseye
+		Gems:       []int32{34220, 24030, 24056},
+		EpValues:   stats.Stats{stats.SpellPower: 1, stats.SpellCrit: 0.8, stats.Stamina: 0.1}.ToFloatArray(),
+		NumSims:    2,
+		ItemFilter: &proto.ItemFilter{SourceZone: "Black Temple"},
+	}
+
+	result := core.OptimizeGear(request)
+	if result.ErrorResult != "" {
+		t.Fatalf("Unexpected error: %s", result.ErrorResult)
+	}
+	if len(result.Candidates) == 0 {
+		t.Fatalf("Expected simmed candidates")
+	}
+	for _, candidate := range result.Candidates {
+		for _, slot := range []proto.ItemSlot{proto.ItemSlot_ItemSlotHands, proto.ItemSlot_ItemSlotFinger1} {
+			item := items.ByID[candidate.Equipment.Items[slot].Id]
+			if item.SourceZone != "Black Temple" {
+				t.Fatalf("Expected only Black Temple candidates, but got %s from %s", item.Name, item.SourceZone)
+			}
+		}
+	}
+}
+
+func TestGearOptimizerInvalidRequest(t *testing.T) {
+	newRequest := func() *proto.GearOptimizeRequest {
+		return &proto.GearOptimizeRequest{
+			Player:     testElementalShaman,
+			RaidBuffs:  &proto.RaidBuffs{},
+			PartyBuffs: &proto.PartyBuffs{},
+			Debuffs:    &proto.Debuffs{},
+			Encounter:  testEncounter,
+			SimOptions: testSimOptions,
+			Slots: []*proto.GearSlotCandidates{
+				{Slot: proto.ItemSlot_ItemSlotHead, Items: []int32{29035}},
+			},
+			EpValues: stats.Stats{stats.SpellPower: 1}.ToFloatArray(),
+			NumSims:  2,
+		}
+	}
+
+	unknownItem := newRequest()
+	unknownItem.Slots[0].Items = []int32{999999}
+	unknownGem := newRequest()
+	unknownGem.Gems = []int32{999999}
+	noSimOptions := newRequest()
+	noSimOptions.SimOptions = nil
+	uselessReferenceStat := newRequest()
+	uselessReferenceStat.EpValues = nil
+	uselessReferenceStat.EpReferenceStat = proto.Stat_StatStrength
+
+	for name, request := range map[string]*proto.GearOptimizeRequest{
+		"UnknownItem":          unknownItem,
+		"UnknownGem":           unknownGem,
+		"NoSimOptions":         noSimOptions,
+		"UselessReferenceStat": uselessReferenceStat,
+	} {
+		if result := core.OptimizeGear(request); result.ErrorResult == "" {
+			t.Errorf("%s: expected an error result", name)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package core_test
+
+import (
+	"github.com/wowsims/tbc/sim"
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+
+	"github.com/wowsims/tbc/sim/hunter"
+	shadowPriest "github.com/wowsims/tbc/sim/priest/shadow"
+	elementalShaman "github.com/wowsims/tbc/sim/shaman/elemental"
+	enhancementShaman "github.com/wowsims/tbc/sim/shaman/enhancement"
+	"github.com/wowsims/tbc/sim/warlock"
+)
+
+// Shared setup for tests which need real players. These live in an external
+// test package, since the spec packages depend on core.
+
+func init() {
+	sim.RegisterAll()
+}
+
+var testSimOptions = &proto.SimOptions{
+	Iterations: 1,
+	IsTest:     true,
+}
+
+var testTarget = &proto.Target{
+	Stats:   stats.Stats{stats.Armor: 7684}.ToFloatArray(),
+	MobType: proto.MobType_MobTypeDemon,
+}
+
+var testEncounter = &proto.Encounter{
+	Duration: 300,
+	Targets: []*proto.Target{
+		testTarget,
+	},
+}
+
+var testElementalShaman = &proto.Player{
+	Name:      "P1 Ele Shaman",
+	Race:      proto.Race_RaceOrc,
+	Class:     proto.Class_ClassShaman,
+	Equipment: elementalShaman.P1Gear,
+	Consumes:  elementalShaman.FullConsumes,
+	Spec:      elementalShaman.PlayerOptionsAdaptive,
+	Buffs:     elementalShaman.FullIndividualBuffs,
+}
+
+var testShadowPriest = &proto.Player{
+	Name:      "P1 Shadow Priest",
+	Race:      proto.Race_RaceUndead,
+	Class:     proto.Class_ClassPriest,
+	Equipment: shadowPriest.P1Gear,
+	Consumes:  shadowPriest.FullConsumes,
+	Spec:      shadowPriest.PlayerOptionsIdeal,
+	Buffs:     shadowPriest.FullIndividualBuffs,
+}
+
+var testEnhancementShaman = &proto.Player{
+	Name:      "P1 Enh Shaman",
+	Race:      proto.Race_RaceOrc,
+	Class:     proto.Class_ClassShaman,
+	Equipment: enhancementShaman.Phase2Gear,
+	Consumes:  enhancementShaman.FullConsumes,
+	Spec:      enhancementShaman.PlayerOptionsBasic,
+	Buffs:     enhancementShaman.FullIndividualBuffs,
+}
+
+var testHunter = &proto.Player{
+	Name:      "P1 BM Hunter",
+	Race:      proto.Race_RaceOrc,
+	Class:     proto.Class_ClassHunter,
+	Equipment: hunter.P1Gear,
+	Consumes:  hunter.FullConsumes,
+	Spec:      hunter.PlayerOptionsBasic,
+	Buffs:     hunter.FullIndividualBuffs,
+}
+
+var testWarlock = &proto.Player{
+	Name:      "P4 Warlock",
+	Race:      proto.Race_RaceOrc,
+	Class:     proto.Class_ClassWarlock,
+	Equipment: warlock.Phase4Gear,
+	Consumes:  warlock.FullConsumes,
+	Spec:      warlock.DefaultDestroWarlock,
+	Buffs:     warlock.FullIndividualBuffs,
+}
+
+// A raid with a single party of casters.
+var testRaid = &proto.Raid{
+	Parties: []*proto.Party{
+		{
+			Players: []*proto.Player{
+				testElementalShaman,
+				testShadowPriest,
+			},
+		},
+	},
+}
+
+// Returns a raid containing only player, without any external buffs.
+func singlePlayerRaid(player *proto.Player) *proto.Raid {
+	return core.SinglePlayerRaidProto(player, &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{})
+}
+
+// Runs a sim of raid against encounter. If simOptions is nil, a single test
+// iteration is used.
+func runTestSim(raid *proto.Raid, encounter *proto.Encounter, simOptions *proto.SimOptions) *proto.RaidSimResult {
+	if simOptions == nil {
+		simOptions = testSimOptions
+	}
+	return core.RunRaidSim(&proto.RaidSimRequest{
+		Raid:       raid,
+		Encounter:  encounter,
+		SimOptions: simOptions,
+	})
+}
+
+// Returns the number of casts of each spell in the metrics of a unit.
+func castsBySpell(metrics *proto.UnitMetrics) map[int32]int32 {
+	casts := make(map[int32]int32)
+	for _, action := range metrics.Actions {
+		for _, target := range action.Targets {
+			if target.Casts > 0 {
+				casts[action.Id.GetSpellId()] += target.Casts
+			}
+		}
+	}
+	return casts
+}
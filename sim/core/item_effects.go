@@ -1,8 +1,10 @@
 package core
 
 import (
-	"github.com/wowsims/tbc/sim/core/proto"
 	"log"
+
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
 )
 
 // Function for applying permanent effects to an Agent.
@@ -42,3 +44,19 @@ func AddWeaponEffect(id int32, weaponEffect ApplyWeaponEffect) {
 	}
 	weaponEffects[id] = weaponEffect
 }
+
+// Returns all items whose tooltip describes a proc or use effect, but which
+// have no registered item or weapon effect. The sim only counts the stats of
+// these items, so it undervalues them.
+//
+// Item effects are registered by other packages, so this is only complete
+// once all of those have been imported.
+func ItemsWithUnimplementedEffects() []items.Item {
+	var unimplemented []items.Item
+	for _, item := range items.Items {
+		if item.HasEffect && !HasItemEffect(item.ID) && !HasWeaponEffect(item.ID) {
+			unimplemented = append(unimplemented, item)
+		}
+	}
+	return unimplemented
+}
@@ -0,0 +1,34 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Lists items whose effects the sim doesn't implement. These only count for
+// their stats, so they are undervalued.
+func TestItemEffectCoverage(t *testing.T) {
+	unimplemented := map[int32]bool{}
+	for _, item := range core.ItemsWithUnimplementedEffects() {
+		t.Logf("No effect implemented for %s (%d)", item.Name, item.ID)
+		unimplemented[item.ID] = true
+	}
+
+	// Argussian Compass has a use effect which isn't implemented.
+	if !unimplemented[27770] {
+		t.Fatalf("Expected Argussian Compass to be reported")
+	}
+	// Icon of the Silver Crescent has an implemented use effect.
+	if unimplemented[29370] {
+		t.Fatalf("Expected Icon of the Silver Crescent to not be reported")
+	}
+
+	gearList := core.GetGearList(&proto.GearListRequest{})
+	for _, item := range gearList.Items {
+		if item.HasEffect && item.EffectImplemented == unimplemented[item.Id] {
+			t.Fatalf("Gear list effect flags for %s don't match the report", item.Name)
+		}
+	}
+}
@@ -11,7 +11,7 @@ var Items = []Item{
 	{Name: "A'dal's Command", ID: 29177, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 16, stats.Stamina: 18}, SocketBonus: stats.Stats{}},
 	{Name: "A'dal's Gift", ID: 31461, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 25, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 21, stats.Armor: 88}, SocketBonus: stats.Stats{}},
 	{Name: "A'dal's Recovery Necklace", ID: 31749, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 17, stats.HealingPower: 68, stats.Resilience: 24}, SocketBonus: stats.Stats{}},
-	{Name: "A'dal's Signet of Defense", ID: 28792, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 34, stats.Armor: 367, stats.Defense: 20}, SocketBonus: stats.Stats{}},
+	{Name: "A'dal's Signet of Defense", ID: 28792, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 34, stats.Armor: 367, stats.Defense: 20}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair"},
 	{Name: "Abacus of Violent Odds", ID: 28288, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 64, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
 	{Name: "Achromic Trousers of the Naaru", ID: 33585, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 35, stats.SpellPower: 34, stats.HealingPower: 135, stats.SpellHaste: 45, stats.Armor: 188}, SocketBonus: stats.Stats{}},
 	{Name: "Acrobat's Mark of the Sha'tar", ID: 31380, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 12}, SocketBonus: stats.Stats{}},
@@ -25,11 +25,11 @@ var Items = []Item{
 	{Name: "Adamantite Rifle", ID: 23746, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 126.0, WeaponDamageMax: 234.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 117, Stats: stats.Stats{stats.Agility: 12, stats.AttackPower: 22, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Adjudicator's Staff", ID: 31543, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 118.0, WeaponDamageMax: 200.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, QualityModifier: -16.300, Stats: stats.Stats{stats.Intellect: 26, stats.SpellPower: 64, stats.HealingPower: 64, stats.MP5: 10, stats.SpellCrit: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Adorned Supernal Legwraps", ID: 34925, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 40, stats.Spirit: 42, stats.SpellPower: 38, stats.HealingPower: 152, stats.Armor: 207}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}},
-	{Name: "Adornment of Stolen Souls", ID: 28762, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Adornment of Stolen Souls", ID: 28762, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Aegis of Angelic Fortune", ID: 34231, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 21, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 13, stats.Armor: 6459, stats.BlockValue: 178}, SocketBonus: stats.Stats{}},
-	{Name: "Aegis of Preservation", ID: 19345, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Aegis of Preservation", ID: 19345, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, HasEffect: true},
 	{Name: "Aegis of the Sunbird", ID: 28316, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Armor: 3806, stats.Defense: 19, stats.BlockValue: 115}, SocketBonus: stats.Stats{}},
-	{Name: "Aegis of the Vindicator", ID: 29458, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Intellect: 21, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 11, stats.Armor: 5279, stats.BlockValue: 137}, SocketBonus: stats.Stats{}},
+	{Name: "Aegis of the Vindicator", ID: 29458, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Intellect: 21, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 11, stats.Armor: 5279, stats.BlockValue: 137}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "After Hours Pauldrons", ID: 29999, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 28, stats.Agility: 16, stats.Stamina: 12, stats.MeleeHit: 7, stats.Armor: 188}, SocketBonus: stats.Stats{}},
 	{Name: "Aftershock Waistguard", ID: 34935, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHaste: 34, stats.Armor: 556}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Aged Leather Bindings", ID: 30940, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.AttackPower: 40, stats.MeleeCrit: 20, stats.Armor: 115, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
@@ -39,8 +39,8 @@ var Items = []Item{
 	{Name: "Akil'zon's Talonblade", ID: 33214, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 100.0, WeaponDamageMax: 187.0, SwingSpeed: 1.50, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 52, stats.MeleeHaste: 18, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Alchemist's Stone", ID: 13503, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{stats.Strength: 15, stats.Agility: 15, stats.Stamina: 15, stats.Intellect: 15, stats.Spirit: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Aldor Ceremonial Wraps", ID: 30382, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 14, stats.Spirit: 13, stats.SpellPower: 23, stats.HealingPower: 23, stats.Armor: 61}, SocketBonus: stats.Stats{}},
-	{Name: "Aldori Legacy Defender", ID: 28825, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 39, stats.MeleeHit: 15, stats.Armor: 5279, stats.Defense: 19, stats.BlockValue: 137}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Defense: 2}},
-	{Name: "Alembic of Infernal Power", ID: 27896, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Resilience: 33}, SocketBonus: stats.Stats{}},
+	{Name: "Aldori Legacy Defender", ID: 28825, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 39, stats.MeleeHit: 15, stats.Armor: 5279, stats.Defense: 19, stats.BlockValue: 137}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Defense: 2}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
+	{Name: "Alembic of Infernal Power", ID: 27896, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Resilience: 33}, SocketBonus: stats.Stats{}, HasEffect: true},
 	{Name: "Alley's Recurve", ID: 30226, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 97.0, WeaponDamageMax: 181.0, SwingSpeed: 2.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 7, stats.Stamina: 10, stats.AttackPower: 14, stats.MeleeHit: 7, stats.RangedAttackPower: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Amani Divining Staff", ID: 33494, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 144.0, WeaponDamageMax: 303.0, SwingSpeed: 3.20, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, QualityModifier: -54.300, Stats: stats.Stats{stats.Stamina: 58, stats.Intellect: 47, stats.SpellPower: 217, stats.HealingPower: 217, stats.SpellCrit: 31}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Amani Mask of Death", ID: 33810, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 51, stats.MeleeHit: 33, stats.Armor: 1306}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
@@ -48,7 +48,7 @@ var Items = []Item{
 	{Name: "Amber Bands of the Aggressor", ID: 29463, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 22, stats.Agility: 18, stats.Stamina: 25, stats.Armor: 608, stats.Defense: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Ameer's Impulse Taser", ID: 30011, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 105.0, WeaponDamageMax: 190.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, QualityModifier: -25.800, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 26, stats.SpellPower: 103, stats.HealingPower: 103, stats.SpellHit: 17, stats.SpellCrit: 27}, SocketBonus: stats.Stats{}},
 	{Name: "Ameer's Judgement", ID: 30012, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 105.0, WeaponDamageMax: 190.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, QualityModifier: -25.800, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 40, stats.Spirit: 27, stats.SpellPower: 65, stats.HealingPower: 259, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}},
-	{Name: "Amice of Brilliant Light", ID: 32273, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 38, stats.Intellect: 27, stats.Spirit: 37, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 177}, SocketBonus: stats.Stats{}},
+	{Name: "Amice of Brilliant Light", ID: 32273, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 38, stats.Intellect: 27, stats.Spirit: 37, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 177}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Amice of the Convoker", ID: 34210, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 28, stats.SpellPower: 53, stats.HealingPower: 53, stats.SpellCrit: 22, stats.SpellHaste: 30, stats.Armor: 199}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Amulet of Bitter Hatred", ID: 35507, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 18, stats.AttackPower: 42, stats.MeleeHit: 18, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Amulet of Flowing Life", ID: 34360, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 10, stats.SpellHaste: 25}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
@@ -60,7 +60,7 @@ var Items = []Item{
 	{Name: "Amulet of the Torn-heart", ID: 31074, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 30, stats.FireResistance: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Anathema", ID: 18609, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 134.0, WeaponDamageMax: 222.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, QualityModifier: -14.000, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 31, stats.ShadowSpellPower: 69, stats.MP5: 7, stats.ShadowResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Ancestral Band", ID: 29168, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 15, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 4}, SocketBonus: stats.Stats{}},
-	{Name: "Ancestral Ring of Conquest", ID: 30061, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Strength: 32, stats.Agility: 21, stats.Stamina: 30}, SocketBonus: stats.Stats{}},
+	{Name: "Ancestral Ring of Conquest", ID: 30061, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Strength: 32, stats.Agility: 21, stats.Stamina: 30}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Anchorite's Robes", ID: 29129, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 38, stats.Spirit: 18, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 156}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Ancient Amani Longbow", ID: 33474, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 181.0, WeaponDamageMax: 337.0, SwingSpeed: 3.00, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.AttackPower: 38, stats.ArmorPenetration: 126, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Ancient Aqir Artifact", ID: 33830, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 133, Stats: stats.Stats{stats.Parry: 45}, SocketBonus: stats.Stats{}},
@@ -70,36 +70,36 @@ var Items = []Item{
 	{Name: "Andonisus, Reaper of Souls", ID: 22736, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 159.0, WeaponDamageMax: 296.0, SwingSpeed: 2.80, Phase: 0, Quality: proto.ItemQuality_ItemQualityLegendary, Unique: true, Ilvl: 100, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Andormu's Tear", ID: 29323, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Defense: 10, stats.Block: 15, stats.Dodge: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Andrethan's Masterwork", ID: 29789, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Strength: 34, stats.Stamina: 27, stats.MeleeHit: 18, stats.MeleeCrit: 19, stats.Armor: 1023}, SocketBonus: stats.Stats{}},
-	{Name: "Anetheron's Noose", ID: 30888, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 24, stats.Armor: 133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
+	{Name: "Anetheron's Noose", ID: 30888, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 24, stats.Armor: 133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Angelista's Charm", ID: 21690, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 14, stats.SpellPower: 11, stats.HealingPower: 42, stats.MP5: 6}, SocketBonus: stats.Stats{}},
 	{Name: "Angelista's Grasp", ID: 19388, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 20, stats.Spirit: 13, stats.SpellHit: 16, stats.Armor: 75}, SocketBonus: stats.Stats{}},
 	{Name: "Angelista's Revenge", ID: 34887, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 28, stats.AttackPower: 58, stats.ArmorPenetration: 126, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
-	{Name: "Angelista's Sash", ID: 30895, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 30, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 133}, SocketBonus: stats.Stats{}},
+	{Name: "Angelista's Sash", ID: 30895, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 30, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 133}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Angelista's Touch", ID: 21695, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.Strength: 11, stats.Stamina: 17, stats.Defense: 9, stats.Dodge: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Anger-Spark Gloves", ID: 30725, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellHit: 20, stats.SpellCrit: 25, stats.Armor: 126}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellCrit: 3}},
 	{Name: "Annihilator Holo-Gogs", ID: 34847, ClassAllowlist: []proto.Class{proto.Class_ClassMage, proto.Class_ClassPriest, proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 41, stats.Intellect: 37, stats.SpellPower: 81, stats.HealingPower: 81, stats.SpellCrit: 42, stats.Armor: 216}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Antlers of Malorne", ID: 29093, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.Spirit: 22, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 24, stats.Armor: 308}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.SpellHit: 4}, SetName: "Malorne Regalia"},
-	{Name: "Antonidas's Aegis of Rapt Concentration", ID: 30909, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 20, stats.Armor: 6336, stats.BlockValue: 174}, SocketBonus: stats.Stats{}},
+	{Name: "Antonidas's Aegis of Rapt Concentration", ID: 30909, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 20, stats.Armor: 6336, stats.BlockValue: 174}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Anveena's Touch", ID: 34890, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Apexis Cleaver", ID: 32663, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 268.0, WeaponDamageMax: 403.0, SwingSpeed: 3.60, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 39, stats.MeleeCrit: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Apexis Cloak", ID: 32653, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 19, stats.HealingPower: 76, stats.Armor: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Apexis Crystal Mace", ID: 32661, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 90.0, WeaponDamageMax: 168.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 22, stats.MeleeHit: 8, stats.MeleeCrit: 20, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Apolyon, the Soul-Render", ID: 34247, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 404.0, WeaponDamageMax: 607.0, SwingSpeed: 3.40, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Stamina: 75, stats.AttackPower: 126, stats.MeleeCrit: 42, stats.MeleeHaste: 32, stats.RangedAttackPower: 126}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}},
-	{Name: "Apostle of Argus", ID: 30908, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 146.0, WeaponDamageMax: 323.0, SwingSpeed: 3.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 62, stats.Intellect: 59, stats.SpellPower: 162, stats.HealingPower: 648, stats.MP5: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Apostle of Argus", ID: 30908, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 146.0, WeaponDamageMax: 323.0, SwingSpeed: 3.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 62, stats.Intellect: 59, stats.SpellPower: 162, stats.HealingPower: 648, stats.MP5: 23}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Ar'tor's Mainstay", ID: 30951, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 18, stats.Intellect: 11, stats.MP5: 3, stats.AttackPower: 68, stats.Armor: 387, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}},
-	{Name: "Aran's Soothing Sapphire", ID: 28728, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 22, stats.SpellPower: 17, stats.HealingPower: 68, stats.MP5: 8}, SocketBonus: stats.Stats{}},
+	{Name: "Aran's Soothing Sapphire", ID: 28728, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 22, stats.SpellPower: 17, stats.HealingPower: 68, stats.MP5: 8}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Aran's Sorcerous Slacks", ID: 28212, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 28, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 21, stats.Armor: 136}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Arcane Infused Gem", ID: 19336, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Arcane Khorium Band", ID: 24086, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.ArcaneSpellPower: 27, stats.MP5: 7}, SocketBonus: stats.Stats{}},
 	{Name: "Arcane Netherband", ID: 28327, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 18, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellPenetration: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Arcanist's Stone", ID: 28223, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellHit: 25}, SocketBonus: stats.Stats{}},
-	{Name: "Arcanite Steam-Pistol", ID: 29949, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 177.0, WeaponDamageMax: 329.0, SwingSpeed: 2.90, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Agility: 20, stats.MeleeHit: 19}, SocketBonus: stats.Stats{}},
+	{Name: "Arcanite Steam-Pistol", ID: 29949, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 177.0, WeaponDamageMax: 329.0, SwingSpeed: 2.90, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Agility: 20, stats.MeleeHit: 19}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Arcanium Signet Bands", ID: 27746, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 15, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 68}, SocketBonus: stats.Stats{}},
 	{Name: "Arcanoweave Boots", ID: 21867, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 39, stats.Armor: 106, stats.ArcaneResistance: 35}, SocketBonus: stats.Stats{}, SetName: "Arcanoweave Vestments"},
 	{Name: "Arcanoweave Bracers", ID: 21866, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 31, stats.Armor: 67, stats.ArcaneResistance: 25}, SocketBonus: stats.Stats{}, SetName: "Arcanoweave Vestments"},
 	{Name: "Arcanoweave Robe", ID: 21868, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 45, stats.MP5: 9, stats.Armor: 156, stats.ArcaneResistance: 50}, SocketBonus: stats.Stats{}, SetName: "Arcanoweave Vestments"},
 	{Name: "Archaic Charm of Presence", ID: 30726, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Intellect: 23, stats.SpellPower: 25, stats.HealingPower: 100}, SocketBonus: stats.Stats{}},
-	{Name: "Archbishop's Slippers", ID: 30885, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 30, stats.Spirit: 37, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 162}, SocketBonus: stats.Stats{}},
+	{Name: "Archbishop's Slippers", ID: 30885, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 30, stats.Spirit: 37, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 162}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Archery Belt of the Broken", ID: 27541, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 19, stats.AttackPower: 58, stats.MeleeCrit: 18, stats.Armor: 367, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
 	{Name: "Archimtiros' Ring of Reckoning", ID: 19376, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Archmage's Guile", ID: 34667, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 45.0, WeaponDamageMax: 145.0, SwingSpeed: 2.30, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 11, stats.SpellPower: 130, stats.HealingPower: 130, stats.SpellCrit: 20}, SocketBonus: stats.Stats{}},
@@ -107,7 +107,7 @@ var Items = []Item{
 	{Name: "Area 52 Defender's Pants", ID: 30019, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 24, stats.SpellPower: 36, stats.HealingPower: 36, stats.MP5: 6, stats.SpellCrit: 21, stats.Armor: 527}, SocketBonus: stats.Stats{}},
 	{Name: "Area 52 Engineering Gloves", ID: 30264, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 10, stats.Agility: 13, stats.Stamina: 19, stats.Armor: 623, stats.Defense: 25}, SocketBonus: stats.Stats{}},
 	{Name: "Arechron's Gift", ID: 29138, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 261.0, WeaponDamageMax: 392.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 84, stats.MeleeHit: 42, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}},
-	{Name: "Argussian Compass", ID: 27770, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 36}, SocketBonus: stats.Stats{}},
+	{Name: "Argussian Compass", ID: 27770, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 36}, SocketBonus: stats.Stats{}, HasEffect: true},
 	{Name: "Arkadian Claymore", ID: 30570, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 231.0, WeaponDamageMax: 347.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 114, Stats: stats.Stats{stats.Strength: 28, stats.Stamina: 42, stats.MeleeCrit: 27}, SocketBonus: stats.Stats{}},
 	{Name: "Armwraps of Disdain", ID: 27765, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.AttackPower: 38, stats.MeleeCrit: 20, stats.Armor: 128, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Armwraps of the Kaldorei Protector", ID: 33578, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 22, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellCrit: 20, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
@@ -151,7 +151,7 @@ var Items = []Item{
 	{Name: "Averinn's Ring of Slaying", ID: 27453, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 22, stats.Stamina: 18, stats.AttackPower: 38, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Avian Cloak of Feathers", ID: 27946, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 18, stats.Spirit: 12, stats.SpellPower: 14, stats.HealingPower: 56, stats.MP5: 5, stats.Armor: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Axe of Shattered Dreams", ID: 34794, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 283.0, WeaponDamageMax: 426.0, SwingSpeed: 3.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 48, stats.AttackPower: 70, stats.ArmorPenetration: 224, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
-	{Name: "Axe of the Gronn Lords", ID: 28794, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 345.0, WeaponDamageMax: 518.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 66, stats.AttackPower: 124, stats.RangedAttackPower: 124}, SocketBonus: stats.Stats{}},
+	{Name: "Axe of the Gronn Lords", ID: 28794, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 345.0, WeaponDamageMax: 518.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 66, stats.AttackPower: 124, stats.RangedAttackPower: 124}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Axe of the Nexus-Kings", ID: 27829, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 253.0, WeaponDamageMax: 381.0, SwingSpeed: 3.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.AttackPower: 72, stats.MeleeCrit: 35, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
 	{Name: "Azure-Shield of Coldarra", ID: 29266, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 31, stats.Armor: 4668, stats.Defense: 22, stats.BlockValue: 148}, SocketBonus: stats.Stats{}},
 	{Name: "Azurestrike Shoulders", ID: 30938, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 11, stats.AttackPower: 44, stats.MeleeHit: 10, stats.MeleeCrit: 21, stats.Armor: 193, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
@@ -161,12 +161,12 @@ var Items = []Item{
 	{Name: "Badge of the Swarmguard", ID: 21670, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Balebrew Charm", ID: 37128, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Accuria", ID: 17063, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{stats.Agility: 12, stats.Stamina: 10, stats.AttackPower: 16, stats.MeleeHit: 20, stats.RangedAttackPower: 16}, SocketBonus: stats.Stats{}},
-	{Name: "Band of Al'ar", ID: 29922, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 23, stats.SpellPower: 37, stats.HealingPower: 37}, SocketBonus: stats.Stats{}},
+	{Name: "Band of Al'ar", ID: 29922, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 23, stats.SpellPower: 37, stats.HealingPower: 37}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Band of Ancestral Spirits", ID: 29145, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 26, stats.Spirit: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Anguish", ID: 30973, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Agility: 13, stats.AttackPower: 50, stats.MeleeHit: 10, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Arcane Alacrity", ID: 34704, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 12, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellHaste: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Celerity", ID: 34798, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 40, stats.MeleeHaste: 18, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
-	{Name: "Band of Crimson Fury", ID: 28793, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellHit: 16}, SocketBonus: stats.Stats{}},
+	{Name: "Band of Crimson Fury", ID: 28793, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellHit: 16}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair"},
 	{Name: "Band of Determination", ID: 34706, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 35, stats.Expertise: 17, stats.Armor: 252}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Devastation", ID: 32526, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 44, stats.AttackPower: 66, stats.MeleeHaste: 31, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Dominion", ID: 31290, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 21}, SocketBonus: stats.Stats{}},
@@ -185,7 +185,7 @@ var Items = []Item{
 	{Name: "Band of Eternity", ID: 29308, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 144, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Forced Concentration", ID: 19403, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 12, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellHit: 8}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Frigid Elements", ID: 32779, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 13, stats.FrostSpellPower: 34, stats.NatureSpellPower: 34, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}},
-	{Name: "Band of Halos", ID: 29373, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 6}, SocketBonus: stats.Stats{}},
+	{Name: "Band of Halos", ID: 29373, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 6}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Band of Icy Depths", ID: 21526, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 19, stats.FrostResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Impenetrable Defenses", ID: 31319, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 36, stats.Defense: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Lucent Beams", ID: 34166, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 25, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 8, stats.SpellHaste: 22}, SocketBonus: stats.Stats{}},
@@ -197,37 +197,37 @@ var Items = []Item{
 	{Name: "Band of Unnatural Forces", ID: 23038, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 85, Stats: stats.Stats{stats.AttackPower: 52, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Ursol", ID: 27740, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Strength: 23, stats.Agility: 12, stats.Stamina: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Band of Vile Aggression", ID: 33055, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.AttackPower: 50, stats.RangedAttackPower: 50, stats.Resilience: 25}, SocketBonus: stats.Stats{}},
-	{Name: "Band of the Abyssal Lord", ID: 32261, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 53, stats.MeleeHit: 21, stats.Defense: 27}, SocketBonus: stats.Stats{}},
+	{Name: "Band of the Abyssal Lord", ID: 32261, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 53, stats.MeleeHit: 21, stats.Defense: 27}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Band of the Crystalline Void", ID: 31923, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Intellect: 20, stats.SpellPower: 15, stats.HealingPower: 59, stats.MP5: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Band of the Eternal Champion", ID: 29301, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 43, stats.AttackPower: 60, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
-	{Name: "Band of the Eternal Defender", ID: 29297, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Stamina: 43, stats.Defense: 30, stats.BlockValue: 44}, SocketBonus: stats.Stats{}},
-	{Name: "Band of the Eternal Restorer", ID: 29309, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 10}, SocketBonus: stats.Stats{}},
-	{Name: "Band of the Eternal Sage", ID: 29305, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}},
+	{Name: "Band of the Eternal Champion", ID: 29301, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 43, stats.AttackPower: 60, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}, SourceZone: "Caverns of Time", ReputationFaction: "The Scale of the Sands", ReputationLevel: proto.RepLevel_RepLevelExalted},
+	{Name: "Band of the Eternal Defender", ID: 29297, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Stamina: 43, stats.Defense: 30, stats.BlockValue: 44}, SocketBonus: stats.Stats{}, SourceZone: "Caverns of Time", ReputationFaction: "The Scale of the Sands", ReputationLevel: proto.RepLevel_RepLevelExalted},
+	{Name: "Band of the Eternal Restorer", ID: 29309, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 10}, SocketBonus: stats.Stats{}, SourceZone: "Caverns of Time", ReputationFaction: "The Scale of the Sands", ReputationLevel: proto.RepLevel_RepLevelExalted},
+	{Name: "Band of the Eternal Sage", ID: 29305, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 152, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}, SourceZone: "Caverns of Time", ReputationFaction: "The Scale of the Sands", ReputationLevel: proto.RepLevel_RepLevelExalted},
 	{Name: "Band of the Exorcist", ID: 28553, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 95, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 34, stats.MeleeHit: 10, stats.MeleeCrit: 16, stats.RangedAttackPower: 34, stats.Resilience: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Band of the Guardian", ID: 29320, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 11, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 17, stats.SpellPenetration: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Band of the Inevitable", ID: 23031, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellHit: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Band of the Ranger-General", ID: 29997, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 56, stats.MeleeHit: 18, stats.MeleeCrit: 28, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
+	{Name: "Band of the Ranger-General", ID: 29997, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 56, stats.MeleeHit: 18, stats.MeleeCrit: 28, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Band of the Swift Paw", ID: 33580, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 21, stats.Agility: 22, stats.Stamina: 31, stats.Intellect: 10, stats.Armor: 317}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 2}},
 	{Name: "Band of the Vigilant", ID: 33058, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 33, stats.SpellPower: 16, stats.HealingPower: 62, stats.SpellCrit: 21, stats.Resilience: 21}, SocketBonus: stats.Stats{}},
-	{Name: "Bands of Indwelling", ID: 28511, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 18, stats.Spirit: 20, stats.SpellPower: 16, stats.HealingPower: 62, stats.Armor: 85}, SocketBonus: stats.Stats{}},
-	{Name: "Bands of Nefarious Deeds", ID: 28515, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.SpellPower: 32, stats.HealingPower: 32, stats.Armor: 85}, SocketBonus: stats.Stats{}},
+	{Name: "Bands of Indwelling", ID: 28511, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 18, stats.Spirit: 20, stats.SpellPower: 16, stats.HealingPower: 62, stats.Armor: 85}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
+	{Name: "Bands of Nefarious Deeds", ID: 28515, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.SpellPower: 32, stats.HealingPower: 32, stats.Armor: 85}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Bands of Negation", ID: 29240, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 22, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 81}, SocketBonus: stats.Stats{}},
 	{Name: "Bands of Nethekurse", ID: 27517, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Intellect: 18, stats.Spirit: 13, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellPenetration: 15, stats.Armor: 67}, SocketBonus: stats.Stats{}},
 	{Name: "Bands of Rarefied Magic", ID: 29255, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.Spirit: 16, stats.SpellPower: 25, stats.HealingPower: 25, stats.Armor: 81}, SocketBonus: stats.Stats{}},
 	{Name: "Bands of Syth", ID: 27918, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Strength: 21, stats.Agility: 19, stats.Stamina: 18, stats.Armor: 497}, SocketBonus: stats.Stats{}},
 	{Name: "Bands of the Benevolent", ID: 29249, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.Spirit: 18, stats.SpellPower: 16, stats.HealingPower: 62, stats.Armor: 81}, SocketBonus: stats.Stats{}},
 	{Name: "Bands of the Celestial Archer", ID: 30026, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 17, stats.Intellect: 24, stats.AttackPower: 48, stats.MeleeCrit: 17, stats.Armor: 394, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
-	{Name: "Bands of the Coming Storm", ID: 32259, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 21, stats.Armor: 432}, SocketBonus: stats.Stats{}},
-	{Name: "Bangle of Endless Blessings", ID: 28370, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Bands of the Coming Storm", ID: 32259, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 21, stats.Armor: 432}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
+	{Name: "Bangle of Endless Blessings", ID: 28370, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, HasEffect: true},
 	{Name: "Barb of the Sand Reaver", ID: 21635, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 225.0, WeaponDamageMax: 338.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Agility: 32, stats.Stamina: 31, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Barbaric Legstraps", ID: 27773, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 13, stats.Intellect: 17, stats.MP5: 7, stats.AttackPower: 56, stats.Armor: 570, stats.RangedAttackPower: 56}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 4}},
 	{Name: "Barbed Choker", ID: 21664, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 10, stats.AttackPower: 44, stats.MeleeCrit: 14, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
-	{Name: "Barbed Choker of Discipline", ID: 28516, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 39, stats.Defense: 16, stats.Dodge: 21}, SocketBonus: stats.Stats{}},
+	{Name: "Barbed Choker of Discipline", ID: 28516, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 39, stats.Defense: 16, stats.Dodge: 21}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Barbed Gloves of the Sage", ID: 34904, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 30, stats.Spirit: 25, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellHit: 15, stats.Armor: 277}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Bark-Gloves of Ancient Wisdom", ID: 30029, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.Spirit: 33, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 252}, SocketBonus: stats.Stats{}},
 	{Name: "Barkchip Boots", ID: 29265, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 24, stats.Agility: 24, stats.Stamina: 36, stats.Intellect: 21, stats.Armor: 352}, SocketBonus: stats.Stats{}},
 	{Name: "Barrel-Blade Longrifle", ID: 30724, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 147.0, WeaponDamageMax: 275.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Agility: 16}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
-	{Name: "Bastion of Light", ID: 30882, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 21, stats.HealingPower: 83, stats.Armor: 5930, stats.BlockValue: 160}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
+	{Name: "Bastion of Light", ID: 30882, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 21, stats.HealingPower: 83, stats.Armor: 5930, stats.BlockValue: 160}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Battle-Mage's Helmet", ID: 29773, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 18, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellCrit: 26, stats.Armor: 465}, SocketBonus: stats.Stats{}},
 	{Name: "Battle-mace of the High Priestess", ID: 34790, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 45.0, WeaponDamageMax: 145.0, SwingSpeed: 2.30, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 13, stats.SpellPower: 76, stats.HealingPower: 304, stats.SpellHaste: 15}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
 	{Name: "Battlecast Hood", ID: 24267, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 43, stats.Intellect: 28, stats.SpellPower: 43, stats.HealingPower: 43, stats.Armor: 145}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 3}, SetName: "Battlecast Garb"},
@@ -239,33 +239,33 @@ var Items = []Item{
 	{Name: "Battlemaster's Depravity", ID: 34162, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.SpellCrit: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Battlemaster's Determination", ID: 33832, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.AttackPower: 80, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}},
 	{Name: "Battlemaster's Perseverance", ID: 34050, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.SpellPower: 30, stats.HealingPower: 118}, SocketBonus: stats.Stats{}},
-	{Name: "Battlescar Boots", ID: 28747, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 18, stats.Stamina: 28, stats.Armor: 997, stats.Defense: 23, stats.Parry: 21}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Parry: 3}},
+	{Name: "Battlescar Boots", ID: 28747, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 18, stats.Stamina: 28, stats.Armor: 997, stats.Defense: 23, stats.Parry: 21}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Parry: 3}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Battleworn Tuskguard", ID: 33421, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 60, stats.Armor: 1355, stats.Defense: 40, stats.BlockValue: 51, stats.Dodge: 23}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
 	{Name: "Beast Lord Cuirass", ID: 28228, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 30, stats.Intellect: 24, stats.MP5: 4, stats.AttackPower: 40, stats.Armor: 652, stats.RangedAttackPower: 40}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}, SetName: "Beast Lord Armor"},
 	{Name: "Beast Lord Handguards", ID: 27474, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 12, stats.Intellect: 17, stats.AttackPower: 34, stats.Armor: 407, stats.RangedAttackPower: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeHit: 3}, SetName: "Beast Lord Armor"},
 	{Name: "Beast Lord Helm", ID: 28275, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 21, stats.Intellect: 22, stats.AttackPower: 50, stats.Armor: 530, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MP5: 2}, SetName: "Beast Lord Armor"},
 	{Name: "Beast Lord Leggings", ID: 27874, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 25, stats.Intellect: 19, stats.MP5: 7, stats.AttackPower: 52, stats.Armor: 570, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}, SetName: "Beast Lord Armor"},
 	{Name: "Beast Lord Mantle", ID: 27801, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Intellect: 12, stats.MP5: 5, stats.AttackPower: 34, stats.Armor: 489, stats.RangedAttackPower: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}, SetName: "Beast Lord Armor"},
-	{Name: "Beast-tamer's Shoulders", ID: 30892, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 39, stats.Stamina: 38, stats.AttackPower: 78, stats.Armor: 741, stats.RangedAttackPower: 78}, SocketBonus: stats.Stats{}},
-	{Name: "Beastmaw Pauldrons", ID: 28589, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 22, stats.Intellect: 23, stats.MP5: 8, stats.AttackPower: 46, stats.Armor: 609, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
+	{Name: "Beast-tamer's Shoulders", ID: 30892, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 39, stats.Stamina: 38, stats.AttackPower: 78, stats.Armor: 741, stats.RangedAttackPower: 78}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
+	{Name: "Beastmaw Pauldrons", ID: 28589, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 22, stats.Intellect: 23, stats.MP5: 8, stats.AttackPower: 46, stats.Armor: 609, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan"},
 	{Name: "Belt of Absolution", ID: 34527, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 29, stats.Spirit: 33, stats.SpellPower: 32, stats.HealingPower: 127, stats.MP5: 8, stats.SpellHaste: 14, stats.Armor: 145}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}, SetName: "Vestments of Absolution"},
 	{Name: "Belt of Blasting", ID: 30038, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 23, stats.SpellCrit: 30, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Belt of Deep Shadow", ID: 30040, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 32, stats.Stamina: 14, stats.AttackPower: 66, stats.MeleeHit: 18, stats.Armor: 227, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}},
 	{Name: "Belt of Depravity", ID: 29241, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 27, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellHit: 17, stats.Armor: 105}, SocketBonus: stats.Stats{}},
-	{Name: "Belt of Divine Guidance", ID: 32519, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 35, stats.Intellect: 24, stats.Spirit: 32, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
-	{Name: "Belt of Divine Inspiration", ID: 28799, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 43, stats.HealingPower: 43, stats.Armor: 118}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
+	{Name: "Belt of Divine Guidance", ID: 32519, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 35, stats.Intellect: 24, stats.Spirit: 32, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Black Temple"},
+	{Name: "Belt of Divine Inspiration", ID: 28799, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 43, stats.HealingPower: 43, stats.Armor: 118}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Belt of Faith", ID: 22518, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 23, stats.Spirit: 17, stats.SpellPower: 16, stats.HealingPower: 64, stats.Armor: 85}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
 	{Name: "Belt of Flowing Thought", ID: 30708, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 32, stats.MP5: 13, stats.Armor: 367}, SocketBonus: stats.Stats{}},
-	{Name: "Belt of Gale Force", ID: 28567, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 28, stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 10, stats.Armor: 457}, SocketBonus: stats.Stats{}},
+	{Name: "Belt of Gale Force", ID: 28567, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 28, stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 10, stats.Armor: 457}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Belt of Natural Power", ID: 30042, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 20, stats.Stamina: 38, stats.Intellect: 12, stats.Armor: 423}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}},
 	{Name: "Belt of Never-ending Agony", ID: 21586, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 20, stats.AttackPower: 64, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.Armor: 162, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
-	{Name: "Belt of One-Hundred Deaths", ID: 30106, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 25, stats.AttackPower: 74, stats.Expertise: 25, stats.Armor: 244, stats.RangedAttackPower: 74}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}},
-	{Name: "Belt of Primal Majesty", ID: 32339, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 29, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 249}, SocketBonus: stats.Stats{}},
-	{Name: "Belt of Seething Fury", ID: 30915, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 48, stats.Stamina: 37, stats.MeleeHaste: 38, stats.Armor: 993}, SocketBonus: stats.Stats{}},
+	{Name: "Belt of One-Hundred Deaths", ID: 30106, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 25, stats.AttackPower: 74, stats.Expertise: 25, stats.Armor: 244, stats.RangedAttackPower: 74}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
+	{Name: "Belt of Primal Majesty", ID: 32339, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 29, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 249}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
+	{Name: "Belt of Seething Fury", ID: 30915, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 48, stats.Stamina: 37, stats.MeleeHaste: 38, stats.Armor: 993}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Belt of Ten Storms", ID: 16944, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 18, stats.Spirit: 11, stats.SpellPower: 9, stats.HealingPower: 35, stats.SpellCrit: 14, stats.Armor: 310, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "The Ten Storms"},
 	{Name: "Belt of Transcendence", ID: 16925, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 26, stats.Spirit: 9, stats.SpellPower: 9, stats.HealingPower: 35, stats.Armor: 74, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Vestments of Transcendence"},
 	{Name: "Belt of the Black Eagle", ID: 30046, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 20, stats.Intellect: 23, stats.AttackPower: 66, stats.MeleeCrit: 17, stats.Armor: 506, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 3}},
-	{Name: "Belt of the Crescent Moon", ID: 30914, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 27, stats.Spirit: 19, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellHaste: 36, stats.Armor: 249}, SocketBonus: stats.Stats{}},
+	{Name: "Belt of the Crescent Moon", ID: 30914, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 27, stats.Spirit: 19, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellHaste: 36, stats.Armor: 249}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Belt of the Fallen Emperor", ID: 21606, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Strength: 17, stats.Agility: 13, stats.Stamina: 17, stats.Intellect: 18, stats.SpellPower: 12, stats.HealingPower: 47, stats.Armor: 584}, SocketBonus: stats.Stats{}},
 	{Name: "Belt of the Grand Crusader", ID: 23666, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Strength: 18, stats.Stamina: 18, stats.Intellect: 18, stats.SpellPower: 21, stats.HealingPower: 21, stats.MP5: 7, stats.Armor: 612}, SocketBonus: stats.Stats{}},
 	{Name: "Belt of the Guardian", ID: 30034, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 13, stats.SpellPower: 21, stats.HealingPower: 21, stats.Armor: 904, stats.Defense: 19, stats.BlockValue: 33}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
@@ -279,20 +279,20 @@ var Items = []Item{
 	{Name: "Belt of the Tracker", ID: 30643, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 15, stats.MP5: 15, stats.AttackPower: 42, stats.Armor: 457, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Benediction", ID: 18608, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 134.0, WeaponDamageMax: 222.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, QualityModifier: -14.000, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 31, stats.Spirit: 12, stats.SpellPower: 36, stats.HealingPower: 142, stats.SpellCrit: 28, stats.ShadowResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Berserker's Call", ID: 33831, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 133, Stats: stats.Stats{stats.AttackPower: 90, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}},
-	{Name: "Big Bad Wolf's Head", ID: 28583, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 40, stats.SpellPower: 47, stats.HealingPower: 47, stats.SpellCrit: 28, stats.Armor: 659}, SocketBonus: stats.Stats{}},
-	{Name: "Big Bad Wolf's Paw", ID: 28584, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 153.0, WeaponDamageMax: 285.0, SwingSpeed: 2.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 18, stats.MeleeCrit: 20}, SocketBonus: stats.Stats{}},
+	{Name: "Big Bad Wolf's Head", ID: 28583, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 40, stats.SpellPower: 47, stats.HealingPower: 47, stats.SpellCrit: 28, stats.Armor: 659}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Big Bad Wolf"},
+	{Name: "Big Bad Wolf's Paw", ID: 28584, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 153.0, WeaponDamageMax: 285.0, SwingSpeed: 2.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 18, stats.MeleeCrit: 20}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Big Bad Wolf"},
 	{Name: "Bile-Covered Gauntlets", ID: 21682, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Strength: 10, stats.Agility: 17, stats.Stamina: 21, stats.Armor: 224, stats.NatureResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Bindings of Faith", ID: 22519, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 11, stats.Intellect: 15, stats.Spirit: 17, stats.SpellPower: 14, stats.HealingPower: 54, stats.Armor: 66}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
 	{Name: "Bindings of Lightning Reflexes", ID: 32574, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 15, stats.Intellect: 16, stats.AttackPower: 56, stats.MeleeHaste: 27, stats.Armor: 432, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
 	{Name: "Bindings of Raging Fire", ID: 34697, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 10, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellHaste: 18, stats.Armor: 68}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Bindings of Transcendence", ID: 16926, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 13, stats.Spirit: 16, stats.SpellPower: 11, stats.HealingPower: 44, stats.Armor: 58}, SocketBonus: stats.Stats{}, SetName: "Vestments of Transcendence"},
 	{Name: "Bindings of the Timewalker", ID: 29183, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 12, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 78}, SocketBonus: stats.Stats{}},
-	{Name: "Bishop's Cloak", ID: 29375, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 17, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 8, stats.Armor: 93}, SocketBonus: stats.Stats{}},
+	{Name: "Bishop's Cloak", ID: 29375, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 17, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 8, stats.Armor: 93}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Black Ash Robe", ID: 19399, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 22, stats.Spirit: 17, stats.Armor: 131, stats.FireResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Black Belt of Knowledge", ID: 24257, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 18, stats.Spirit: 21, stats.MP5: 11, stats.Armor: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Black Bow of the Betrayer", ID: 32336, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 201.0, WeaponDamageMax: 374.0, SwingSpeed: 3.00, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.AttackPower: 26, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
+	{Name: "Black Bow of the Betrayer", ID: 32336, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 201.0, WeaponDamageMax: 374.0, SwingSpeed: 3.00, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.AttackPower: 26, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Black Brood Pauldrons", ID: 19373, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 12, stats.Intellect: 15, stats.MP5: 9, stats.Armor: 408}, SocketBonus: stats.Stats{}},
-	{Name: "Black Featherlight Boots", ID: 30891, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 41, stats.AttackPower: 98, stats.MeleeHit: 34, stats.Armor: 305, stats.RangedAttackPower: 98}, SocketBonus: stats.Stats{}},
+	{Name: "Black Featherlight Boots", ID: 30891, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 41, stats.AttackPower: 98, stats.MeleeHit: 34, stats.Armor: 305, stats.RangedAttackPower: 98}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Black Felsteel Bracers", ID: 23537, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 15, stats.MeleeCrit: 22, stats.Armor: 581}, SocketBonus: stats.Stats{}},
 	{Name: "Black Planar Edge", ID: 28432, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 172.0, WeaponDamageMax: 320.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.AttackPower: 44, stats.MeleeCrit: 21, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
 	{Name: "Black-Iron Battlecloak", ID: 30729, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.AttackPower: 60, stats.MeleeCrit: 30, stats.Armor: 101, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
@@ -301,25 +301,25 @@ var Items = []Item{
 	{Name: "Blackened Leather Spaulders", ID: 29148, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.AttackPower: 70, stats.MeleeHit: 15, stats.Armor: 219, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
 	{Name: "Blackened Naaru Sliver", ID: 34427, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.MeleeHaste: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Blackened Spear", ID: 29167, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 261.0, WeaponDamageMax: 392.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 26, stats.AttackPower: 92, stats.MeleeHit: 19, stats.RangedAttackPower: 92}, SocketBonus: stats.Stats{}},
-	{Name: "Blackfathom Warbands", ID: 30047, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.SpellPower: 21, stats.HealingPower: 83, stats.Armor: 394}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
+	{Name: "Blackfathom Warbands", ID: 30047, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.SpellPower: 21, stats.HealingPower: 83, stats.Armor: 394}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Blackout Truncheon", ID: 27901, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 73.0, WeaponDamageMax: 136.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
 	{Name: "Blackstrike Bracers", ID: 24251, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.MP5: 5, stats.SpellCrit: 26, stats.Armor: 67}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Blackwhelp Belt", ID: 31513, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 11, stats.Spirit: 10, stats.SpellPower: 32, stats.HealingPower: 32, stats.SpellCrit: 10, stats.Armor: 137}, SocketBonus: stats.Stats{}},
 	{Name: "Blackwing Helm", ID: 31520, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 21, stats.MeleeHit: 15, stats.MeleeCrit: 37, stats.Armor: 810}, SocketBonus: stats.Stats{}},
-	{Name: "Blade of Infamy", ID: 30881, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 182.0, WeaponDamageMax: 339.0, SwingSpeed: 2.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.AttackPower: 56, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
+	{Name: "Blade of Infamy", ID: 30881, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 182.0, WeaponDamageMax: 339.0, SwingSpeed: 2.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.AttackPower: 56, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Blade of Life's Inevitability", ID: 34349, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 155.0, WeaponDamageMax: 233.0, SwingSpeed: 2.00, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 36, stats.MeleeHaste: 16, stats.RangedAttackPower: 36}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
-	{Name: "Blade of Savagery", ID: 32369, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 98.0, WeaponDamageMax: 183.0, SwingSpeed: 1.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 44, stats.MeleeHit: 15, stats.MeleeCrit: 22, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
+	{Name: "Blade of Savagery", ID: 32369, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 98.0, WeaponDamageMax: 183.0, SwingSpeed: 1.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 44, stats.MeleeHit: 15, stats.MeleeCrit: 22, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Mother Shahraz"},
 	{Name: "Blade of Serration", ID: 34894, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 130.0, WeaponDamageMax: 241.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 44, stats.MeleeCrit: 24, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
 	{Name: "Blade of Twisted Visions", ID: 33467, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 21.0, WeaponDamageMax: 128.0, SwingSpeed: 1.80, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, QualityModifier: -57.300, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 21, stats.SpellPower: 229, stats.HealingPower: 229, stats.SpellHaste: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Blade of Unquenched Thirst", ID: 31193, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 53.0, WeaponDamageMax: 98.0, SwingSpeed: 1.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 97, Stats: stats.Stats{stats.AttackPower: 22, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Blade of Wizardry", ID: 31336, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 30.0, WeaponDamageMax: 118.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, QualityModifier: -39.800, Stats: stats.Stats{stats.SpellPower: 159, stats.HealingPower: 159}, SocketBonus: stats.Stats{}},
 	{Name: "Blade of the Archmage", ID: 29153, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 30.0, WeaponDamageMax: 118.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, QualityModifier: -39.800, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 11, stats.SpellPower: 159, stats.HealingPower: 159, stats.SpellCrit: 21}, SocketBonus: stats.Stats{}},
-	{Name: "Blade of the Unrequited", ID: 28572, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 112.0, WeaponDamageMax: 168.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 18, stats.MeleeCrit: 9, stats.RangedAttackPower: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
+	{Name: "Blade of the Unrequited", ID: 28572, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 112.0, WeaponDamageMax: 168.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 18, stats.MeleeCrit: 9, stats.RangedAttackPower: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}, SourceZone: "Karazhan"},
 	{Name: "Bladeangel's Money Belt", ID: 33211, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 27, stats.AttackPower: 58, stats.MeleeCrit: 21, stats.ArmorPenetration: 77, stats.Armor: 227, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Bladed Chaos Tunic", ID: 34397, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 45, stats.AttackPower: 120, stats.MeleeCrit: 38, stats.ArmorPenetration: 210, stats.Armor: 499, stats.RangedAttackPower: 120}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
-	{Name: "Bladed Shoulderpads of the Merciless", ID: 28755, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 58, stats.MeleeHit: 13, stats.MeleeCrit: 21, stats.Armor: 273, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 3}},
+	{Name: "Bladed Shoulderpads of the Merciless", ID: 28755, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 58, stats.MeleeHit: 13, stats.MeleeCrit: 21, stats.Armor: 273, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 3}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Bladefist's Breadth", ID: 28041, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 88, Stats: stats.Stats{stats.MeleeCrit: 26}, SocketBonus: stats.Stats{}},
-	{Name: "Bladespire Warbands", ID: 28795, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 20, stats.Stamina: 16, stats.MeleeCrit: 24, stats.Armor: 687}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Strength: 3}},
+	{Name: "Bladespire Warbands", ID: 28795, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 20, stats.Stamina: 16, stats.MeleeCrit: 24, stats.Armor: 687}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Strength: 3}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Bland Blade", ID: 32466, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, SwingSpeed: 2.60, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Bland Shiv", ID: 32914, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, SwingSpeed: 1.80, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Blastguard Belt", ID: 29500, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 27, stats.Armor: 160, stats.FireResistance: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
@@ -330,7 +330,7 @@ var Items = []Item{
 	{Name: "Blazeguard", ID: 28426, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 102.0, WeaponDamageMax: 190.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 25, stats.MeleeHit: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Blazing Eternium Band", ID: 24089, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 18, stats.MP5: 8}, SocketBonus: stats.Stats{}},
 	{Name: "Bleeding Hollow Warhammer", ID: 27741, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 47.0, WeaponDamageMax: 151.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 17, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellCrit: 16}, SocketBonus: stats.Stats{}},
-	{Name: "Blessed Adamantite Bracers", ID: 30862, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.SpellPower: 21, stats.HealingPower: 83, stats.SpellCrit: 21, stats.Armor: 772}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "Blessed Adamantite Bracers", ID: 30862, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.SpellPower: 21, stats.HealingPower: 83, stats.SpellCrit: 21, stats.Armor: 772}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Blessed Band of Karabor", ID: 32528, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 20, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 6, stats.SpellHaste: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Blessed Bracers", ID: 23539, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 14, stats.SpellPower: 19, stats.HealingPower: 76, stats.SpellCrit: 18, stats.Armor: 581}, SocketBonus: stats.Stats{}},
 	{Name: "Blessed Elunite Coverings", ID: 33566, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 34, stats.SpellPower: 54, stats.HealingPower: 54, stats.MP5: 7, stats.SpellCrit: 22, stats.Armor: 404}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
@@ -344,13 +344,13 @@ var Items = []Item{
 	{Name: "Blessed Qiraji War Axe", ID: 21242, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 110.0, WeaponDamageMax: 205.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 79, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 10, stats.AttackPower: 14, stats.MeleeCrit: 14, stats.RangedAttackPower: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Blessed Qiraji War Hammer", ID: 21268, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 89.0, WeaponDamageMax: 166.0, SwingSpeed: 2.10, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 79, Stats: stats.Stats{stats.Strength: 10, stats.Stamina: 12, stats.Armor: 70, stats.Defense: 12, stats.FeralAttackPower: 337}, SocketBonus: stats.Stats{}},
 	{Name: "Blessed Scale Girdle", ID: 29180, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Intellect: 15, stats.AttackPower: 70, stats.Armor: 367, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
-	{Name: "Blind-Seers Icon", ID: 32361, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 16, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 24}, SocketBonus: stats.Stats{}},
+	{Name: "Blind-Seers Icon", ID: 32361, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 16, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 24}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Blinkstrike", ID: 31332, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 147.0, WeaponDamageMax: 275.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Blood Guard's Necklace of Ferocity", ID: 30710, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 54, stats.MeleeCrit: 17, stats.RangedAttackPower: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Blood Knight Defender", ID: 27449, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 26, stats.Armor: 3806, stats.Block: 18, stats.BlockValue: 86, stats.ArcaneResistance: 20}, SocketBonus: stats.Stats{}},
-	{Name: "Blood Knight War Cloak", ID: 29382, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 22, stats.AttackPower: 48, stats.Armor: 93, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
-	{Name: "Blood-cursed Shoulderpads", ID: 32338, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 19, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellHit: 18, stats.SpellCrit: 25, stats.Armor: 177}, SocketBonus: stats.Stats{}},
-	{Name: "Blood-stained Pauldrons", ID: 30866, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 47, stats.Stamina: 34, stats.MeleeHit: 23, stats.MeleeCrit: 32, stats.Armor: 1324}, SocketBonus: stats.Stats{}},
+	{Name: "Blood Knight War Cloak", ID: 29382, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 22, stats.AttackPower: 48, stats.Armor: 93, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
+	{Name: "Blood-cursed Shoulderpads", ID: 32338, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 19, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellHit: 18, stats.SpellCrit: 25, stats.Armor: 177}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
+	{Name: "Blood-stained Pauldrons", ID: 30866, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 47, stats.Stamina: 34, stats.MeleeHit: 23, stats.MeleeCrit: 32, stats.Armor: 1324}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Bloodfang Belt", ID: 16910, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 20, stats.Stamina: 15, stats.MeleeCrit: 14, stats.Armor: 144, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Bloodfang Armor"},
 	{Name: "Bloodfang Boots", ID: 16906, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 6, stats.Agility: 25, stats.Stamina: 17, stats.Armor: 176, stats.Dodge: 12, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Bloodfang Armor"},
 	{Name: "Bloodfang Bracers", ID: 16911, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 13, stats.MeleeHit: 10, stats.Armor: 112}, SocketBonus: stats.Stats{}, SetName: "Bloodfang Armor"},
@@ -364,17 +364,17 @@ var Items = []Item{
 	{Name: "Bloodfyre Robes of Annihilation", ID: 28252, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.SpellPower: 54, stats.HealingPower: 54, stats.Armor: 156}, SocketBonus: stats.Stats{}},
 	{Name: "Bloodguard's Greaves", ID: 30386, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 11, stats.Stamina: 42, stats.Armor: 685, stats.Defense: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Bloodlord Legplates", ID: 27487, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 27, stats.MeleeCrit: 11, stats.Armor: 1019}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
-	{Name: "Bloodlust Brooch", ID: 29383, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.AttackPower: 72, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
-	{Name: "Bloodmaw Magus-Blade", ID: 28802, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 36.0, WeaponDamageMax: 136.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, QualityModifier: -44.500, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 15, stats.SpellPower: 203, stats.HealingPower: 203, stats.SpellCrit: 25}, SocketBonus: stats.Stats{}},
+	{Name: "Bloodlust Brooch", ID: 29383, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.AttackPower: 72, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 41},
+	{Name: "Bloodmaw Magus-Blade", ID: 28802, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 36.0, WeaponDamageMax: 136.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, QualityModifier: -44.500, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 15, stats.SpellPower: 203, stats.HealingPower: 203, stats.SpellCrit: 25}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Bloodmoon", ID: 28436, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 375.0, WeaponDamageMax: 564.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 136, Stats: stats.Stats{stats.AttackPower: 112, stats.MeleeCrit: 56, stats.RangedAttackPower: 112}, SocketBonus: stats.Stats{}},
-	{Name: "Bloodsea Brigand's Vest", ID: 30101, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 92, stats.MeleeHit: 27, stats.MeleeCrit: 36, stats.Armor: 404, stats.RangedAttackPower: 92}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
+	{Name: "Bloodsea Brigand's Vest", ID: 30101, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 92, stats.MeleeHit: 27, stats.MeleeCrit: 36, stats.Armor: 404, stats.RangedAttackPower: 92}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Fathom-Lord Karathress"},
 	{Name: "Bloodskull Destroyer", ID: 28210, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 130.0, WeaponDamageMax: 243.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.AttackPower: 22, stats.MeleeCrit: 21, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Bloodstained Elven Battlevest", ID: 33215, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 69, stats.MeleeCrit: 35, stats.ArmorPenetration: 161, stats.Armor: 1607}, SocketBonus: stats.Stats{}},
 	{Name: "Bloodsworn Warboots", ID: 27788, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Strength: 31, stats.Stamina: 29, stats.MeleeHit: 17, stats.Armor: 780}, SocketBonus: stats.Stats{}},
 	{Name: "Bloodthirster's Wargreaves", ID: 33501, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 43, stats.MeleeHit: 38, stats.Armor: 1406}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}},
 	{Name: "Bloodwarder's Rifle", ID: 31000, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 114.0, WeaponDamageMax: 213.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 8, stats.Stamina: 7, stats.AttackPower: 30, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Blue Diamond Witchwand", ID: 28588, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 169.0, WeaponDamageMax: 314.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 13, stats.Spirit: 11, stats.SpellPower: 10, stats.HealingPower: 39}, SocketBonus: stats.Stats{}},
-	{Name: "Blue Suede Shoes", ID: 30894, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 32, stats.SpellPower: 56, stats.HealingPower: 56, stats.SpellHit: 18, stats.Armor: 162}, SocketBonus: stats.Stats{}},
+	{Name: "Blue Diamond Witchwand", ID: 28588, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 169.0, WeaponDamageMax: 314.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 13, stats.Spirit: 11, stats.SpellPower: 10, stats.HealingPower: 39}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Crone"},
+	{Name: "Blue Suede Shoes", ID: 30894, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 32, stats.SpellPower: 56, stats.HealingPower: 56, stats.SpellHit: 18, stats.Armor: 162}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Blue's Greaves of the Righteous Guardian", ID: 34947, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 58, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellHit: 23, stats.Armor: 1213, stats.Block: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Blued Steel Gauntlets", ID: 29812, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Strength: 16, stats.Agility: 28, stats.MeleeHit: 12, stats.Armor: 639}, SocketBonus: stats.Stats{}},
 	{Name: "Boggspine Knuckles", ID: 27747, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 130.0, WeaponDamageMax: 243.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 15, stats.Stamina: 10, stats.AttackPower: 10, stats.MeleeHit: 11, stats.RangedAttackPower: 10}, SocketBonus: stats.Stats{}},
@@ -393,7 +393,7 @@ var Items = []Item{
 	{Name: "Bonescythe Ring", ID: 23060, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 92, Stats: stats.Stats{stats.Strength: 20, stats.Agility: 20, stats.Stamina: 10, stats.MeleeHit: 10}, SocketBonus: stats.Stats{}, SetName: "Bonescythe Armor"},
 	{Name: "Bonescythe Sabatons", ID: 22480, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 86, Stats: stats.Stats{stats.Stamina: 18, stats.AttackPower: 64, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.Armor: 195, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}, SetName: "Bonescythe Armor"},
 	{Name: "Bonescythe Waistguard", ID: 22482, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Strength: 23, stats.Agility: 24, stats.Stamina: 20, stats.MeleeCrit: 14, stats.Armor: 162}, SocketBonus: stats.Stats{}, SetName: "Bonescythe Armor"},
-	{Name: "Boneweave Girdle", ID: 32346, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 38, stats.Intellect: 26, stats.AttackPower: 76, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 556, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
+	{Name: "Boneweave Girdle", ID: 32346, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 38, stats.Intellect: 26, stats.AttackPower: 76, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 556, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Book of Highborne Hymns", ID: 34206, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 20, stats.SpellPower: 24, stats.HealingPower: 94, stats.MP5: 9, stats.SpellHaste: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Boot's Boots", ID: 30002, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 28, stats.Stamina: 12, stats.MeleeCrit: 7, stats.Armor: 685, stats.Parry: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Absolution", ID: 34562, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 30, stats.Spirit: 29, stats.SpellPower: 32, stats.HealingPower: 127, stats.MP5: 8, stats.SpellHaste: 19, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}, SetName: "Vestments of Absolution"},
@@ -401,20 +401,20 @@ var Items = []Item{
 	{Name: "Boots of Blasting", ID: 30037, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 25, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHit: 18, stats.SpellCrit: 25, stats.Armor: 148}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Courage Unending", ID: 30027, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 20, stats.SpellPower: 30, stats.HealingPower: 120, stats.SpellCrit: 31, stats.Armor: 1105}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Displacement", ID: 23073, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 21, stats.Armor: 190}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of Effortless Striking", ID: 30060, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 41, stats.AttackPower: 58, stats.Armor: 278, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
+	{Name: "Boots of Effortless Striking", ID: 30060, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 41, stats.AttackPower: 58, stats.Armor: 278, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Boots of Elusion", ID: 30641, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.Armor: 997, stats.Defense: 23, stats.Dodge: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Epiphany", ID: 21600, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 19, stats.SpellPower: 34, stats.HealingPower: 34, stats.Armor: 96}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Ethereal Manipulation", ID: 29258, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 21, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 128}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of Foretelling", ID: 28517, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 23, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellCrit: 19, stats.Armor: 134}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}},
+	{Name: "Boots of Foretelling", ID: 28517, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 23, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellCrit: 19, stats.Armor: 134}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Boots of Incantations", ID: 34919, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 26, stats.Spirit: 23, stats.SpellPower: 47, stats.HealingPower: 47, stats.SpellHit: 17, stats.Armor: 162}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Boots of Natural Grace", ID: 30041, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 33, stats.Agility: 26, stats.Stamina: 37, stats.Intellect: 13, stats.MeleeHit: 14, stats.Armor: 474}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of Oceanic Fury", ID: 32242, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 36, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 26, stats.Armor: 679}, SocketBonus: stats.Stats{}},
+	{Name: "Boots of Oceanic Fury", ID: 32242, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 36, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 26, stats.Armor: 679}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Boots of Resuscitation", ID: 34707, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 7, stats.Armor: 201}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
 	{Name: "Boots of Righteous Fortitude", ID: 32778, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 14, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 800, stats.Defense: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Shackled Souls", ID: 32398, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 40, stats.Armor: 628, stats.ShadowResistance: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of Transcendence", ID: 16919, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 17, stats.Spirit: 17, stats.SpellPower: 12, stats.HealingPower: 47, stats.Armor: 91, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Vestments of Transcendence"},
 	{Name: "Boots of Utter Darkness", ID: 30039, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.AttackPower: 66, stats.MeleeHit: 23, stats.MeleeCrit: 32, stats.Armor: 278, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of Valiance", ID: 28569, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 19, stats.HealingPower: 74, stats.SpellCrit: 25, stats.Armor: 997}, SocketBonus: stats.Stats{}},
+	{Name: "Boots of Valiance", ID: 28569, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 19, stats.HealingPower: 74, stats.SpellCrit: 25, stats.Armor: 997}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Boots of Zealotry", ID: 31276, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Strength: 22, stats.Intellect: 17, stats.MP5: 8, stats.MeleeCrit: 21, stats.Armor: 740}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Beneficent", ID: 30398, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Colossus", ID: 27813, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 22, stats.Stamina: 27, stats.Armor: 800, stats.Defense: 19}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Defense: 3}},
@@ -424,17 +424,17 @@ var Items = []Item{
 	{Name: "Boots of the Endless Hunt", ID: 29262, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 19, stats.Intellect: 23, stats.MP5: 6, stats.AttackPower: 48, stats.Armor: 535, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Fallen Hero", ID: 21688, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Strength: 20, stats.Agility: 14, stats.Stamina: 22, stats.MeleeHit: 10, stats.Armor: 664}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Glade-Keeper", ID: 28251, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 24, stats.Spirit: 20, stats.SpellPower: 18, stats.HealingPower: 71, stats.Armor: 201}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of the Incorrupt", ID: 28663, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 24, stats.Spirit: 23, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 8, stats.Armor: 134}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of the Infernal Coven", ID: 28670, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 23, stats.SpellPower: 34, stats.HealingPower: 34, stats.Armor: 134}, SocketBonus: stats.Stats{}},
+	{Name: "Boots of the Incorrupt", ID: 28663, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 24, stats.Spirit: 23, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 8, stats.Armor: 134}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
+	{Name: "Boots of the Infernal Coven", ID: 28670, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 23, stats.SpellPower: 34, stats.HealingPower: 34, stats.Armor: 134}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Boots of the Long Road", ID: 30035, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.Spirit: 22, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 9, stats.Armor: 148}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Malefic", ID: 34564, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 26, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 28, stats.SpellCrit: 16, stats.SpellHaste: 29, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Malefic Raiment"},
 	{Name: "Boots of the Nexus Warden", ID: 30519, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 17, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellHit: 18, stats.Armor: 97}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Pious", ID: 29251, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 26, stats.Spirit: 23, stats.SpellPower: 21, stats.HealingPower: 83, stats.Armor: 128}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Protector", ID: 30033, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 22, stats.SpellPower: 27, stats.HealingPower: 27, stats.Armor: 1105, stats.Defense: 26, stats.Block: 17}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of the Resilient", ID: 32267, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 51, stats.Armor: 1105, stats.Defense: 25, stats.Block: 25}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Boots of the Resilient", ID: 32267, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 51, stats.Armor: 1105, stats.Defense: 25, stats.Block: 25}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Boots of the Righteous Path", ID: 29254, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 26, stats.SpellPower: 28, stats.HealingPower: 28, stats.Armor: 955, stats.Defense: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Shadow Flame", ID: 19381, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 22, stats.AttackPower: 44, stats.MeleeHit: 20, stats.Armor: 310, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
-	{Name: "Boots of the Shifting Nightmare", ID: 30050, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 41, stats.Intellect: 22, stats.ShadowSpellPower: 59, stats.SpellHit: 18, stats.Armor: 148}, SocketBonus: stats.Stats{}},
+	{Name: "Boots of the Shifting Nightmare", ID: 30050, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 41, stats.Intellect: 22, stats.ShadowSpellPower: 59, stats.SpellHit: 18, stats.Armor: 148}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Boots of the Shifting Sands", ID: 28339, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 40, stats.MeleeCrit: 19, stats.Armor: 201, stats.RangedAttackPower: 40}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 3}},
 	{Name: "Boots of the Skybreaker", ID: 30953, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 22, stats.Intellect: 15, stats.AttackPower: 48, stats.Armor: 404, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "Boots of the Tempest", ID: 34574, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 29, stats.Spirit: 20, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 15, stats.SpellCrit: 20, stats.SpellHaste: 25, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Tempest Regalia"},
@@ -444,23 +444,23 @@ var Items = []Item{
 	{Name: "Borak's Reminder", ID: 31073, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 84.0, WeaponDamageMax: 157.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 11, stats.AttackPower: 40, stats.MeleeHit: 7, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Borderland Fortress Grips", ID: 34352, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 156, Stats: stats.Stats{stats.Stamina: 66, stats.Armor: 1217, stats.Defense: 22, stats.Dodge: 36, stats.Parry: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
 	{Name: "Borderland Paingrips", ID: 34341, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Strength: 47, stats.Stamina: 48, stats.MeleeCrit: 39, stats.ArmorPenetration: 161, stats.Armor: 1277}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 3}},
-	{Name: "Botanist's Gloves of Growth", ID: 32328, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 277}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
-	{Name: "Boundless Agony", ID: 30901, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 144.0, WeaponDamageMax: 217.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.MeleeCrit: 24, stats.ArmorPenetration: 210}, SocketBonus: stats.Stats{}},
+	{Name: "Botanist's Gloves of Growth", ID: 32328, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 277}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
+	{Name: "Boundless Agony", ID: 30901, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 144.0, WeaponDamageMax: 217.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.MeleeCrit: 24, stats.ArmorPenetration: 210}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Bow of Unusual Slowness", ID: 34039, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 278.0, WeaponDamageMax: 518.0, SwingSpeed: 6.00, Phase: 0, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Bow-stitched Leggings", ID: 30900, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 28, stats.Intellect: 28, stats.AttackPower: 100, stats.MeleeCrit: 20, stats.Armor: 864, stats.RangedAttackPower: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
+	{Name: "Bow-stitched Leggings", ID: 30900, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 28, stats.Intellect: 28, stats.AttackPower: 100, stats.MeleeCrit: 20, stats.Armor: 864, stats.RangedAttackPower: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Bracelets of Royal Redemption", ID: 21604, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 8, stats.Intellect: 10, stats.Spirit: 9, stats.SpellPower: 18, stats.HealingPower: 71, stats.Armor: 61}, SocketBonus: stats.Stats{}},
 	{Name: "Bracelets of Wrath", ID: 16959, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 13, stats.Stamina: 27, stats.Armor: 428}, SocketBonus: stats.Stats{}, SetName: "Battlegear of Wrath"},
 	{Name: "Bracers of Absolution", ID: 34434, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 23, stats.Spirit: 16, stats.SpellPower: 39, stats.HealingPower: 39, stats.MP5: 5, stats.SpellHaste: 20, stats.Armor: 113}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Absolution Regalia"},
 	{Name: "Bracers of Arcane Accuracy", ID: 19374, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 12, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellHit: 8, stats.Armor: 57}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of Dignity", ID: 29252, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 12, stats.SpellPower: 19, stats.HealingPower: 19, stats.Armor: 608, stats.Defense: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of Divine Infusion", ID: 34705, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 12, stats.SpellPower: 14, stats.HealingPower: 56, stats.MP5: 5, stats.Armor: 68}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Bracers of Eradication", ID: 30057, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 25, stats.Stamina: 12, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 703}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 2}},
+	{Name: "Bracers of Eradication", ID: 30057, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 25, stats.Stamina: 12, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 703}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 2}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Bracers of Eternal Reckoning", ID: 21584, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 20, stats.Armor: 276}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of Havok", ID: 24250, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Intellect: 12, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 67}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 2}},
 	{Name: "Bracers of Just Rewards", ID: 27447, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 23, stats.Stamina: 16, stats.Intellect: 15, stats.MP5: 4, stats.Armor: 509}, SocketBonus: stats.Stats{}},
-	{Name: "Bracers of Justice", ID: 28512, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.SpellPower: 16, stats.HealingPower: 62, stats.SpellCrit: 16, stats.Armor: 634}, SocketBonus: stats.Stats{}},
-	{Name: "Bracers of Maliciousness", ID: 28514, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 50, stats.MeleeCrit: 22, stats.Armor: 159, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
-	{Name: "Bracers of Martyrdom", ID: 30871, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 20, stats.Spirit: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 103}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}},
+	{Name: "Bracers of Justice", ID: 28512, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.SpellPower: 16, stats.HealingPower: 62, stats.SpellCrit: 16, stats.Armor: 634}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
+	{Name: "Bracers of Maliciousness", ID: 28514, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 50, stats.MeleeCrit: 22, stats.Armor: 159, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
+	{Name: "Bracers of Martyrdom", ID: 30871, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 20, stats.Spirit: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 103}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Bracers of Nimble Thought", ID: 32586, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 20, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellHaste: 28, stats.Armor: 103}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of Recklessness", ID: 31284, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 29, stats.Stamina: 18, stats.Armor: 484}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of Renewed Life", ID: 32582, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 20, stats.SpellPower: 22, stats.HealingPower: 86, stats.SpellHaste: 28, stats.Armor: 194}, SocketBonus: stats.Stats{}},
@@ -474,10 +474,10 @@ var Items = []Item{
 	{Name: "Bracers of the Green Fortress", ID: 23538, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 39, stats.Armor: 581, stats.Defense: 17, stats.Dodge: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of the Hunt", ID: 29259, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 16, stats.Intellect: 17, stats.MP5: 4, stats.AttackPower: 36, stats.Armor: 340, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Bracers of the Malefic", ID: 34436, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 22, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 18, stats.SpellHaste: 21, stats.Armor: 113}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Malefic Raiment"},
-	{Name: "Bracers of the Pathfinder", ID: 30864, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 24, stats.Intellect: 24, stats.AttackPower: 48, stats.Armor: 432, stats.RangedAttackPower: 48}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 2}},
+	{Name: "Bracers of the Pathfinder", ID: 30864, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 24, stats.Intellect: 24, stats.AttackPower: 48, stats.Armor: 432, stats.RangedAttackPower: 48}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 2}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Bracers of the Tempest", ID: 34447, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 17, stats.Spirit: 14, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 11, stats.SpellHaste: 26, stats.Armor: 113}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellCrit: 2}, SetName: "Tempest Regalia"},
 	{Name: "Bracers of the Weald", ID: 31516, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 8, stats.AttackPower: 22, stats.MeleeCrit: 20, stats.Armor: 238, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
-	{Name: "Bracers of the White Stag", ID: 28453, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 18, stats.Spirit: 22, stats.SpellPower: 26, stats.HealingPower: 26, stats.Armor: 159}, SocketBonus: stats.Stats{}},
+	{Name: "Bracers of the White Stag", ID: 28453, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 18, stats.Spirit: 22, stats.SpellPower: 26, stats.HealingPower: 26, stats.Armor: 159}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Braided Eternium Chain", ID: 24114, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 102, Stats: stats.Stats{stats.MeleeHit: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Breastplate of Agony's Aversion", ID: 34394, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 78, stats.MeleeHaste: 40, stats.Armor: 1983, stats.Defense: 36, stats.Dodge: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}},
 	{Name: "Breastplate of Fierce Survival", ID: 34605, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 32, stats.SpellPower: 30, stats.HealingPower: 118, stats.SpellCrit: 22, stats.Armor: 1450}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 4}},
@@ -490,7 +490,7 @@ var Items = []Item{
 	{Name: "Breastplate of Ten Storms", ID: 16950, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 31, stats.Spirit: 16, stats.SpellPower: 23, stats.HealingPower: 23, stats.Armor: 551, stats.FireResistance: 10, stats.NatureResistance: 10}, SocketBonus: stats.Stats{}, SetName: "The Ten Storms"},
 	{Name: "Breastplate of Wrath", ID: 16966, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 40, stats.Armor: 978, stats.Defense: 16, stats.FireResistance: 10, stats.NatureResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Battlegear of Wrath"},
 	{Name: "Breastplate of the Bold", ID: 28205, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 23, stats.Agility: 21, stats.Stamina: 33, stats.Armor: 1164, stats.Defense: 19}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Defense: 4}, SetName: "Bold Armor"},
-	{Name: "Breastplate of the Lightbinder", ID: 28662, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 40, stats.SpellPower: 30, stats.HealingPower: 118, stats.MP5: 13, stats.Armor: 1450}, SocketBonus: stats.Stats{}},
+	{Name: "Breastplate of the Lightbinder", ID: 28662, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 40, stats.SpellPower: 30, stats.HealingPower: 118, stats.MP5: 13, stats.Armor: 1450}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Breastplate of the Righteous", ID: 28203, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 28, stats.SpellPower: 23, stats.HealingPower: 23, stats.Armor: 1164, stats.Defense: 20}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}, SetName: "Righteous Armor"},
 	{Name: "Breeches of Absolution", ID: 31068, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 33, stats.Spirit: 34, stats.SpellPower: 39, stats.HealingPower: 156, stats.MP5: 8, stats.Armor: 214}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}, SetName: "Vestments of Absolution"},
 	{Name: "Breeches of Natural Aggression", ID: 34169, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 41, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellCrit: 49, stats.Armor: 436}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
@@ -500,14 +500,14 @@ var Items = []Item{
 	{Name: "Breeching Comet", ID: 34616, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 167.0, WeaponDamageMax: 312.0, SwingSpeed: 2.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 15, stats.AttackPower: 40, stats.RangedAttackPower: 40}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Brightbrew Charm", ID: 37127, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45}, SocketBonus: stats.Stats{}},
 	{Name: "Brightdawn Bracers", ID: 30384, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 13, stats.Stamina: 18, stats.AttackPower: 36, stats.Armor: 109, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
-	{Name: "Brighthelm of Justice", ID: 30048, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 44, stats.SpellPower: 34, stats.HealingPower: 135, stats.MP5: 14, stats.Armor: 1306}, SocketBonus: stats.Stats{}},
+	{Name: "Brighthelm of Justice", ID: 30048, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 44, stats.SpellPower: 34, stats.HealingPower: 135, stats.MP5: 14, stats.Armor: 1306}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Brimstone Staff", ID: 22800, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 141.0, WeaponDamageMax: 251.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, QualityModifier: -23.900, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 30, stats.SpellPower: 113, stats.HealingPower: 113, stats.SpellHit: 16, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
-	{Name: "Bristleblitz Striker", ID: 30906, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 201.0, WeaponDamageMax: 374.0, SwingSpeed: 3.00, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 28, stats.MeleeCrit: 25}, SocketBonus: stats.Stats{}},
+	{Name: "Bristleblitz Striker", ID: 30906, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 201.0, WeaponDamageMax: 374.0, SwingSpeed: 3.00, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 28, stats.MeleeCrit: 25}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Britches of Malorne", ID: 29094, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 32, stats.Intellect: 35, stats.Spirit: 18, stats.SpellPower: 44, stats.HealingPower: 44, stats.MP5: 6, stats.SpellCrit: 25, stats.Armor: 332}, SocketBonus: stats.Stats{}, SetName: "Malorne Regalia"},
 	{Name: "Brooch of Deftness", ID: 33296, Type: proto.ItemType_ItemTypeNeck, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 48, stats.MeleeHit: 22, stats.Expertise: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Brooch of Heightened Potential", ID: 28134, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 14, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellHit: 9, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Brooch of Nature's Mercy", ID: 33281, Type: proto.ItemType_ItemTypeNeck, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Intellect: 24, stats.Spirit: 19, stats.SpellPower: 25, stats.HealingPower: 100, stats.SpellHaste: 33}, SocketBonus: stats.Stats{}},
-	{Name: "Brooch of Unquenchable Fury", ID: 28530, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellHit: 15}, SocketBonus: stats.Stats{}},
+	{Name: "Brooch of Unquenchable Fury", ID: 28530, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellHit: 15}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Brooch of the Highborne", ID: 34184, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 22, stats.SpellPower: 24, stats.HealingPower: 94, stats.MP5: 9, stats.SpellHaste: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Brooch of the Immortal King", ID: 32534, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Defense: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Brood Mother Leggings", ID: 31685, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 10, stats.MP5: 4, stats.AttackPower: 42, stats.MeleeCrit: 37, stats.Armor: 488, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
@@ -645,8 +645,8 @@ var Items = []Item{
 	{Name: "Brutal Gladiator's Wyrmhide Legguards", ID: 35113, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 65, stats.Intellect: 40, stats.SpellPower: 67, stats.HealingPower: 67, stats.MP5: 11, stats.SpellCrit: 27, stats.Armor: 492, stats.Resilience: 31}, SocketBonus: stats.Stats{}},
 	{Name: "Brutal Gladiator's Wyrmhide Spaulders", ID: 35114, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 49, stats.Intellect: 24, stats.SpellPower: 41, stats.HealingPower: 41, stats.MP5: 5, stats.SpellCrit: 13, stats.Armor: 416, stats.Resilience: 22}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}},
 	{Name: "Brutal Gladiator's Wyrmhide Tunic", ID: 35115, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 62, stats.Intellect: 33, stats.SpellPower: 59, stats.HealingPower: 59, stats.MP5: 7, stats.SpellCrit: 18, stats.Armor: 541, stats.Resilience: 27}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 4}},
-	{Name: "Brute Cloak of the Ogre-Magi", ID: 28797, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 23, stats.Armor: 105}, SocketBonus: stats.Stats{}},
-	{Name: "Bulwark of Azzinoth", ID: 32375, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 60, stats.Armor: 6336, stats.Defense: 29, stats.BlockValue: 174}, SocketBonus: stats.Stats{}},
+	{Name: "Brute Cloak of the Ogre-Magi", ID: 28797, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 23, stats.Armor: 105}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
+	{Name: "Bulwark of Azzinoth", ID: 32375, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 60, stats.Armor: 6336, stats.Defense: 29, stats.BlockValue: 174}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Bulwark of Kings", ID: 28484, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 55, stats.MeleeHit: 23, stats.MeleeCrit: 37, stats.Armor: 1595}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
 	{Name: "Bulwark of the Amani Empire", ID: 33326, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 42, stats.Armor: 5400, stats.Block: 19, stats.BlockValue: 183}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Bulwark of the Ancient Kings", ID: 28485, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 56, stats.MeleeHit: 25, stats.MeleeCrit: 41, stats.Armor: 1825}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
@@ -672,7 +672,7 @@ var Items = []Item{
 	{Name: "Cataclysm Shoulderguards", ID: 30168, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 27, stats.HealingPower: 106, stats.MP5: 8, stats.Armor: 700}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SetName: "Cataclysm Raiment"},
 	{Name: "Cataclysm Shoulderpads", ID: 30173, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 19, stats.SpellPower: 41, stats.HealingPower: 41, stats.MP5: 6, stats.SpellCrit: 24, stats.Armor: 700}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}, SetName: "Cataclysm Regalia"},
 	{Name: "Cataclysm Shoulderplates", ID: 30194, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Strength: 30, stats.Stamina: 37, stats.Intellect: 21, stats.MP5: 6, stats.MeleeCrit: 22, stats.Armor: 700}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SetName: "Cataclysm Harness"},
-	{Name: "Cataclysm's Edge", ID: 30902, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 386.0, WeaponDamageMax: 580.0, SwingSpeed: 3.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Strength: 75, stats.Stamina: 49, stats.ArmorPenetration: 335}, SocketBonus: stats.Stats{}},
+	{Name: "Cataclysm's Edge", ID: 30902, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 386.0, WeaponDamageMax: 580.0, SwingSpeed: 3.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Strength: 75, stats.Stamina: 49, stats.ArmorPenetration: 335}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Caustic Feelers", ID: 25578, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 28, stats.AttackPower: 36, stats.Armor: 152, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Ced's Carver", ID: 31305, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 103.0, WeaponDamageMax: 155.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 30, stats.MeleeCrit: 16, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Celestial Jewel Ring", ID: 29814, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 106, Stats: stats.Stats{stats.Intellect: 13, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 4}, SocketBonus: stats.Stats{}},
@@ -794,7 +794,7 @@ var Items = []Item{
 	{Name: "Chestguard of Malorne", ID: 29087, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.Spirit: 25, stats.SpellPower: 30, stats.HealingPower: 118, stats.Armor: 379}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SetName: "Malorne Raiment"},
 	{Name: "Chestguard of No Remorse", ID: 27787, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 35, stats.AttackPower: 92, stats.MeleeCrit: 21, stats.Armor: 285, stats.RangedAttackPower: 92}, SocketBonus: stats.Stats{}},
 	{Name: "Chestguard of Relentless Storms", ID: 32592, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 30, stats.SpellPower: 74, stats.HealingPower: 74, stats.SpellCrit: 46, stats.Armor: 988}, SocketBonus: stats.Stats{}},
-	{Name: "Chestguard of the Conniver", ID: 28601, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 37, stats.Stamina: 36, stats.AttackPower: 90, stats.MeleeHit: 22, stats.Armor: 364, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}},
+	{Name: "Chestguard of the Conniver", ID: 28601, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 37, stats.Stamina: 36, stats.AttackPower: 90, stats.MeleeHit: 22, stats.Armor: 364, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Chestguard of the Dark Stalker", ID: 31295, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 31, stats.AttackPower: 84, stats.MeleeCrit: 32, stats.Armor: 285, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}},
 	{Name: "Chestguard of the Prowler", ID: 27461, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 31, stats.Stamina: 26, stats.AttackPower: 70, stats.MeleeHit: 17, stats.Armor: 292, stats.RangedAttackPower: 70, stats.Parry: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Chestguard of the Stoic Guardian", ID: 33522, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 60, stats.SpellPower: 35, stats.HealingPower: 35, stats.Armor: 1607, stats.Defense: 22, stats.Dodge: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
@@ -805,18 +805,18 @@ var Items = []Item{
 	{Name: "Chestplate of A'dal", ID: 30258, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 27, stats.MeleeHit: 22, stats.MeleeCrit: 25, stats.Armor: 1106}, SocketBonus: stats.Stats{}},
 	{Name: "Chestplate of Stoicism", ID: 34939, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 78, stats.Armor: 1765, stats.Defense: 51, stats.BlockValue: 39, stats.Dodge: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Chief Engineer's Belt", ID: 30516, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 11, stats.Spirit: 10, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 75}, SocketBonus: stats.Stats{}},
-	{Name: "Choker of Animalistic Fury", ID: 30059, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 64, stats.MeleeCrit: 23, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
-	{Name: "Choker of Endless Nightmares", ID: 32260, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 72, stats.MeleeHit: 21, stats.MeleeCrit: 27, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
+	{Name: "Choker of Animalistic Fury", ID: 30059, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 64, stats.MeleeCrit: 23, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
+	{Name: "Choker of Endless Nightmares", ID: 32260, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 72, stats.MeleeHit: 21, stats.MeleeCrit: 27, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Choker of Fluid Thought", ID: 28419, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 17, stats.Spirit: 17, stats.SpellPower: 12, stats.HealingPower: 47}, SocketBonus: stats.Stats{}},
 	{Name: "Choker of Repentance", ID: 31321, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Strength: 23, stats.Intellect: 15, stats.SpellPower: 22, stats.HealingPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Choker of Serrated Blades", ID: 32591, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.AttackPower: 70, stats.ArmorPenetration: 175, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
-	{Name: "Choker of Vile Intent", ID: 29381, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 18, stats.AttackPower: 42, stats.MeleeHit: 18, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
+	{Name: "Choker of Vile Intent", ID: 29381, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 18, stats.AttackPower: 42, stats.MeleeHit: 18, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Choker of the Arctic Flow", ID: 35508, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 28, stats.HealingPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Choker of the Fire Lord", ID: 18814, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 7, stats.Intellect: 7, stats.SpellPower: 34, stats.HealingPower: 34}, SocketBonus: stats.Stats{}},
 	{Name: "Chromatic Boots", ID: 19387, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Strength: 20, stats.Agility: 20, stats.Stamina: 19, stats.MeleeHit: 10, stats.Armor: 681}, SocketBonus: stats.Stats{}},
 	{Name: "Chromatically Tempered Sword", ID: 19352, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 106.0, WeaponDamageMax: 198.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 77, Stats: stats.Stats{stats.Strength: 14, stats.Agility: 14, stats.Stamina: 7}, SocketBonus: stats.Stats{}},
-	{Name: "Chronicle of Dark Secrets", ID: 30872, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 12, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 17, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}},
-	{Name: "Cincture of Will", ID: 28652, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 28, stats.Spirit: 25, stats.SpellPower: 20, stats.HealingPower: 79, stats.Armor: 109}, SocketBonus: stats.Stats{}},
+	{Name: "Chronicle of Dark Secrets", ID: 30872, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 12, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 17, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
+	{Name: "Cincture of Will", ID: 28652, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 28, stats.Spirit: 25, stats.SpellPower: 20, stats.HealingPower: 79, stats.Armor: 109}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Circle of Applied Force", ID: 19432, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.Strength: 12, stats.Agility: 22, stats.Stamina: 9}, SocketBonus: stats.Stats{}},
 	{Name: "Circlet of Arcane Might", ID: 24123, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 27, stats.Spirit: 27, stats.Armor: 138}, SocketBonus: stats.Stats{}},
 	{Name: "Circlet of Faith", ID: 22514, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.Spirit: 22, stats.SpellPower: 25, stats.HealingPower: 100, stats.MP5: 5, stats.Armor: 123}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
@@ -826,7 +826,7 @@ var Items = []Item{
 	{Name: "Claw of the Black Drake", ID: 19365, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 102.0, WeaponDamageMax: 191.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.Strength: 13, stats.Stamina: 7, stats.MeleeCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Claw of the Frost Wyrm", ID: 23242, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 75.0, WeaponDamageMax: 140.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 8, stats.AttackPower: 22, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Claw of the Netherwing Flight", ID: 31492, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 83.0, WeaponDamageMax: 156.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 30, stats.MeleeHit: 15, stats.Expertise: 15, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Claw of the Phoenix", ID: 29948, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 101.0, WeaponDamageMax: 189.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 30, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
+	{Name: "Claw of the Phoenix", ID: 29948, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 101.0, WeaponDamageMax: 189.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 30, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Claw of the Watcher", ID: 27846, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 125.0, WeaponDamageMax: 233.0, SwingSpeed: 2.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 24, stats.MeleeCrit: 12, stats.RangedAttackPower: 24}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
 	{Name: "Claymore of Unholy Might", ID: 22813, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 235.0, WeaponDamageMax: 354.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 20, stats.AttackPower: 98, stats.RangedAttackPower: 98}, SocketBonus: stats.Stats{}},
 	{Name: "Cleansed Fel Pauldrons", ID: 30369, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 34, stats.Agility: 18, stats.Stamina: 21, stats.Armor: 208}, SocketBonus: stats.Stats{}},
@@ -864,24 +864,24 @@ var Items = []Item{
 	{Name: "Cloak of the Fallen God", ID: 21710, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Strength: 11, stats.Agility: 26, stats.Stamina: 15, stats.Armor: 76}, SocketBonus: stats.Stats{}},
 	{Name: "Cloak of the Frigid Winds", ID: 35497, Type: proto.ItemType_ItemTypeBack, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellCrit: 22, stats.Armor: 93}, SocketBonus: stats.Stats{}},
 	{Name: "Cloak of the Golden Hive", ID: 21621, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 10, stats.Stamina: 19, stats.Armor: 68, stats.Defense: 9}, SocketBonus: stats.Stats{}},
-	{Name: "Cloak of the Illidari Council", ID: 32331, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 16, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 25, stats.Armor: 118}, SocketBonus: stats.Stats{}},
+	{Name: "Cloak of the Illidari Council", ID: 32331, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 16, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 25, stats.Armor: 118}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Cloak of the Inciter", ID: 27892, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 30, stats.MeleeHit: 16, stats.MeleeCrit: 18, stats.Armor: 76, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Cloak of the Necropolis", ID: 23050, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 90, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 11, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellHit: 8, stats.SpellCrit: 14, stats.Armor: 77}, SocketBonus: stats.Stats{}},
-	{Name: "Cloak of the Pit Stalker", ID: 28777, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 56, stats.MeleeCrit: 24, stats.Armor: 105, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
+	{Name: "Cloak of the Pit Stalker", ID: 28777, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 56, stats.MeleeCrit: 24, stats.Armor: 105, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Cloak of the Scourge", ID: 23030, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 23, stats.AttackPower: 30, stats.MeleeHit: 10, stats.Armor: 72, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Cloak of the Valiant Defender", ID: 29777, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 21, stats.Armor: 262, stats.Defense: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Clocktock's Jumpers", ID: 31441, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Strength: 15, stats.MeleeHit: 11, stats.MeleeCrit: 27, stats.Armor: 667}, SocketBonus: stats.Stats{}},
 	{Name: "Clutch of Demise", ID: 34177, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 33, stats.AttackPower: 64, stats.MeleeHaste: 30, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
 	{Name: "Clutch of the Soothing Breeze", ID: 34932, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 34, stats.SpellPower: 29, stats.HealingPower: 115, stats.MP5: 10, stats.Armor: 556}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Cobalt Band of Tyrigosa", ID: 29352, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 17, stats.SpellPower: 35, stats.HealingPower: 35}, SocketBonus: stats.Stats{}},
-	{Name: "Cobra-Lash Boots", ID: 30104, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 25, stats.Intellect: 25, stats.AttackPower: 66, stats.Armor: 665, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}},
+	{Name: "Cobra-Lash Boots", ID: 30104, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 25, stats.Intellect: 25, stats.AttackPower: 66, stats.Armor: 665, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Cobrascale Gloves", ID: 29503, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 33, stats.AttackPower: 50, stats.MeleeHit: 20, stats.MeleeCrit: 25, stats.Armor: 208, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Cobrascale Hood", ID: 29502, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Agility: 37, stats.Stamina: 42, stats.AttackPower: 74, stats.MeleeHit: 18, stats.Armor: 271, stats.RangedAttackPower: 74}, SocketBonus: stats.Stats{}},
 	{Name: "Coif of Alleria", ID: 34333, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Agility: 43, stats.Stamina: 45, stats.Intellect: 25, stats.AttackPower: 126, stats.MeleeCrit: 34, stats.ArmorPenetration: 182, stats.Armor: 929, stats.RangedAttackPower: 126}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Coif of the Jungle Stalker", ID: 33432, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 40, stats.Intellect: 35, stats.AttackPower: 72, stats.ArmorPenetration: 140, stats.Armor: 759, stats.RangedAttackPower: 72}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
 	{Name: "Coif of the Wicked", ID: 29782, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 39, stats.MP5: 10, stats.AttackPower: 52, stats.Armor: 490, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Coldwhisper Cord", ID: 27547, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 23, stats.Spirit: 12, stats.FrostSpellPower: 36, stats.SpellCrit: 16, stats.Armor: 88}, SocketBonus: stats.Stats{}},
-	{Name: "Collar of Cho'gall", ID: 28804, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 36, stats.SpellPower: 68, stats.HealingPower: 68, stats.Armor: 171}, SocketBonus: stats.Stats{}},
+	{Name: "Collar of Cho'gall", ID: 28804, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 36, stats.SpellPower: 68, stats.HealingPower: 68, stats.Armor: 171}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Collar of the Aldor", ID: 29076, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 35, stats.Spirit: 17, stats.SpellPower: 41, stats.HealingPower: 41, stats.SpellCrit: 27, stats.Armor: 164}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Aldor Regalia"},
 	{Name: "Collar of the Pit Lord", ID: 34178, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 63, stats.Expertise: 29, stats.Defense: 25}, SocketBonus: stats.Stats{}},
 	{Name: "Commander's Badge", ID: 32864, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 45}, SocketBonus: stats.Stats{}},
@@ -896,15 +896,15 @@ var Items = []Item{
 	{Name: "Consortium Blaster", ID: 29115, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 111.0, WeaponDamageMax: 207.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 28, stats.MeleeCrit: 7, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Consortium Combatant's Robes", ID: 29978, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 21, stats.Spirit: 10, stats.SpellPower: 46, stats.HealingPower: 46, stats.Armor: 141}, SocketBonus: stats.Stats{}},
 	{Name: "Continuum Blade", ID: 29185, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 11, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellHit: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Coral Band of the Revived", ID: 30110, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.Spirit: 27, stats.SpellPower: 25, stats.HealingPower: 100}, SocketBonus: stats.Stats{}},
-	{Name: "Coral-Barbed Shoulderpads", ID: 30097, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 33, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 6, stats.Armor: 675}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
+	{Name: "Coral Band of the Revived", ID: 30110, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.Spirit: 27, stats.SpellPower: 25, stats.HealingPower: 100}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
+	{Name: "Coral-Barbed Shoulderpads", ID: 30097, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 33, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 6, stats.Armor: 675}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Leotheras the Blind"},
 	{Name: "Cord of Absolution", ID: 34528, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 33, stats.Spirit: 21, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 14, stats.SpellHaste: 29, stats.Armor: 145}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Absolution Regalia"},
 	{Name: "Cord of Belief", ID: 27542, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 23, stats.Spirit: 20, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 8, stats.Armor: 88}, SocketBonus: stats.Stats{}},
 	{Name: "Cord of Braided Troll Hair", ID: 33480, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 34, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 6, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Cord of Nature's Sustenance", ID: 28655, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 30, stats.Spirit: 16, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 6, stats.Armor: 205}, SocketBonus: stats.Stats{}},
+	{Name: "Cord of Nature's Sustenance", ID: 28655, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 30, stats.Spirit: 16, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 6, stats.Armor: 205}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Cord of Reconstruction", ID: 34793, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 16, stats.Spirit: 18, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 88}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 3}},
 	{Name: "Cord of Sanctification", ID: 29250, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 24, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 7, stats.Armor: 105}, SocketBonus: stats.Stats{}},
-	{Name: "Cord of Screaming Terrors", ID: 30064, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 15, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 24, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Cord of Screaming Terrors", ID: 30064, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 15, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 24, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Cord of the Ring", ID: 25567, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 19, stats.SpellPower: 21, stats.HealingPower: 21, stats.Armor: 305}, SocketBonus: stats.Stats{}},
 	{Name: "Core of Ar'kelos", ID: 29776, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.AttackPower: 52, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Coren's Lucky Coin", ID: 38289, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.BlockValue: 59}, SocketBonus: stats.Stats{}},
@@ -919,24 +919,24 @@ var Items = []Item{
 	{Name: "Cover of Ursol the Wise", ID: 34245, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 42, stats.Spirit: 40, stats.SpellPower: 47, stats.HealingPower: 188, stats.MP5: 11, stats.Armor: 418}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Cowl of Absolution", ID: 31063, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 32, stats.Spirit: 33, stats.SpellPower: 39, stats.HealingPower: 156, stats.MP5: 8, stats.Armor: 199}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SetName: "Vestments of Absolution"},
 	{Name: "Cowl of Beastly Rage", ID: 32088, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 26, stats.Stamina: 27, stats.Intellect: 20, stats.Armor: 423}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Strength: 4}},
-	{Name: "Cowl of Benevolence", ID: 32329, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 27, stats.Spirit: 42, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 192}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
-	{Name: "Cowl of Defiance", ID: 28732, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 34, stats.Stamina: 33, stats.AttackPower: 100, stats.MeleeHit: 24, stats.Armor: 296, stats.RangedAttackPower: 100}, SocketBonus: stats.Stats{}},
+	{Name: "Cowl of Benevolence", ID: 32329, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 27, stats.Spirit: 42, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 192}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
+	{Name: "Cowl of Defiance", ID: 28732, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 34, stats.Stamina: 33, stats.AttackPower: 100, stats.MeleeHit: 24, stats.Armor: 296, stats.RangedAttackPower: 100}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Cowl of Gul'dan", ID: 34332, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 43, stats.SpellPower: 74, stats.HealingPower: 74, stats.SpellCrit: 36, stats.SpellHaste: 32, stats.Armor: 929}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Cowl of Light's Purity", ID: 34339, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 42, stats.Spirit: 38, stats.SpellPower: 47, stats.HealingPower: 188, stats.SpellHaste: 30, stats.Armor: 222}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Cowl of Naaru Blessings", ID: 32090, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.Spirit: 23, stats.SpellPower: 22, stats.HealingPower: 88, stats.Armor: 151}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
-	{Name: "Cowl of Nature's Breath", ID: 28803, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 42, stats.Spirit: 34, stats.SpellPower: 31, stats.HealingPower: 123, stats.Armor: 321}, SocketBonus: stats.Stats{}},
+	{Name: "Cowl of Nature's Breath", ID: 28803, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 42, stats.Spirit: 34, stats.SpellPower: 31, stats.HealingPower: 123, stats.Armor: 321}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Cowl of Tirisfal", ID: 30206, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 36, stats.Spirit: 24, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 24, stats.Armor: 181}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellHit: 4}, SetName: "Tirisfal Regalia"},
 	{Name: "Cowl of the Avatar", ID: 30152, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 28, stats.Spirit: 31, stats.SpellPower: 35, stats.HealingPower: 138, stats.MP5: 8, stats.Armor: 181}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 2}, SetName: "Avatar Raiment"},
-	{Name: "Cowl of the Grand Engineer", ID: 29986, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 27, stats.SpellPower: 53, stats.HealingPower: 53, stats.SpellHit: 16, stats.SpellCrit: 35, stats.Armor: 175}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
+	{Name: "Cowl of the Grand Engineer", ID: 29986, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 27, stats.SpellPower: 53, stats.HealingPower: 53, stats.SpellHit: 16, stats.SpellCrit: 35, stats.Armor: 175}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Tempest Keep", SourceDrop: "Void Reaver"},
 	{Name: "Cowl of the Guiltless", ID: 28206, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 52, stats.Armor: 237, stats.RangedAttackPower: 52, stats.Dodge: 30, stats.Resilience: 31}, SocketBonus: stats.Stats{}},
-	{Name: "Cowl of the Illidari High Lord", ID: 32525, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 31, stats.SpellPower: 64, stats.HealingPower: 64, stats.SpellHit: 21, stats.SpellCrit: 47, stats.Armor: 205}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
+	{Name: "Cowl of the Illidari High Lord", ID: 32525, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 31, stats.SpellPower: 64, stats.HealingPower: 64, stats.SpellHit: 21, stats.SpellCrit: 47, stats.Armor: 205}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Cowl of the Tempest", ID: 31056, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 40, stats.Spirit: 28, stats.SpellPower: 62, stats.HealingPower: 62, stats.SpellHit: 13, stats.SpellCrit: 29, stats.Armor: 199}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}, SetName: "Tempest Regalia"},
 	{Name: "Cowpoke's Riding Gloves", ID: 29806, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.MP5: 6, stats.AttackPower: 42, stats.Armor: 358, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Creature - Maiev's Glaive", ID: 32407, Type: proto.ItemType_ItemTypeWeapon, WeaponDamageMin: 30.0, WeaponDamageMax: 95.0, SwingSpeed: 1.50, Phase: 0, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellHit: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Creeping Vine Helm", ID: 21669, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 23, stats.Spirit: 17, stats.SpellPower: 20, stats.HealingPower: 79, stats.Armor: 208}, SocketBonus: stats.Stats{}},
 	{Name: "Crest of the Sha'tar", ID: 29176, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 19, stats.Armor: 4465, stats.Defense: 13, stats.BlockValue: 132}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}},
 	{Name: "Crimson Bracers of Gloom", ID: 27462, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 18, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellHit: 12, stats.Armor: 68}, SocketBonus: stats.Stats{}},
-	{Name: "Crimson Girdle of the Indomitable", ID: 28566, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 16, stats.Stamina: 36, stats.Armor: 816, stats.Defense: 24, stats.Block: 20}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Parry: 3}},
+	{Name: "Crimson Girdle of the Indomitable", ID: 28566, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 16, stats.Stamina: 36, stats.Armor: 816, stats.Defense: 24, stats.Block: 20}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Parry: 3}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Crimson Mail Bracers", ID: 29785, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 13, stats.MP5: 5, stats.AttackPower: 26, stats.Armor: 251, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Crimson Mail Hauberk", ID: 30947, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 16, stats.Intellect: 14, stats.MP5: 4, stats.AttackPower: 76, stats.Armor: 573, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
 	{Name: "Crimson Paragon's Cover", ID: 34190, Type: proto.ItemType_ItemTypeBack, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 61, stats.Expertise: 28, stats.Armor: 129, stats.Dodge: 28}, SocketBonus: stats.Stats{}},
@@ -947,11 +947,11 @@ var Items = []Item{
 	{Name: "Crown of Cinders", ID: 30955, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 30, stats.Intellect: 20, stats.MP5: 8, stats.AttackPower: 40, stats.Armor: 465, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Crown of Dath'Remar", ID: 34400, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 91, stats.Armor: 1611, stats.Defense: 32, stats.Dodge: 53, stats.Parry: 33}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
 	{Name: "Crown of Destruction", ID: 18817, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 9, stats.Spirit: 9, stats.AttackPower: 44, stats.MeleeCrit: 28, stats.Armor: 447, stats.RangedAttackPower: 44, stats.FireResistance: 10}, SocketBonus: stats.Stats{}},
-	{Name: "Crown of Empowered Fate", ID: 32354, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 27, stats.SpellPower: 37, stats.HealingPower: 155, stats.SpellCrit: 42, stats.Armor: 1434}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 4}},
+	{Name: "Crown of Empowered Fate", ID: 32354, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 27, stats.SpellPower: 37, stats.HealingPower: 155, stats.SpellCrit: 42, stats.Armor: 1434}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 4}, SourceZone: "Black Temple"},
 	{Name: "Crown of Malorne", ID: 29086, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 27, stats.Spirit: 25, stats.SpellPower: 23, stats.HealingPower: 91, stats.MP5: 10, stats.Armor: 308}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Intellect: 4}, SetName: "Malorne Raiment"},
 	{Name: "Crown of the Forest Lord", ID: 27763, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 30, stats.Spirit: 27, stats.SpellPower: 23, stats.HealingPower: 91, stats.Armor: 237}, SocketBonus: stats.Stats{}},
 	{Name: "Crown of the Sea Witch", ID: 32776, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 20, stats.Spirit: 20, stats.SpellPower: 23, stats.HealingPower: 23, stats.Armor: 127}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
-	{Name: "Crown of the Sun", ID: 29990, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 38, stats.Spirit: 49, stats.SpellPower: 36, stats.HealingPower: 144, stats.Armor: 188}, SocketBonus: stats.Stats{}},
+	{Name: "Crown of the Sun", ID: 29990, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 38, stats.Spirit: 49, stats.SpellPower: 36, stats.HealingPower: 144, stats.Armor: 188}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Crul'shorukh, Edge of Chaos", ID: 19363, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 101.0, WeaponDamageMax: 188.0, SwingSpeed: 2.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 36, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Crusader's Ornamented Chestplate", ID: 35402, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 24, stats.SpellPower: 6, stats.HealingPower: 24, stats.SpellCrit: 21, stats.Armor: 1164, stats.Resilience: 22}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 4}, SetName: "Crusader's Ornamented Battlegear"},
 	{Name: "Crusader's Ornamented Gloves", ID: 35403, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 20, stats.SpellPower: 14, stats.HealingPower: 54, stats.SpellCrit: 19, stats.Armor: 728, stats.Resilience: 12}, SocketBonus: stats.Stats{}, SetName: "Crusader's Ornamented Battlegear"},
@@ -979,7 +979,7 @@ var Items = []Item{
 	{Name: "Crystal Band of Valor", ID: 27822, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.MeleeHit: 16, stats.Defense: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Crystal Orb of Enlightenment", ID: 32651, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 33, stats.HealingPower: 33}, SocketBonus: stats.Stats{}},
 	{Name: "Crystal Pulse Shield", ID: 31292, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Intellect: 24, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 6, stats.Armor: 3711, stats.BlockValue: 83}, SocketBonus: stats.Stats{}},
-	{Name: "Crystal Spire of Karabor", ID: 32500, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 16.0, WeaponDamageMax: 131.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 15, stats.SpellPower: 162, stats.HealingPower: 648, stats.MP5: 6}, SocketBonus: stats.Stats{}},
+	{Name: "Crystal Spire of Karabor", ID: 32500, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 16.0, WeaponDamageMax: 131.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 15, stats.SpellPower: 162, stats.HealingPower: 648, stats.MP5: 6}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Crystal Webbed Robe", ID: 23220, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 19, stats.SpellPower: 53, stats.HealingPower: 53, stats.Armor: 147}, SocketBonus: stats.Stats{}},
 	{Name: "Crystal-Infused Shiv", ID: 32659, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 103.0, WeaponDamageMax: 155.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 11, stats.Stamina: 12, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Crystalforge Breastplate", ID: 30129, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Strength: 56, stats.Stamina: 40, stats.Intellect: 20, stats.MeleeHit: 23, stats.MeleeCrit: 21, stats.Armor: 1668}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 4}, SetName: "Crystalforge Battlegear"},
@@ -1000,7 +1000,7 @@ var Items = []Item{
 	{Name: "Crystalforged Sword", ID: 32660, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 8, stats.SpellPower: 121, stats.HealingPower: 121, stats.Block: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Crystalforged Trinket", ID: 32654, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Crystalforged War Axe", ID: 31291, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 253.0, WeaponDamageMax: 380.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 50, stats.AttackPower: 80, stats.MeleeCrit: 27, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}},
-	{Name: "Crystalheart Pulse-Staff", ID: 28782, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 297.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 50, stats.SpellPower: 128, stats.HealingPower: 510, stats.MP5: 16}, SocketBonus: stats.Stats{}},
+	{Name: "Crystalheart Pulse-Staff", ID: 28782, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 297.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 50, stats.SpellPower: 128, stats.HealingPower: 510, stats.MP5: 16}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Crystalhide Handwraps", ID: 32656, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Agility: 11, stats.Intellect: 27, stats.AttackPower: 56, stats.Armor: 465, stats.RangedAttackPower: 56}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}},
 	{Name: "Crystalline Crossbow", ID: 32645, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 165.0, WeaponDamageMax: 248.0, SwingSpeed: 2.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Agility: 12, stats.Stamina: 16, stats.MeleeHit: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Crystalweave Bracers", ID: 32655, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 16, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 12, stats.Armor: 78}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
@@ -1008,10 +1008,10 @@ var Items = []Item{
 	{Name: "Crystalwind Leggings", ID: 34905, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 40, stats.Spirit: 32, stats.SpellPower: 61, stats.HealingPower: 61, stats.SpellCrit: 28, stats.Armor: 388}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Cudgel of Consecration", ID: 34611, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 25.0, WeaponDamageMax: 131.0, SwingSpeed: 1.90, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 20, stats.SpellPower: 203, stats.HealingPower: 203, stats.SpellHit: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Cuffs of Absolution", ID: 34435, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 22, stats.Spirit: 20, stats.SpellPower: 25, stats.HealingPower: 98, stats.SpellCrit: 15, stats.SpellHaste: 16, stats.Armor: 113}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 2}, SetName: "Vestments of Absolution"},
-	{Name: "Cuffs of Devastation", ID: 30870, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 20, stats.Spirit: 19, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 14, stats.Armor: 103}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "Cuffs of Devastation", ID: 30870, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 20, stats.Spirit: 19, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 14, stats.Armor: 103}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Curate's Boots", ID: 25792, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 25, stats.Spirit: 25, stats.SpellPower: 18, stats.HealingPower: 71, stats.Armor: 106}, SocketBonus: stats.Stats{}},
 	{Name: "Curate's Footwraps", ID: 25793, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.Armor: 97}, SocketBonus: stats.Stats{}},
-	{Name: "Cursed Vision of Sargeras", ID: 32235, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Agility: 39, stats.Stamina: 46, stats.AttackPower: 108, stats.MeleeHit: 21, stats.MeleeCrit: 38, stats.Armor: 385, stats.RangedAttackPower: 108}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Cursed Vision of Sargeras", ID: 32235, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Agility: 39, stats.Stamina: 46, stats.AttackPower: 108, stats.MeleeHit: 21, stats.MeleeCrit: 38, stats.Armor: 385, stats.RangedAttackPower: 108}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Cyclone Breastplate", ID: 29038, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 33, stats.Stamina: 31, stats.Intellect: 23, stats.MP5: 8, stats.MeleeCrit: 25, stats.Armor: 846}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 4}, SetName: "Cyclone Harness"},
 	{Name: "Cyclone Chestguard", ID: 29033, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 32, stats.SpellPower: 39, stats.HealingPower: 39, stats.MP5: 8, stats.SpellCrit: 20, stats.Armor: 846}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellHit: 4}, SetName: "Cyclone Regalia"},
 	{Name: "Cyclone Faceguard", ID: 29035, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 31, stats.SpellPower: 39, stats.HealingPower: 39, stats.MP5: 8, stats.SpellCrit: 25, stats.Armor: 687}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Cyclone Regalia"},
@@ -1049,7 +1049,7 @@ var Items = []Item{
 	{Name: "Dawnsteel Bracers", ID: 32571, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.SpellPower: 22, stats.HealingPower: 86, stats.SpellHaste: 28, stats.Armor: 772}, SocketBonus: stats.Stats{}},
 	{Name: "Dawnsteel Shoulders", ID: 32573, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 28, stats.SpellPower: 27, stats.HealingPower: 108, stats.SpellHaste: 38, stats.Armor: 1324}, SocketBonus: stats.Stats{}},
 	{Name: "Dawnstrike's Cloak", ID: 29792, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 12, stats.AttackPower: 42, stats.MeleeHit: 9, stats.Armor: 69, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
-	{Name: "Deadly Cuffs", ID: 30863, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 58, stats.MeleeHit: 12, stats.MeleeCrit: 28, stats.Armor: 194, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "Deadly Cuffs", ID: 30863, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 58, stats.MeleeHit: 12, stats.MeleeCrit: 28, stats.Armor: 194, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Death's Bargain", ID: 23075, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.SpellPower: 10, stats.HealingPower: 39, stats.MP5: 4, stats.SpellCrit: 14, stats.Armor: 3570, stats.BlockValue: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Death's Sting", ID: 21126, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 95.0, WeaponDamageMax: 144.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 84, Stats: stats.Stats{stats.Stamina: 10, stats.AttackPower: 38, stats.Expertise: 7, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Deathblow X11 Goggles", ID: 32478, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Agility: 48, stats.Stamina: 28, stats.AttackPower: 76, stats.MeleeHit: 11, stats.Armor: 326, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}},
@@ -1076,7 +1076,7 @@ var Items = []Item{
 	{Name: "Demonblood Eviscerator", ID: 27533, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 130.0, WeaponDamageMax: 243.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.AttackPower: 28, stats.Expertise: 17, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Demonfang Ritual Helm", ID: 27781, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 30, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellHit: 19, stats.Armor: 127}, SocketBonus: stats.Stats{}},
 	{Name: "Demontooth Shoulderpads", ID: 34392, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Strength: 38, stats.Agility: 38, stats.Stamina: 38, stats.Intellect: 19, stats.MeleeCrit: 20, stats.ArmorPenetration: 105, stats.Armor: 514}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}},
-	{Name: "Despair", ID: 28573, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 319.0, WeaponDamageMax: 479.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 52}, SocketBonus: stats.Stats{}},
+	{Name: "Despair", ID: 28573, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 319.0, WeaponDamageMax: 479.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 52}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan"},
 	{Name: "Destroyer Battle-Helm", ID: 30120, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Strength: 47, stats.Stamina: 45, stats.MeleeHit: 21, stats.MeleeCrit: 36, stats.Armor: 1355}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}, SetName: "Destroyer Battlegear"},
 	{Name: "Destroyer Breastplate", ID: 30118, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Strength: 50, stats.Stamina: 48, stats.MeleeHit: 15, stats.MeleeCrit: 33, stats.Armor: 1668}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Strength: 4}, SetName: "Destroyer Battlegear"},
 	{Name: "Destroyer Chestguard", ID: 30113, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Strength: 25, stats.Agility: 26, stats.Stamina: 57, stats.MeleeHit: 24, stats.Armor: 1668, stats.Defense: 27, stats.Dodge: 24}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}, SetName: "Destroyer Armor"},
@@ -1101,10 +1101,10 @@ var Items = []Item{
 	{Name: "Diviner's Cinch", ID: 30331, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 18, stats.Spirit: 17, stats.SpellPower: 21, stats.HealingPower: 21, stats.Armor: 79}, SocketBonus: stats.Stats{}},
 	{Name: "Diviner's Cloak", ID: 30338, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 15, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 5, stats.Armor: 70}, SocketBonus: stats.Stats{}},
 	{Name: "Doc's Belt", ID: 30001, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 16, stats.MP5: 3, stats.AttackPower: 56, stats.Armor: 314, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
-	{Name: "Don Alejandro's Money Belt", ID: 30879, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 37, stats.AttackPower: 76, stats.MeleeCrit: 19, stats.Armor: 249, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Don Alejandro's Money Belt", ID: 30879, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 37, stats.AttackPower: 76, stats.MeleeCrit: 19, stats.Armor: 249, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Don Carlos' Famous Hat", ID: 38506, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Armor: 127}, SocketBonus: stats.Stats{}},
 	{Name: "Don Rigoberto's Lost Hat", ID: 21615, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 24, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 11, stats.Armor: 114}, SocketBonus: stats.Stats{}},
-	{Name: "Don Rodrigo's Poncho", ID: 30899, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 31, stats.Spirit: 52, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 444}, SocketBonus: stats.Stats{}},
+	{Name: "Don Rodrigo's Poncho", ID: 30899, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 31, stats.Spirit: 52, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 444}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Don Santos' Famous Hunting Rifle", ID: 31323, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 139.0, WeaponDamageMax: 259.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Doomcaller's Circlet", ID: 21337, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 24, stats.Spirit: 6, stats.SpellPower: 33, stats.HealingPower: 33, stats.SpellHit: 8, stats.SpellCrit: 14, stats.Armor: 114}, SocketBonus: stats.Stats{}, SetName: "Doomcaller's Attire"},
 	{Name: "Doomcaller's Footwraps", ID: 21338, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 16, stats.Spirit: 3, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellPenetration: 10, stats.Armor: 93}, SocketBonus: stats.Stats{}, SetName: "Doomcaller's Attire"},
@@ -1122,9 +1122,9 @@ var Items = []Item{
 	{Name: "Draenic Wildstaff", ID: 27877, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 106.0, WeaponDamageMax: 196.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Strength: 34, stats.Agility: 32, stats.Stamina: 33, stats.MeleeHit: 22, stats.FeralAttackPower: 539}, SocketBonus: stats.Stats{}},
 	{Name: "Dragon Crested Epaulets", ID: 29810, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 13, stats.AttackPower: 68, stats.Armor: 193, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}},
 	{Name: "Dragon's Touch", ID: 19367, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 107.0, WeaponDamageMax: 199.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 7, stats.Intellect: 12, stats.SpellPower: 6, stats.HealingPower: 6}, SocketBonus: stats.Stats{}},
-	{Name: "Dragon-Quake Shoulderguards", ID: 28631, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 8, stats.Armor: 609}, SocketBonus: stats.Stats{}},
+	{Name: "Dragon-Quake Shoulderguards", ID: 28631, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 8, stats.Armor: 609}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Curator"},
 	{Name: "Dragonbreath Hand Cannon", ID: 19368, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 104.0, WeaponDamageMax: 194.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 7}, SocketBonus: stats.Stats{}},
-	{Name: "Dragonheart Flameshield", ID: 28611, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 21, stats.SpellPower: 23, stats.HealingPower: 23, stats.MP5: 7, stats.Armor: 4872, stats.BlockValue: 122}, SocketBonus: stats.Stats{}},
+	{Name: "Dragonheart Flameshield", ID: 28611, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 21, stats.SpellPower: 23, stats.HealingPower: 23, stats.MP5: 7, stats.Armor: 4872, stats.BlockValue: 122}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Dragonhide Gloves", ID: 35356, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 17, stats.Agility: 17, stats.Stamina: 24, stats.Intellect: 12, stats.SpellPower: 12, stats.HealingPower: 47, stats.Armor: 213, stats.Resilience: 16}, SocketBonus: stats.Stats{}, SetName: "Dragonhide Battlegear"},
 	{Name: "Dragonhide Helm", ID: 35357, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 22, stats.Agility: 22, stats.Stamina: 21, stats.Intellect: 15, stats.SpellPower: 11, stats.HealingPower: 42, stats.Armor: 267, stats.Resilience: 21}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 4}, SetName: "Dragonhide Battlegear"},
 	{Name: "Dragonhide Legguards", ID: 35358, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 22, stats.Agility: 22, stats.Stamina: 30, stats.Intellect: 22, stats.SpellPower: 16, stats.HealingPower: 64, stats.Armor: 306, stats.Resilience: 22}, SocketBonus: stats.Stats{}, SetName: "Dragonhide Battlegear"},
@@ -1135,7 +1135,7 @@ var Items = []Item{
 	{Name: "Dragonmaw", ID: 28438, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 172.0, WeaponDamageMax: 320.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 9}, SocketBonus: stats.Stats{}},
 	{Name: "Dragonmaw Augur's Cinch", ID: 32867, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 18, stats.HealingPower: 18, stats.Armor: 79}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Dragonscale-Encrusted Longblade", ID: 34164, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 113.0, WeaponDamageMax: 211.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 36, stats.MeleeHaste: 25, stats.Expertise: 25}, SocketBonus: stats.Stats{}},
-	{Name: "Dragonspine Trophy", ID: 28830, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
+	{Name: "Dragonspine Trophy", ID: 28830, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Dragonstalker's Belt", ID: 16936, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 15, stats.Intellect: 13, stats.Spirit: 11, stats.AttackPower: 26, stats.MeleeCrit: 14, stats.Armor: 310, stats.RangedAttackPower: 26, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Dragonstalker Armor"},
 	{Name: "Dragonstalker's Bracers", ID: 16935, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 13, stats.Intellect: 6, stats.Spirit: 6, stats.AttackPower: 30, stats.Armor: 241, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}, SetName: "Dragonstalker Armor"},
 	{Name: "Dragonstalker's Breastplate", ID: 16942, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 17, stats.Intellect: 14, stats.Spirit: 6, stats.AttackPower: 44, stats.MeleeCrit: 14, stats.Armor: 551, stats.RangedAttackPower: 44, stats.FireResistance: 10, stats.NatureResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Dragonstalker Armor"},
@@ -1151,9 +1151,9 @@ var Items = []Item{
 	{Name: "Drake Tamer's Gloves", ID: 32865, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 22, stats.AttackPower: 30, stats.Armor: 165, stats.RangedAttackPower: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}},
 	{Name: "Drake Tooth Necklace", ID: 21531, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 7, stats.Intellect: 12, stats.SpellPower: 9, stats.HealingPower: 35, stats.NatureResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Drakefist Hammer", ID: 28437, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 159.0, WeaponDamageMax: 296.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 107, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Drape of the Dark Reavers", ID: 28672, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 21, stats.AttackPower: 34, stats.MeleeHit: 17, stats.Armor: 97, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
+	{Name: "Drape of the Dark Reavers", ID: 28672, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 21, stats.AttackPower: 34, stats.MeleeHit: 17, stats.Armor: 97, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Drape of the Righteous", ID: 30642, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 13, stats.HolySpellPower: 43, stats.MP5: 7, stats.Armor: 97}, SocketBonus: stats.Stats{}},
-	{Name: "Dreadboots of the Legion", ID: 32345, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 40, stats.MeleeHit: 18, stats.MeleeCrit: 30, stats.Armor: 1213}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
+	{Name: "Dreadboots of the Legion", ID: 32345, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 40, stats.MeleeHit: 18, stats.MeleeCrit: 30, stats.Armor: 1213}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}, SourceZone: "Black Temple"},
 	{Name: "Dreadnaught Bracers", ID: 22423, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Strength: 14, stats.Stamina: 28, stats.Armor: 492, stats.Defense: 7}, SocketBonus: stats.Stats{}, SetName: "Dreadnaught's Battlegear"},
 	{Name: "Dreadnaught Breastplate", ID: 22416, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 92, Stats: stats.Stats{stats.Strength: 21, stats.Stamina: 43, stats.MeleeHit: 20, stats.Armor: 1172, stats.Defense: 20, stats.Dodge: 12}, SocketBonus: stats.Stats{}, SetName: "Dreadnaught's Battlegear"},
 	{Name: "Dreadnaught Gauntlets", ID: 22421, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 27, stats.Armor: 702, stats.Defense: 13, stats.BlockValue: 21}, SocketBonus: stats.Stats{}, SetName: "Dreadnaught's Battlegear"},
@@ -1190,7 +1190,7 @@ var Items = []Item{
 	{Name: "Eaglecrest Warboots", ID: 29239, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 21, stats.Stamina: 33, stats.Armor: 955, stats.Defense: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Earring of Soulful Meditation", ID: 30665, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.SpellPower: 22, stats.HealingPower: 88}, SocketBonus: stats.Stats{}},
 	{Name: "Earth Mantle Handwraps", ID: 27793, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 18, stats.SpellPower: 19, stats.HealingPower: 19, stats.SpellCrit: 16, stats.Armor: 397}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}},
-	{Name: "Earthblood Chestguard", ID: 28735, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 41, stats.SpellPower: 29, stats.HealingPower: 115, stats.MP5: 11, stats.Armor: 812}, SocketBonus: stats.Stats{}},
+	{Name: "Earthblood Chestguard", ID: 28735, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 41, stats.SpellPower: 29, stats.HealingPower: 115, stats.MP5: 11, stats.Armor: 812}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Earthcaller's Headdress", ID: 29135, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 25, stats.AttackPower: 50, stats.Armor: 530, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
 	{Name: "Earthen Netherscale Boots", ID: 29512, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 28, stats.Stamina: 27, stats.Intellect: 18, stats.MP5: 10, stats.Armor: 511}, SocketBonus: stats.Stats{}},
 	{Name: "Earthmender's Bracer of Shattering", ID: 30927, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 12, stats.Spirit: 9, stats.SpellPower: 27, stats.HealingPower: 27, stats.Armor: 61}, SocketBonus: stats.Stats{}},
@@ -1208,7 +1208,7 @@ var Items = []Item{
 	{Name: "Earthshatter Tunic", ID: 22464, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 92, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 32, stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 12, stats.Armor: 658}, SocketBonus: stats.Stats{}, SetName: "The Earthshatterer"},
 	{Name: "Earthshatter Wristguards", ID: 22471, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 18, stats.SpellPower: 11, stats.HealingPower: 44, stats.MP5: 4, stats.Armor: 276}, SocketBonus: stats.Stats{}, SetName: "The Earthshatterer"},
 	{Name: "Earthsoul Britches", ID: 27800, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 33, stats.Spirit: 26, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 256}, SocketBonus: stats.Stats{}},
-	{Name: "Earthsoul Leggings", ID: 28591, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 30, stats.Spirit: 24, stats.SpellPower: 27, stats.HealingPower: 108, stats.Armor: 319}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}},
+	{Name: "Earthsoul Leggings", ID: 28591, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 30, stats.Spirit: 24, stats.SpellPower: 27, stats.HealingPower: 108, stats.Armor: 319}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}, SourceZone: "Karazhan"},
 	{Name: "Earthwarden", ID: 29171, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 279.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, QualityModifier: -39.800, Stats: stats.Stats{stats.Stamina: 39, stats.Expertise: 24, stats.Armor: 461, stats.Defense: 27, stats.FeralAttackPower: 712}, SocketBonus: stats.Stats{}},
 	{Name: "Earthwarden's Coif", ID: 28181, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 25, stats.Intellect: 18, stats.MP5: 7, stats.AttackPower: 34, stats.Armor: 530, stats.RangedAttackPower: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Ebon Netherscale Belt", ID: 29516, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.MP5: 8, stats.AttackPower: 48, stats.MeleeCrit: 22, stats.Armor: 457, stats.RangedAttackPower: 48}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 3}, SetName: "Netherscale Armor"},
@@ -1219,15 +1219,15 @@ var Items = []Item{
 	{Name: "Eco-Dome Leggings", ID: 30272, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 34, stats.AttackPower: 46, stats.MeleeHit: 18, stats.Armor: 225, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
 	{Name: "Edge of Oppression", ID: 34606, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 79.0, WeaponDamageMax: 148.0, SwingSpeed: 1.30, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 36, stats.MeleeHaste: 17, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Edge of the Cosmos", ID: 28267, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 130.0, WeaponDamageMax: 243.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 30, stats.MeleeCrit: 16, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Edgewalker Longboots", ID: 28545, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 28, stats.AttackPower: 44, stats.MeleeHit: 13, stats.Armor: 250, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 3}},
+	{Name: "Edgewalker Longboots", ID: 28545, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 28, stats.AttackPower: 44, stats.MeleeHit: 13, stats.Armor: 250, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 3}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Eighty Silver Links", ID: 25583, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 16, stats.Spirit: 16, stats.SpellPower: 19, stats.HealingPower: 19, stats.Armor: 137}, SocketBonus: stats.Stats{}},
 	{Name: "Elementium Band of the Sentry", ID: 28407, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Defense: 20, stats.Dodge: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Elementium Reinforced Bulwark", ID: 19349, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 23, stats.Armor: 3325, stats.Defense: 10, stats.BlockValue: 90}, SocketBonus: stats.Stats{}},
 	{Name: "Elementium Threaded Cloak", ID: 19386, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 13, stats.Armor: 177, stats.Dodge: 24}, SocketBonus: stats.Stats{}},
-	{Name: "Elunite Empowered Bracers", ID: 32351, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.SpellPower: 34, stats.HealingPower: 34, stats.MP5: 6, stats.SpellHit: 19, stats.Armor: 194}, SocketBonus: stats.Stats{}},
+	{Name: "Elunite Empowered Bracers", ID: 32351, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.SpellPower: 34, stats.HealingPower: 34, stats.MP5: 6, stats.SpellHit: 19, stats.Armor: 194}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Elunite Imbued Leggings", ID: 33971, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 40, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellCrit: 31, stats.Armor: 353}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Emberhawk Crossbow", ID: 28397, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 159.0, WeaponDamageMax: 239.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 14, stats.MeleeCrit: 18, stats.RangedAttackPower: 14}, SocketBonus: stats.Stats{}},
-	{Name: "Emberspur Talisman", ID: 28609, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 11}, SocketBonus: stats.Stats{}},
+	{Name: "Emberspur Talisman", ID: 28609, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 11}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Emberweave Leggings", ID: 19433, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 22, stats.Intellect: 12, stats.Armor: 476, stats.FireResistance: 35}, SocketBonus: stats.Stats{}},
 	{Name: "Embrace of Everlasting Prowess", ID: 34906, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 40, stats.Agility: 49, stats.Stamina: 52, stats.Intellect: 23, stats.MeleeHaste: 20, stats.Armor: 696}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Embrace of Starlight", ID: 34903, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 38, stats.Spirit: 26, stats.SpellPower: 60, stats.HealingPower: 60, stats.SpellCrit: 36, stats.Armor: 444}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
@@ -1237,7 +1237,7 @@ var Items = []Item{
 	{Name: "Embroidered Cape of Mysteries", ID: 27485, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 25, stats.HealingPower: 25, stats.Armor: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Embroidered Spellpyre Boots", ID: 27848, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 21, stats.SpellPower: 41, stats.HealingPower: 41, stats.Armor: 107}, SocketBonus: stats.Stats{}},
 	{Name: "Emerald Eye Bracer", ID: 27494, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 12, stats.MP5: 5, stats.AttackPower: 46, stats.MeleeCrit: 13, stats.Armor: 285, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
-	{Name: "Emerald Ripper", ID: 28524, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 126.0, WeaponDamageMax: 189.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 18, stats.AttackPower: 36, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
+	{Name: "Emerald Ripper", ID: 28524, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 126.0, WeaponDamageMax: 189.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 18, stats.AttackPower: 36, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Emerald-Scale Greaves", ID: 28219, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 28, stats.Intellect: 20, stats.MP5: 5, stats.AttackPower: 56, stats.Armor: 570, stats.RangedAttackPower: 56}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
 	{Name: "Empowered Leggings", ID: 19385, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.Spirit: 24, stats.SpellPower: 26, stats.HealingPower: 103, stats.SpellCrit: 14, stats.Armor: 117}, SocketBonus: stats.Stats{}},
 	{Name: "Empty Mug of Direbrew", ID: 38287, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.AttackPower: 72, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
@@ -1253,7 +1253,7 @@ var Items = []Item{
 	{Name: "Enchanted Felscale Boots", ID: 29491, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 27, stats.Armor: 437, stats.ArcaneResistance: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
 	{Name: "Enchanted Felscale Gloves", ID: 29490, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 27, stats.Armor: 397, stats.ArcaneResistance: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
 	{Name: "Enchanted Felscale Leggings", ID: 29489, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 556, stats.ArcaneResistance: 40}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
-	{Name: "Enchanted Leather Sandals", ID: 30886, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 29, stats.Spirit: 37, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 305}, SocketBonus: stats.Stats{}},
+	{Name: "Enchanted Leather Sandals", ID: 30886, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 29, stats.Spirit: 37, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 305}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Enchanted Thorium Torque", ID: 28321, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.MeleeHit: 16, stats.Dodge: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Endbringer", ID: 27769, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 253.0, WeaponDamageMax: 381.0, SwingSpeed: 3.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 29, stats.Stamina: 28, stats.MeleeCrit: 45}, SocketBonus: stats.Stats{}},
 	{Name: "Energis Armwraps", ID: 28317, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 34, stats.HealingPower: 34, stats.Armor: 97}, SocketBonus: stats.Stats{}},
@@ -1270,22 +1270,22 @@ var Items = []Item{
 	{Name: "Epoch's Whispering Cinch", ID: 27911, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 24, stats.AttackPower: 40, stats.MeleeHit: 17, stats.Armor: 164, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Epoch-Mender", ID: 28033, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 100.0, WeaponDamageMax: 187.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 35, stats.SpellPower: 76, stats.HealingPower: 303, stats.MP5: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Equilibrium Epaulets", ID: 34208, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 30, stats.SpellPower: 33, stats.HealingPower: 132, stats.MP5: 12, stats.SpellHaste: 24, stats.Armor: 832}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Eredar Wand of Obliteration", ID: 28783, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 177.0, WeaponDamageMax: 330.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 11, stats.SpellPower: 16, stats.HealingPower: 16, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
+	{Name: "Eredar Wand of Obliteration", ID: 28783, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 177.0, WeaponDamageMax: 330.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 11, stats.SpellPower: 16, stats.HealingPower: 16, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Erupting Epaulets", ID: 34390, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 30, stats.SpellPower: 53, stats.HealingPower: 53, stats.SpellCrit: 30, stats.SpellHaste: 24, stats.Armor: 832}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Essence of the Martyr", ID: 29376, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.SpellPower: 28, stats.HealingPower: 112}, SocketBonus: stats.Stats{}},
+	{Name: "Essence of the Martyr", ID: 29376, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.SpellPower: 28, stats.HealingPower: 112}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 41},
 	{Name: "Essence of the Pure Flame", ID: 18815, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Eternium Greathelm", ID: 28593, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 31, stats.Stamina: 48, stats.Armor: 1178, stats.Defense: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 4}},
+	{Name: "Eternium Greathelm", ID: 28593, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 31, stats.Stamina: 48, stats.Armor: 1178, stats.Defense: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 4}, SourceZone: "Karazhan"},
 	{Name: "Eternium Rage-shackles", ID: 33513, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 32, stats.Stamina: 32, stats.ArmorPenetration: 150, stats.Armor: 703}, SocketBonus: stats.Stats{}},
 	{Name: "Eternium Runed Blade", ID: 23554, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 28.0, WeaponDamageMax: 114.0, SwingSpeed: 1.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, QualityModifier: -42.000, Stats: stats.Stats{stats.Intellect: 19, stats.SpellPower: 168, stats.HealingPower: 168, stats.SpellCrit: 21}, SocketBonus: stats.Stats{}},
-	{Name: "Eternium Shell Bracers", ID: 32232, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 52, stats.Armor: 772, stats.Defense: 24, stats.Dodge: 26}, SocketBonus: stats.Stats{}},
+	{Name: "Eternium Shell Bracers", ID: 32232, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 52, stats.Armor: 772, stats.Defense: 24, stats.Dodge: 26}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Ethereal Gloves", ID: 30253, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 12, stats.Spirit: 30, stats.SpellPower: 19, stats.HealingPower: 19, stats.Armor: 88}, SocketBonus: stats.Stats{}},
 	{Name: "Ethereal Sash", ID: 25637, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 19, stats.Spirit: 12, stats.SpellPower: 15, stats.HealingPower: 15, stats.SpellHit: 18, stats.Armor: 73}, SocketBonus: stats.Stats{}},
-	{Name: "Ethereum Life-Staff", ID: 29981, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 144.0, WeaponDamageMax: 306.0, SwingSpeed: 3.20, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, QualityModifier: -55.300, Stats: stats.Stats{stats.Stamina: 52, stats.Intellect: 44, stats.Spirit: 62, stats.SpellPower: 138, stats.HealingPower: 553}, SocketBonus: stats.Stats{}},
+	{Name: "Ethereum Life-Staff", ID: 29981, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 144.0, WeaponDamageMax: 306.0, SwingSpeed: 3.20, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, QualityModifier: -55.300, Stats: stats.Stats{stats.Stamina: 52, stats.Intellect: 44, stats.Spirit: 62, stats.SpellPower: 138, stats.HealingPower: 553}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Ethereum Nexus-Reaver", ID: 30722, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 346.0, WeaponDamageMax: 519.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 50, stats.MeleeCrit: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{}},
 	{Name: "Ethereum Phase Blade", ID: 29380, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 76.0, WeaponDamageMax: 143.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 11, stats.SpellPower: 13, stats.HealingPower: 13}, SocketBonus: stats.Stats{}},
 	{Name: "Ethereum Phase-Spear", ID: 29377, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 102.0, WeaponDamageMax: 153.0, SwingSpeed: 1.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 26, stats.MP5: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Eva's Strap", ID: 30936, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.AttackPower: 60, stats.MeleeHit: 12, stats.MeleeCrit: 16, stats.Armor: 148, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
-	{Name: "Everbloom Idol", ID: 29390, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Everbloom Idol", ID: 29390, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 15},
 	{Name: "Evergrove Ranger's Cloak", ID: 31792, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 12, stats.Intellect: 12, stats.MP5: 5, stats.AttackPower: 26, stats.Armor: 67, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Eversong Cuffs", ID: 34602, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 20, stats.Spirit: 15, stats.SpellPower: 17, stats.HealingPower: 68, stats.Armor: 159}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 2}},
 	{Name: "Evoker's Helmet of Second Sight", ID: 31104, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 15, stats.Spirit: 8, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellCrit: 24, stats.Armor: 120}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
@@ -1304,39 +1304,39 @@ var Items = []Item{
 	{Name: "Expedition Scout's Epaulets", ID: 25790, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Agility: 25, stats.AttackPower: 50, stats.MeleeHit: 24, stats.Armor: 217, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Extravagant Boots of Malice", ID: 27821, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 24, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellHit: 14, stats.Armor: 107}, SocketBonus: stats.Stats{}},
 	{Name: "Eye of Diminution", ID: 23001, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 85, Stats: stats.Stats{stats.SpellCrit: 28}, SocketBonus: stats.Stats{}},
-	{Name: "Eye of Gruul", ID: 28823, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 15, stats.HealingPower: 59}, SocketBonus: stats.Stats{}},
-	{Name: "Eye of Magtheridon", ID: 28789, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 54, stats.HealingPower: 54}, SocketBonus: stats.Stats{}},
+	{Name: "Eye of Gruul", ID: 28823, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 15, stats.HealingPower: 59}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
+	{Name: "Eye of Magtheridon", ID: 28789, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 54, stats.HealingPower: 54}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Eye of the Dead", ID: 23047, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{stats.SpellPower: 24, stats.HealingPower: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Eye of the Night", ID: 24116, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.SpellHit: 16, stats.SpellCrit: 26, stats.SpellPenetration: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Eye of the Stalker", ID: 32081, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 31, stats.AttackPower: 60, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
 	{Name: "Eyestalk Waist Cord", ID: 22730, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 9, stats.SpellPower: 41, stats.HealingPower: 41, stats.SpellCrit: 14, stats.Armor: 85}, SocketBonus: stats.Stats{}},
 	{Name: "Faceguard of Determination", ID: 32083, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 36, stats.Armor: 1129, stats.Defense: 32, stats.Block: 24, stats.Dodge: 24}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Stamina: 6}},
 	{Name: "Faceguard of the Endless Watch", ID: 30731, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 49, stats.Armor: 1227, stats.Defense: 30, stats.Dodge: 37}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
-	{Name: "Faceplate of the Impenetrable", ID: 32521, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 82, stats.Armor: 1532, stats.Defense: 30, stats.Block: 29, stats.BlockValue: 45, stats.Dodge: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Faceplate of the Impenetrable", ID: 32521, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 82, stats.Armor: 1532, stats.Defense: 30, stats.Block: 29, stats.BlockValue: 45, stats.Dodge: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Fairweather's Wristguards", ID: 31540, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Strength: 14, stats.Stamina: 14, stats.SpellPower: 16, stats.HealingPower: 16, stats.SpellCrit: 14, stats.MeleeCrit: 14, stats.Armor: 424}, SocketBonus: stats.Stats{}},
 	{Name: "Fanblade Pauldrons", ID: 27847, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 16, stats.Stamina: 22, stats.Armor: 873, stats.Defense: 20, stats.Parry: 15}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Parry: 3}},
 	{Name: "Fang of Kalecgos", ID: 34165, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 113.0, WeaponDamageMax: 211.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 25, stats.AttackPower: 48, stats.MeleeHaste: 25, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "Fang of Korialstrasz", ID: 21523, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 43.0, WeaponDamageMax: 106.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, QualityModifier: -16.000, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 14, stats.Spirit: 13, stats.SpellPower: 41, stats.HealingPower: 162}, SocketBonus: stats.Stats{}},
-	{Name: "Fang of Vashj", ID: 30103, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 144.0, WeaponDamageMax: 217.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 56, stats.Expertise: 21, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
-	{Name: "Fang of the Leviathan", ID: 30095, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 21.0, WeaponDamageMax: 126.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, QualityModifier: -55.300, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 20, stats.SpellPower: 221, stats.HealingPower: 221, stats.SpellCrit: 21}, SocketBonus: stats.Stats{}},
+	{Name: "Fang of Vashj", ID: 30103, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 144.0, WeaponDamageMax: 217.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 56, stats.Expertise: 21, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
+	{Name: "Fang of the Leviathan", ID: 30095, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 21.0, WeaponDamageMax: 126.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, QualityModifier: -55.300, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 20, stats.SpellPower: 221, stats.HealingPower: 221, stats.SpellCrit: 21}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Leotheras the Blind"},
 	{Name: "Fanged Axe", ID: 31542, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 192.0, WeaponDamageMax: 289.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 39, stats.AttackPower: 52, stats.MeleeCrit: 26, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Faol's Signet of Cleansing", ID: 27477, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 7}, SocketBonus: stats.Stats{}},
 	{Name: "Far Seer's Helm", ID: 29136, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 25, stats.AttackPower: 50, stats.Armor: 530, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
 	{Name: "Farahlite Studded Boots", ID: 30401, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 16, stats.AttackPower: 56, stats.MeleeCrit: 16, stats.Armor: 172, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
-	{Name: "Farstrider Defender's Cloak", ID: 29385, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 267, stats.BlockValue: 38}, SocketBonus: stats.Stats{}},
-	{Name: "Farstrider Wildercloak", ID: 28764, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 36, stats.AttackPower: 70, stats.Armor: 105, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
+	{Name: "Farstrider Defender's Cloak", ID: 29385, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 267, stats.BlockValue: 38}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
+	{Name: "Farstrider Wildercloak", ID: 28764, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 36, stats.AttackPower: 70, stats.Armor: 105, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Fathom-Brooch of the Tidewalker", ID: 30663, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Fathom-Helm of the Deeps", ID: 30728, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Intellect: 29, stats.SpellPower: 36, stats.HealingPower: 142, stats.MP5: 9, stats.Armor: 687}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
 	{Name: "Fathomheart Gauntlets", ID: 27806, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 25, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 7, stats.Armor: 407}, SocketBonus: stats.Stats{}},
-	{Name: "Fathomstone", ID: 30049, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 12, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Fathomstone", ID: 30049, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 12, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Fel Conquerer Raiments", ID: 34232, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 60, stats.Intellect: 41, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellCrit: 24, stats.SpellHaste: 33, stats.Armor: 266}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Fel Edged Battleaxe", ID: 23542, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 128.0, WeaponDamageMax: 239.0, SwingSpeed: 2.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.MeleeHit: 14, stats.MeleeCrit: 21}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Fel Hardened Maul", ID: 23546, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 286.0, WeaponDamageMax: 430.0, SwingSpeed: 3.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 50, stats.Stamina: 46, stats.MeleeCrit: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Fel Leather Boots", ID: 25686, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.AttackPower: 36, stats.MeleeHit: 25, stats.MeleeCrit: 17, stats.Armor: 196, stats.RangedAttackPower: 36}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}, SetName: "Fel Skin"},
 	{Name: "Fel Leather Gloves", ID: 25685, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.AttackPower: 36, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 169, stats.RangedAttackPower: 36}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}, SetName: "Fel Skin"},
 	{Name: "Fel Leather Leggings", ID: 25687, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.AttackPower: 52, stats.MeleeHit: 25, stats.MeleeCrit: 25, stats.Armor: 249, stats.RangedAttackPower: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}, SetName: "Fel Skin"},
-	{Name: "Fel Reaver's Piston", ID: 30619, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.MP5: 16}, SocketBonus: stats.Stats{}},
-	{Name: "Fel-Steel Warhelm", ID: 29983, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 46, stats.MeleeHit: 30, stats.MeleeCrit: 44, stats.Armor: 1306}, SocketBonus: stats.Stats{}},
+	{Name: "Fel Reaver's Piston", ID: 30619, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.MP5: 16}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Void Reaver"},
+	{Name: "Fel-Steel Warhelm", ID: 29983, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 46, stats.MeleeHit: 30, stats.MeleeCrit: 44, stats.Armor: 1306}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Void Reaver"},
 	{Name: "Fel-tinged Mantle", ID: 34607, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellCrit: 21, stats.Armor: 146}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Felboar Hide Shoes", ID: 30939, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 15, stats.AttackPower: 68, stats.MeleeHit: 9, stats.Armor: 191, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}},
 	{Name: "Felfury Gauntlets", ID: 23531, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 40, stats.MeleeCrit: 27, stats.Armor: 465, stats.RangedAttackPower: 40}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeHit: 3}},
@@ -1352,16 +1352,16 @@ var Items = []Item{
 	{Name: "Felsteel Longblade", ID: 23540, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 128.0, WeaponDamageMax: 239.0, SwingSpeed: 2.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Felsteel Reaper", ID: 23543, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 295.0, WeaponDamageMax: 443.0, SwingSpeed: 3.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.AttackPower: 112, stats.MeleeCrit: 30, stats.RangedAttackPower: 112}, SocketBonus: stats.Stats{}},
 	{Name: "Felsteel Whisper Knives", ID: 29204, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 134.0, WeaponDamageMax: 135.0, SwingSpeed: 2.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 110, Stats: stats.Stats{stats.AttackPower: 20, stats.MeleeHit: 10, stats.MeleeCrit: 10, stats.RangedAttackPower: 20}, SocketBonus: stats.Stats{}},
-	{Name: "Felstone Bulwark", ID: 32255, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 21, stats.SpellPower: 22, stats.HealingPower: 86, stats.SpellCrit: 27, stats.Armor: 5930, stats.BlockValue: 160}, SocketBonus: stats.Stats{}},
+	{Name: "Felstone Bulwark", ID: 32255, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 21, stats.SpellPower: 22, stats.HealingPower: 86, stats.SpellCrit: 27, stats.Armor: 5930, stats.BlockValue: 160}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Felstrength Legplates", ID: 34381, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 78, stats.Expertise: 40, stats.Armor: 1735, stats.Defense: 40, stats.Dodge: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}},
 	{Name: "Feltooth Eviscerator", ID: 29346, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 83.0, WeaponDamageMax: 156.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.AttackPower: 34, stats.MeleeCrit: 22, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
 	{Name: "Feral Staff of Lashing", ID: 29359, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 148.0, WeaponDamageMax: 279.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, QualityModifier: -37.500, Stats: stats.Stats{stats.Strength: 36, stats.Agility: 35, stats.Stamina: 34, stats.Armor: 263, stats.FeralAttackPower: 754}, SocketBonus: stats.Stats{}},
 	{Name: "Ferocious Bands", ID: 30332, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 16, stats.Agility: 15, stats.Stamina: 15, stats.MeleeHit: 10, stats.Armor: 115}, SocketBonus: stats.Stats{}},
-	{Name: "Ferocious Swift-Kickers", ID: 28610, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.AttackPower: 58, stats.MeleeCrit: 16, stats.Armor: 558, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
+	{Name: "Ferocious Swift-Kickers", ID: 28610, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.AttackPower: 58, stats.MeleeCrit: 16, stats.Armor: 558, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Fetish of the Primal Gods", ID: 33334, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 17, stats.SpellPower: 37, stats.HealingPower: 37, stats.SpellHaste: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Fetish of the Sand Reaver", ID: 21647, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 77, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Field Agent's Bracers", ID: 30402, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 21, stats.Agility: 8, stats.Stamina: 16, stats.Armor: 436}, SocketBonus: stats.Stats{}},
-	{Name: "Fiend Slayer Boots", ID: 28746, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 24, stats.Intellect: 16, stats.AttackPower: 34, stats.MeleeHit: 17, stats.Armor: 558, stats.RangedAttackPower: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}},
+	{Name: "Fiend Slayer Boots", ID: 28746, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 24, stats.Intellect: 16, stats.AttackPower: 34, stats.MeleeHit: 17, stats.Armor: 558, stats.RangedAttackPower: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Figurine - Crimson Serpent", ID: 35700, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 49, stats.Intellect: 33}, SocketBonus: stats.Stats{}},
 	{Name: "Figurine - Dawnstone Crab", ID: 24125, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Defense: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Figurine - Empyrean Tortoise", ID: 35693, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Defense: 42}, SocketBonus: stats.Stats{}},
@@ -1374,7 +1374,7 @@ var Items = []Item{
 	{Name: "Figurine - Talasite Owl", ID: 24127, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.MP5: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Figurine of the Colossus", ID: 27529, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Block: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Finely Wrought Scale Leggings", ID: 29788, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.AttackPower: 76, stats.Armor: 501, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
-	{Name: "Fire Crest Breastplate", ID: 29921, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 34, stats.SpellPower: 27, stats.HealingPower: 108, stats.MP5: 10, stats.SpellCrit: 34, stats.Armor: 900}, SocketBonus: stats.Stats{}},
+	{Name: "Fire Crest Breastplate", ID: 29921, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 34, stats.SpellPower: 27, stats.HealingPower: 108, stats.MP5: 10, stats.SpellCrit: 34, stats.Armor: 900}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Fire-Cord of the Magus", ID: 30020, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.FireSpellPower: 60, stats.SpellCrit: 30, stats.Armor: 121}, SocketBonus: stats.Stats{}},
 	{Name: "Firebrand Battleaxe", ID: 27490, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 120.0, WeaponDamageMax: 224.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 15, stats.Expertise: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Fireguard", ID: 28425, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 94.0, WeaponDamageMax: 176.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 107, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 23, stats.MeleeHit: 16}, SocketBonus: stats.Stats{}},
@@ -1382,7 +1382,7 @@ var Items = []Item{
 	{Name: "Firemaw's Clutch", ID: 19400, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.SpellPower: 35, stats.HealingPower: 35, stats.MP5: 5, stats.Armor: 74}, SocketBonus: stats.Stats{}},
 	{Name: "Fist of Molten Fury", ID: 32945, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 120.0, WeaponDamageMax: 181.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 28, stats.AttackPower: 38, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}, SetName: "The Fists of Fury"},
 	{Name: "Fist of Reckoning", ID: 31139, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 102.0, WeaponDamageMax: 190.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 88, Stats: stats.Stats{stats.AttackPower: 12, stats.MeleeHit: 10, stats.MeleeCrit: 16, stats.RangedAttackPower: 12}, SocketBonus: stats.Stats{}},
-	{Name: "Fists of Mukoa", ID: 32234, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 24, stats.Intellect: 17, stats.AttackPower: 76, stats.MeleeHaste: 37, stats.Armor: 617, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
+	{Name: "Fists of Mukoa", ID: 32234, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 24, stats.Intellect: 17, stats.AttackPower: 76, stats.MeleeHaste: 37, stats.Armor: 617, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Fists of the Unrelenting", ID: 23072, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 90, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 26, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.Armor: 718}, SocketBonus: stats.Stats{}},
 	{Name: "Fizit's Mantle of Drake Hunting", ID: 31438, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 18, stats.Spirit: 18, stats.SpellPower: 18, stats.HealingPower: 18, stats.SpellCrit: 11, stats.Armor: 97}, SocketBonus: stats.Stats{}},
 	{Name: "Flamebane Bracers", ID: 23515, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 15, stats.Armor: 497, stats.FireResistance: 28}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Parry: 2}, SetName: "Flame Guard"},
@@ -1397,24 +1397,24 @@ var Items = []Item{
 	{Name: "Flamescale Leggings", ID: 29492, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 556, stats.FireResistance: 40}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
 	{Name: "Flametongue Seal", ID: 29270, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.FireSpellPower: 49, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Flaming Quartz Staff", ID: 32662, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 92.0, WeaponDamageMax: 171.0, SwingSpeed: 2.10, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 46, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellCrit: 26}, SocketBonus: stats.Stats{}},
-	{Name: "Flashfire Girdle", ID: 32276, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 18, stats.SpellHaste: 37, stats.Armor: 556}, SocketBonus: stats.Stats{}},
+	{Name: "Flashfire Girdle", ID: 32276, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 18, stats.SpellHaste: 37, stats.Armor: 556}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Flesh Handler's Gauntlets", ID: 30341, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 18, stats.Agility: 17, stats.Stamina: 27, stats.Expertise: 18, stats.Armor: 165}, SocketBonus: stats.Stats{}},
 	{Name: "Flesh Handler's Headpiece", ID: 30329, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 26, stats.MP5: 8, stats.AttackPower: 52, stats.Armor: 477, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Fleshling Simulation Staff", ID: 30010, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 105.0, WeaponDamageMax: 190.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, QualityModifier: -25.800, Stats: stats.Stats{stats.Strength: 27, stats.Agility: 27, stats.Stamina: 27, stats.MeleeHit: 26, stats.Expertise: 17, stats.FeralAttackPower: 457}, SocketBonus: stats.Stats{}},
 	{Name: "Fletcher's Gloves of the Phoenix", ID: 34374, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 33, stats.Intellect: 25, stats.AttackPower: 76, stats.MeleeHaste: 34, stats.Armor: 694, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
-	{Name: "Focused Mana Bindings", ID: 32270, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 19, stats.Armor: 103}, SocketBonus: stats.Stats{}},
-	{Name: "Fool's Bane", ID: 28657, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 159.0, WeaponDamageMax: 296.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 30, stats.MeleeCrit: 24, stats.RangedAttackPower: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "Focused Mana Bindings", ID: 32270, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 19, stats.Armor: 103}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
+	{Name: "Fool's Bane", ID: 28657, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 159.0, WeaponDamageMax: 296.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 30, stats.MeleeCrit: 24, stats.RangedAttackPower: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Footpads of Madness", ID: 33357, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 22, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHaste: 25, stats.Armor: 148}, SocketBonus: stats.Stats{}},
 	{Name: "Footwraps of Wild Encroachment", ID: 33582, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 29, stats.Stamina: 28, stats.Intellect: 19, stats.Armor: 418}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Footwraps of the Oracle", ID: 21349, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 17, stats.Spirit: 12, stats.SpellPower: 21, stats.HealingPower: 21, stats.MP5: 3, stats.Armor: 123}, SocketBonus: stats.Stats{}, SetName: "Garments of the Oracle"},
 	{Name: "Foreman's Enchanted Helmet", ID: 23838, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 95, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 30, stats.SpellPower: 36, stats.HealingPower: 36, stats.Armor: 132}, SocketBonus: stats.Stats{}},
 	{Name: "Foreman's Reinforced Helmet", ID: 23839, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 95, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 48, stats.AttackPower: 62, stats.Armor: 550, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
-	{Name: "Forest Prowler's Helm", ID: 32376, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 29, stats.Intellect: 28, stats.AttackPower: 100, stats.MeleeCrit: 20, stats.Armor: 803, stats.RangedAttackPower: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MP5: 2}},
-	{Name: "Forest Wind Shoulderpads", ID: 28647, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 28, stats.Spirit: 24, stats.SpellPower: 22, stats.HealingPower: 88, stats.Armor: 273}, SocketBonus: stats.Stats{}},
+	{Name: "Forest Prowler's Helm", ID: 32376, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 42, stats.Stamina: 29, stats.Intellect: 28, stats.AttackPower: 100, stats.MeleeCrit: 20, stats.Armor: 803, stats.RangedAttackPower: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MP5: 2}, SourceZone: "Black Temple"},
+	{Name: "Forest Wind Shoulderpads", ID: 28647, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 28, stats.Spirit: 24, stats.SpellPower: 22, stats.HealingPower: 88, stats.Armor: 273}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Curator"},
 	{Name: "Forestheart Bracers", ID: 29263, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 18, stats.Agility: 18, stats.Stamina: 27, stats.Intellect: 16, stats.Armor: 237}, SocketBonus: stats.Stats{}},
-	{Name: "Forestlord Striders", ID: 28752, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 27, stats.Spirit: 16, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 250}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
+	{Name: "Forestlord Striders", ID: 28752, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 27, stats.Spirit: 16, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 250}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Forestwalker Kilt", ID: 30535, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 33, stats.Agility: 26, stats.Stamina: 24, stats.Intellect: 27, stats.Armor: 459}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}},
-	{Name: "Frayed Tether of the Drowned", ID: 30099, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.MeleeHit: 18, stats.Defense: 24}, SocketBonus: stats.Stats{}},
+	{Name: "Frayed Tether of the Drowned", ID: 30099, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.MeleeHit: 18, stats.Defense: 24}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Fathom-Lord Karathress"},
 	{Name: "Frostfire Belt", ID: 22502, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 21, stats.Spirit: 10, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellHit: 8, stats.Armor: 85}, SocketBonus: stats.Stats{}, SetName: "Frostfire Regalia"},
 	{Name: "Frostfire Bindings", ID: 22503, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 15, stats.SpellPower: 27, stats.HealingPower: 27, stats.SpellPenetration: 10, stats.Armor: 66}, SocketBonus: stats.Stats{}, SetName: "Frostfire Regalia"},
 	{Name: "Frostfire Circlet", ID: 22498, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 8, stats.SpellCrit: 28, stats.Armor: 123}, SocketBonus: stats.Stats{}, SetName: "Frostfire Regalia"},
@@ -1430,7 +1430,7 @@ var Items = []Item{
 	{Name: "Frozen Shadoweave Shoulders", ID: 21869, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 15, stats.FrostSpellPower: 50, stats.ShadowSpellPower: 50, stats.Armor: 133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellHit: 3}, SetName: "Shadow's Embrace"},
 	{Name: "Furious Deathgrips", ID: 33512, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 34, stats.Stamina: 37, stats.MeleeHit: 25, stats.Armor: 1005}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Furious Gizmatic Goggles", ID: 32461, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Strength: 48, stats.Stamina: 28, stats.MeleeHit: 13, stats.MeleeCrit: 38, stats.Armor: 1296}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
-	{Name: "Furious Shackles", ID: 30861, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 28, stats.MeleeCrit: 19, stats.Armor: 772}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "Furious Shackles", ID: 30861, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 28, stats.MeleeCrit: 19, stats.Armor: 772}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Fury", ID: 33640, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 174.0, WeaponDamageMax: 323.0, SwingSpeed: 2.60, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.Stamina: 20, stats.AttackPower: 26, stats.MeleeHit: 20, stats.RangedAttackPower: 26}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Fury of the Ursine", ID: 33285, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 17, stats.Spirit: 16, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellCrit: 17, stats.Armor: 94}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Fused Nethergon Band", ID: 34889, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 28}, SocketBonus: stats.Stats{}},
@@ -1438,19 +1438,19 @@ var Items = []Item{
 	{Name: "Gargon's Bracers of Peaceful Slumber", ID: 33557, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 25, stats.Spirit: 17, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}},
 	{Name: "Garments of Crashing Shores", ID: 34396, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellCrit: 25, stats.SpellHaste: 40, stats.Armor: 1110}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Garments of Serene Shores", ID: 34229, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.SpellPower: 45, stats.HealingPower: 179, stats.MP5: 16, stats.SpellCrit: 25, stats.Armor: 1110}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
-	{Name: "Garments of Temperance", ID: 32340, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 34, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 20, stats.Armor: 236}, SocketBonus: stats.Stats{}},
-	{Name: "Garona's Signet Ring", ID: 28649, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 25, stats.AttackPower: 40, stats.MeleeHit: 18, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
+	{Name: "Garments of Temperance", ID: 32340, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 34, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 20, stats.Armor: 236}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
+	{Name: "Garona's Signet Ring", ID: 28649, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 25, stats.AttackPower: 40, stats.MeleeHit: 18, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Curator"},
 	{Name: "Gauntlets of Annihilation", ID: 21581, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 15, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.Armor: 702}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of Cruel Intention", ID: 28324, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 30, stats.Stamina: 25, stats.MeleeCrit: 21, stats.Armor: 728}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of Desolation", ID: 27528, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 16, stats.AttackPower: 32, stats.MeleeCrit: 17, stats.Armor: 407, stats.RangedAttackPower: 32}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Desolation Battlegear"},
 	{Name: "Gauntlets of Dissension", ID: 32072, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 24, stats.Agility: 23, stats.Stamina: 36, stats.Armor: 868, stats.Defense: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of Divine Blessings", ID: 34700, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 17, stats.SpellPower: 19, stats.HealingPower: 74, stats.SpellHaste: 16, stats.Armor: 728}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Gauntlets of Enforcement", ID: 32280, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 70, stats.Expertise: 21, stats.Armor: 1103, stats.Defense: 32, stats.BlockValue: 36}, SocketBonus: stats.Stats{}},
+	{Name: "Gauntlets of Enforcement", ID: 32280, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 70, stats.Expertise: 21, stats.Armor: 1103, stats.Defense: 32, stats.BlockValue: 36}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Gauntlets of Kalimdor", ID: 21624, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Strength: 14, stats.Stamina: 15, stats.Intellect: 13, stats.Spirit: 10, stats.SpellPower: 20, stats.HealingPower: 20, stats.SpellCrit: 14, stats.Armor: 353}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of Malorne", ID: 29097, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 32, stats.Agility: 24, stats.Stamina: 28, stats.Intellect: 12, stats.Armor: 475}, SocketBonus: stats.Stats{}, SetName: "Malorne Harness"},
-	{Name: "Gauntlets of Martial Perfection", ID: 28824, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 36, stats.Stamina: 34, stats.MeleeCrit: 23, stats.Armor: 982}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
+	{Name: "Gauntlets of Martial Perfection", ID: 28824, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 36, stats.Stamina: 34, stats.MeleeCrit: 23, stats.Armor: 982}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Gauntlets of Rapidity", ID: 34916, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 34, stats.Stamina: 30, stats.Intellect: 19, stats.AttackPower: 78, stats.MeleeCrit: 16, stats.Armor: 617, stats.RangedAttackPower: 78}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 2}},
-	{Name: "Gauntlets of Renewed Hope", ID: 28505, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 29, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 5, stats.Armor: 906}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 3}},
+	{Name: "Gauntlets of Renewed Hope", ID: 28505, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 29, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 5, stats.Armor: 906}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 3}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Gauntlets of Sniping", ID: 33528, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 27, stats.Intellect: 20, stats.AttackPower: 58, stats.MeleeHit: 19, stats.Armor: 562, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
 	{Name: "Gauntlets of Steadfast Determination", ID: 21674, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 19, stats.Agility: 18, stats.Stamina: 20, stats.Armor: 611, stats.Defense: 13}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of Ten Storms", ID: 16948, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 17, stats.Spirit: 13, stats.SpellPower: 13, stats.HealingPower: 28, stats.MP5: 6, stats.Armor: 344, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "The Ten Storms"},
@@ -1460,13 +1460,13 @@ var Items = []Item{
 	{Name: "Gauntlets of the Ancient Shadowmoon", ID: 34350, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 32, stats.SpellPower: 43, stats.HealingPower: 43, stats.SpellCrit: 28, stats.SpellHaste: 24, stats.Armor: 672}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 2}},
 	{Name: "Gauntlets of the Bold", ID: 27475, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 17, stats.Agility: 16, stats.Stamina: 31, stats.Armor: 728, stats.Defense: 14}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Parry: 3}, SetName: "Bold Armor"},
 	{Name: "Gauntlets of the Chosen", ID: 29134, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 15, stats.Stamina: 30, stats.Armor: 728, stats.Defense: 35}, SocketBonus: stats.Stats{}},
-	{Name: "Gauntlets of the Dragonslayer", ID: 28827, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 27, stats.Intellect: 27, stats.AttackPower: 48, stats.Armor: 550, stats.RangedAttackPower: 48}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}},
+	{Name: "Gauntlets of the Dragonslayer", ID: 28827, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 27, stats.Intellect: 27, stats.AttackPower: 48, stats.Armor: 550, stats.RangedAttackPower: 48}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Gauntlets of the Iron Tower", ID: 23532, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 831, stats.Defense: 20, stats.Resilience: 26}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Gauntlets of the Redeemed Vindicator", ID: 30370, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 21, stats.Intellect: 20, stats.AttackPower: 48, stats.Armor: 387, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of the Righteous", ID: 27535, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 20, stats.SpellPower: 21, stats.HealingPower: 21, stats.MP5: 7, stats.Armor: 728, stats.Defense: 19}, SocketBonus: stats.Stats{}, SetName: "Righteous Armor"},
 	{Name: "Gauntlets of the Righteous Champion", ID: 21623, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Strength: 15, stats.Stamina: 17, stats.Intellect: 13, stats.Spirit: 10, stats.SpellPower: 16, stats.HealingPower: 16, stats.MeleeCrit: 14, stats.Armor: 627}, SocketBonus: stats.Stats{}},
 	{Name: "Gauntlets of the Soothed Soul", ID: 34240, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 33, stats.SpellPower: 33, stats.HealingPower: 132, stats.MP5: 9, stats.SpellHaste: 30, stats.Armor: 1239}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Gauntlets of the Sun King", ID: 29987, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.Spirit: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 28, stats.Armor: 145}, SocketBonus: stats.Stats{}},
+	{Name: "Gauntlets of the Sun King", ID: 29987, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.Spirit: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 28, stats.Armor: 145}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Gauntlets of the Tranquil Waves", ID: 34791, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 10, stats.SpellPower: 19, stats.HealingPower: 74, stats.SpellHaste: 24, stats.Armor: 407}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
 	{Name: "Gauntlets of the Vanquisher", ID: 30375, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 24, stats.Agility: 23, stats.Stamina: 30, stats.MeleeHit: 14, stats.Armor: 691}, SocketBonus: stats.Stats{}},
 	{Name: "Gavel of Naaru Blessings", ID: 34896, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 16.0, WeaponDamageMax: 116.0, SwingSpeed: 1.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, QualityModifier: -61.800, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 28, stats.SpellPower: 155, stats.HealingPower: 619}, SocketBonus: stats.Stats{}},
@@ -1492,7 +1492,7 @@ var Items = []Item{
 	{Name: "General's Leather Belt", ID: 28423, ClassAllowlist: []proto.Class{proto.Class_ClassRogue, proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 39, stats.AttackPower: 30, stats.MeleeCrit: 15, stats.Armor: 219, stats.RangedAttackPower: 30, stats.Resilience: 26}, SocketBonus: stats.Stats{}},
 	{Name: "General's Leather Boots", ID: 28422, ClassAllowlist: []proto.Class{proto.Class_ClassRogue, proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 39, stats.AttackPower: 30, stats.MeleeCrit: 15, stats.Armor: 267, stats.RangedAttackPower: 30, stats.Resilience: 26}, SocketBonus: stats.Stats{}},
 	{Name: "General's Leather Bracers", ID: 28424, ClassAllowlist: []proto.Class{proto.Class_ClassRogue, proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 113, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 25, stats.AttackPower: 16, stats.MeleeCrit: 7, stats.Armor: 157, stats.RangedAttackPower: 16, stats.Resilience: 13}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 2}},
-	{Name: "General's Linked Bracers", ID: 28605, ClassAllowlist: []proto.Class{proto.Class_ClassHunter, proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 113, Stats: stats.Stats{stats.Strength: 19, stats.Stamina: 25, stats.Intellect: 9, stats.MeleeCrit: 15, stats.Armor: 349, stats.Resilience: 10}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 2}},
+	{Name: "General's Linked Bracers", ID: 28605, ClassAllowlist: []proto.Class{proto.Class_ClassHunter, proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 113, Stats: stats.Stats{stats.Strength: 19, stats.Stamina: 25, stats.Intellect: 9, stats.MeleeCrit: 15, stats.Armor: 349, stats.Resilience: 10}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 2}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "General's Linked Girdle", ID: 28629, ClassAllowlist: []proto.Class{proto.Class_ClassHunter, proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Strength: 27, stats.Stamina: 39, stats.Intellect: 18, stats.MeleeCrit: 27, stats.Armor: 487, stats.Resilience: 19}, SocketBonus: stats.Stats{}},
 	{Name: "General's Linked Sabatons", ID: 28630, ClassAllowlist: []proto.Class{proto.Class_ClassHunter, proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 39, stats.Intellect: 18, stats.MeleeCrit: 27, stats.Armor: 595, stats.Resilience: 19}, SocketBonus: stats.Stats{}},
 	{Name: "General's Mail Bracers", ID: 28638, ClassAllowlist: []proto.Class{proto.Class_ClassHunter, proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 113, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 12, stats.SpellPower: 20, stats.HealingPower: 20, stats.SpellCrit: 14, stats.Armor: 349, stats.Resilience: 13}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 2}},
@@ -1529,40 +1529,40 @@ var Items = []Item{
 	{Name: "Ghostly Headwrap", ID: 30931, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 11, stats.Spirit: 16, stats.SpellPower: 46, stats.HealingPower: 46, stats.Armor: 257}, SocketBonus: stats.Stats{}},
 	{Name: "Ghoul Skin Tunic", ID: 23226, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 22, stats.MeleeCrit: 28, stats.Armor: 446}, SocketBonus: stats.Stats{}},
 	{Name: "Gift of the Talonpriests", ID: 32535, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 12, stats.SpellPower: 22, stats.HealingPower: 88}, SocketBonus: stats.Stats{}},
-	{Name: "Gilded Thorium Cloak", ID: 28660, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 385, stats.Defense: 24}, SocketBonus: stats.Stats{}},
+	{Name: "Gilded Thorium Cloak", ID: 28660, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 385, stats.Defense: 24}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Gilded Trousers of Benediction", ID: 30727, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 16, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 12, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
 	{Name: "Girdle of Elemental Fury", ID: 23663, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 21, stats.SpellPower: 29, stats.HealingPower: 29, stats.MP5: 5, stats.Armor: 344}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of Fallen Stars", ID: 30030, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 18, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 10, stats.Armor: 506}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
 	{Name: "Girdle of Ferocity", ID: 29261, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 21, stats.Intellect: 22, stats.AttackPower: 44, stats.MeleeHit: 14, stats.Armor: 438, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of Gale Force", ID: 31293, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Agility: 32, stats.Intellect: 20, stats.MP5: 6, stats.AttackPower: 28, stats.Armor: 357, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of Gallantry", ID: 27755, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 27, stats.Agility: 14, stats.Stamina: 22, stats.Intellect: 13, stats.Armor: 655}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}},
-	{Name: "Girdle of Hope", ID: 30897, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 38, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellCrit: 21, stats.Armor: 993}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}},
+	{Name: "Girdle of Hope", ID: 30897, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 38, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellCrit: 21, stats.Armor: 993}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Girdle of Living Flame", ID: 27743, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 17, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellHit: 16, stats.Armor: 367}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 3}},
 	{Name: "Girdle of Lordaeron's Fallen", ID: 32512, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 32, stats.Intellect: 32, stats.SpellPower: 24, stats.HealingPower: 94, stats.SpellHaste: 38, stats.Armor: 993}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of Many Blessings", ID: 27548, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 16, stats.HealingPower: 62, stats.SpellCrit: 21, stats.Armor: 655}, SocketBonus: stats.Stats{}},
-	{Name: "Girdle of Mighty Resolve", ID: 32342, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 993, stats.Defense: 26, stats.Block: 25, stats.BlockValue: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}},
+	{Name: "Girdle of Mighty Resolve", ID: 32342, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 993, stats.Defense: 26, stats.Block: 25, stats.BlockValue: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}, SourceZone: "Black Temple"},
 	{Name: "Girdle of Ruination", ID: 24256, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 13, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 20, stats.Armor: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
 	{Name: "Girdle of Seething Rage", ID: 34944, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 55, stats.MeleeHaste: 30, stats.Armor: 993}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 2}},
-	{Name: "Girdle of Stability", ID: 32333, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.Armor: 993, stats.Defense: 19, stats.BlockValue: 56, stats.Dodge: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Girdle of Stability", ID: 32333, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.Armor: 993, stats.Defense: 19, stats.BlockValue: 56, stats.Dodge: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Black Temple"},
 	{Name: "Girdle of Stromgarde's Hope", ID: 33446, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.SpellPower: 27, stats.HealingPower: 106, stats.MP5: 6, stats.SpellCrit: 16, stats.Armor: 904}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
-	{Name: "Girdle of Treachery", ID: 28750, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 37, stats.AttackPower: 58, stats.Armor: 205, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}},
-	{Name: "Girdle of Truth", ID: 28733, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 8, stats.Armor: 816}, SocketBonus: stats.Stats{}},
+	{Name: "Girdle of Treachery", ID: 28750, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 37, stats.AttackPower: 58, stats.Armor: 205, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
+	{Name: "Girdle of Truth", ID: 28733, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 29, stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 8, stats.Armor: 816}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Girdle of Valorous Deeds", ID: 29253, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 22, stats.SpellPower: 20, stats.HealingPower: 20, stats.Armor: 782, stats.Defense: 24, stats.Block: 16}, SocketBonus: stats.Stats{}},
-	{Name: "Girdle of Zaetar", ID: 29984, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.Spirit: 24, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 227}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
+	{Name: "Girdle of Zaetar", ID: 29984, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.Spirit: 24, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 227}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Tempest Keep", SourceDrop: "Void Reaver"},
 	{Name: "Girdle of the Blasted Reaches", ID: 27478, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 22, stats.Intellect: 20, stats.AttackPower: 30, stats.Armor: 367, stats.RangedAttackPower: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}},
 	{Name: "Girdle of the Deathdealer", ID: 29247, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 28, stats.AttackPower: 56, stats.MeleeHit: 20, stats.Armor: 196, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
-	{Name: "Girdle of the Endless Pit", ID: 28779, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 34, stats.Stamina: 30, stats.MeleeCrit: 28, stats.Armor: 884}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
+	{Name: "Girdle of the Endless Pit", ID: 28779, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 34, stats.Stamina: 30, stats.MeleeCrit: 28, stats.Armor: 884}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Girdle of the Fallen Crusader", ID: 19392, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Strength: 20, stats.Agility: 10, stats.Stamina: 15, stats.Intellect: 17, stats.Spirit: 13, stats.Armor: 557}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of the Fearless", ID: 34941, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 58, stats.MeleeHit: 23, stats.Expertise: 22, stats.Armor: 993, stats.Defense: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Girdle of the Immovable", ID: 27672, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 33, stats.Armor: 655, stats.Defense: 18, stats.Block: 12}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 3}},
-	{Name: "Girdle of the Invulnerable", ID: 30096, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.Armor: 904, stats.Defense: 22, stats.Dodge: 19, stats.Parry: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Girdle of the Invulnerable", ID: 30096, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.Armor: 904, stats.Defense: 22, stats.Dodge: 19, stats.Parry: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Leotheras the Blind"},
 	{Name: "Girdle of the Lightbearer", ID: 32606, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 49, stats.Stamina: 33, stats.Intellect: 21, stats.MeleeHaste: 32, stats.Armor: 993}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of the Lost Vindicator", ID: 30380, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 18, stats.Stamina: 39, stats.Armor: 590, stats.Defense: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of the Mentor", ID: 23219, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Strength: 21, stats.Agility: 20, stats.Stamina: 21, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.Armor: 612}, SocketBonus: stats.Stats{}},
 	{Name: "Girdle of the Protector", ID: 33524, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 40, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellHit: 18, stats.Armor: 904, stats.Defense: 11, stats.Dodge: 27}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
-	{Name: "Girdle of the Prowler", ID: 28656, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 21, stats.Intellect: 22, stats.MP5: 6, stats.AttackPower: 48, stats.MeleeHit: 17, stats.Armor: 457, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
-	{Name: "Girdle of the Righteous Path", ID: 29965, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 33, stats.SpellPower: 26, stats.HealingPower: 103, stats.SpellCrit: 27, stats.Armor: 904}, SocketBonus: stats.Stats{}},
-	{Name: "Girdle of the Tidal Call", ID: 30068, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 30, stats.Intellect: 20, stats.MeleeCrit: 33, stats.Armor: 506}, SocketBonus: stats.Stats{}},
+	{Name: "Girdle of the Prowler", ID: 28656, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 21, stats.Intellect: 22, stats.MP5: 6, stats.AttackPower: 48, stats.MeleeHit: 17, stats.Armor: 457, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
+	{Name: "Girdle of the Righteous Path", ID: 29965, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 33, stats.SpellPower: 26, stats.HealingPower: 103, stats.SpellCrit: 27, stats.Armor: 904}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
+	{Name: "Girdle of the Tidal Call", ID: 30068, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 30, stats.Intellect: 20, stats.MeleeCrit: 33, stats.Armor: 506}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Glacial Cloak", ID: 22658, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 80, Stats: stats.Stats{stats.Stamina: 18, stats.Armor: 69, stats.FrostResistance: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Glacial Gloves", ID: 22654, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 80, Stats: stats.Stats{stats.Stamina: 22, stats.SpellPower: 15, stats.HealingPower: 15, stats.Armor: 87, stats.FrostResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Glacial Headdress", ID: 23032, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 21, stats.SpellPower: 18, stats.HealingPower: 18, stats.Armor: 117, stats.FrostResistance: 40}, SocketBonus: stats.Stats{}},
@@ -1689,16 +1689,16 @@ var Items = []Item{
 	{Name: "Gladiator's Wyrmhide Legguards", ID: 28138, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 29, stats.SpellPower: 50, stats.HealingPower: 50, stats.MP5: 10, stats.Armor: 400, stats.Resilience: 29}, SocketBonus: stats.Stats{}, SetName: "Gladiator's Wildhide"},
 	{Name: "Gladiator's Wyrmhide Spaulders", ID: 28139, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 14, stats.SpellPower: 28, stats.HealingPower: 28, stats.MP5: 4, stats.Armor: 331, stats.Resilience: 20}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Gladiator's Wildhide"},
 	{Name: "Gladiator's Wyrmhide Tunic", ID: 28140, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 22, stats.SpellPower: 46, stats.HealingPower: 46, stats.MP5: 6, stats.Armor: 429, stats.Resilience: 26}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 4}, SetName: "Gladiator's Wildhide"},
-	{Name: "Glaive of the Pit", ID: 28774, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 354.0, WeaponDamageMax: 532.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
+	{Name: "Glaive of the Pit", ID: 28774, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 354.0, WeaponDamageMax: 532.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Gleaming Earthen Bracers", ID: 33532, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 10, stats.Armor: 394}, SocketBonus: stats.Stats{}},
 	{Name: "Glimmering Naaru Sliver", ID: 34430, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.SpellPower: 40, stats.HealingPower: 159}, SocketBonus: stats.Stats{}},
-	{Name: "Glimmering Steel Mantle", ID: 30878, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellCrit: 29, stats.Armor: 1324}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}},
-	{Name: "Glorious Gauntlets of Crestfall", ID: 30112, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.SpellPower: 27, stats.HealingPower: 108, stats.SpellCrit: 28, stats.Armor: 1080}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
-	{Name: "Glory of the Defender", ID: 30896, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 75, stats.MeleeHit: 34, stats.Armor: 1765, stats.Defense: 35, stats.Dodge: 51}, SocketBonus: stats.Stats{}},
+	{Name: "Glimmering Steel Mantle", ID: 30878, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellCrit: 29, stats.Armor: 1324}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
+	{Name: "Glorious Gauntlets of Crestfall", ID: 30112, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.SpellPower: 27, stats.HealingPower: 108, stats.SpellCrit: 28, stats.Armor: 1080}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
+	{Name: "Glory of the Defender", ID: 30896, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 75, stats.MeleeHit: 34, stats.Armor: 1765, stats.Defense: 35, stats.Dodge: 51}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Gloves of Absolution", ID: 31060, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 31, stats.Spirit: 27, stats.SpellPower: 29, stats.HealingPower: 115, stats.MP5: 5, stats.Armor: 153}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}, SetName: "Vestments of Absolution"},
 	{Name: "Gloves of Arcane Acuity", ID: 34808, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 20, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellHit: 18, stats.Armor: 116}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Gloves of Centering", ID: 28520, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 20, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 6, stats.Armor: 507}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}},
-	{Name: "Gloves of Dexterous Manipulation", ID: 28506, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 35, stats.Stamina: 22, stats.AttackPower: 42, stats.Armor: 228, stats.RangedAttackPower: 42}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
+	{Name: "Gloves of Centering", ID: 28520, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 20, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 6, stats.Armor: 507}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
+	{Name: "Gloves of Dexterous Manipulation", ID: 28506, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 35, stats.Stamina: 22, stats.AttackPower: 42, stats.Armor: 228, stats.RangedAttackPower: 42}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Gloves of Ebru", ID: 21689, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 27, stats.HealingPower: 27, stats.SpellCrit: 14, stats.Armor: 158}, SocketBonus: stats.Stats{}},
 	{Name: "Gloves of Enforcement", ID: 21672, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 28, stats.Agility: 20, stats.Stamina: 6, stats.MeleeHit: 10, stats.Armor: 160}, SocketBonus: stats.Stats{}},
 	{Name: "Gloves of Faith", ID: 22517, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 21, stats.Spirit: 22, stats.SpellPower: 14, stats.HealingPower: 54, stats.MP5: 4, stats.Armor: 95}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
@@ -1708,11 +1708,11 @@ var Items = []Item{
 	{Name: "Gloves of Pandemonium", ID: 31149, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 91, Stats: stats.Stats{stats.Intellect: 15, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellHit: 10, stats.SpellCrit: 22, stats.Armor: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Gloves of Preservation", ID: 25791, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 25, stats.Spirit: 25, stats.SpellPower: 18, stats.HealingPower: 71, stats.Armor: 181}, SocketBonus: stats.Stats{}},
 	{Name: "Gloves of Preservation", ID: 25795, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.Armor: 165}, SocketBonus: stats.Stats{}},
-	{Name: "Gloves of Quickening", ID: 28519, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.MP5: 4, stats.AttackPower: 52, stats.MeleeCrit: 17, stats.Armor: 507, stats.RangedAttackPower: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}},
-	{Name: "Gloves of Saintly Blessings", ID: 28508, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 25, stats.Spirit: 25, stats.SpellPower: 14, stats.HealingPower: 54, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
+	{Name: "Gloves of Quickening", ID: 28519, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.MP5: 4, stats.AttackPower: 52, stats.MeleeCrit: 17, stats.Armor: 507, stats.RangedAttackPower: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
+	{Name: "Gloves of Saintly Blessings", ID: 28508, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 25, stats.Spirit: 25, stats.SpellPower: 14, stats.HealingPower: 54, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Gloves of Tirisfal", ID: 30205, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 27, stats.Spirit: 18, stats.SpellPower: 41, stats.HealingPower: 41, stats.SpellCrit: 27, stats.Armor: 140}, SocketBonus: stats.Stats{}, SetName: "Tirisfal Regalia"},
 	{Name: "Gloves of Tyri's Power", ID: 34406, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 32, stats.Spirit: 27, stats.SpellPower: 47, stats.HealingPower: 47, stats.SpellHaste: 36, stats.Armor: 171}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Gloves of Unfailing Faith", ID: 32353, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 33, stats.SpellPower: 25, stats.HealingPower: 100, stats.MP5: 11, stats.Armor: 148}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
+	{Name: "Gloves of Unfailing Faith", ID: 32353, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 33, stats.SpellPower: 25, stats.HealingPower: 100, stats.MP5: 11, stats.Armor: 148}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}, SourceZone: "Black Temple"},
 	{Name: "Gloves of the Aldor", ID: 29080, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 22, stats.Spirit: 19, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 17, stats.SpellCrit: 19, stats.Armor: 126}, SocketBonus: stats.Stats{}, SetName: "Aldor Regalia"},
 	{Name: "Gloves of the Avatar", ID: 30151, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 27, stats.Spirit: 29, stats.SpellPower: 26, stats.HealingPower: 103, stats.MP5: 6, stats.Armor: 140}, SocketBonus: stats.Stats{}, SetName: "Avatar Raiment"},
 	{Name: "Gloves of the Corruptor", ID: 30211, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 50, stats.Intellect: 24, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellCrit: 25, stats.Armor: 140}, SocketBonus: stats.Stats{}, SetName: "Corruptor Raiment"},
@@ -1727,16 +1727,16 @@ var Items = []Item{
 	{Name: "Gloves of the Messiah", ID: 21619, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 17, stats.SpellPower: 9, stats.HealingPower: 35, stats.MP5: 10, stats.Armor: 85}, SocketBonus: stats.Stats{}},
 	{Name: "Gloves of the Nether-Stalker", ID: 30003, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 12, stats.AttackPower: 60, stats.MeleeHit: 8, stats.Armor: 165, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
 	{Name: "Gloves of the Redeemed Prophecy", ID: 21889, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Strength: 12, stats.Stamina: 20, stats.Intellect: 20, stats.SpellPower: 13, stats.HealingPower: 50, stats.Armor: 603}, SocketBonus: stats.Stats{}},
-	{Name: "Gloves of the Searing Grip", ID: 29947, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 37, stats.AttackPower: 66, stats.Expertise: 18, stats.Armor: 252, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}},
+	{Name: "Gloves of the Searing Grip", ID: 29947, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 37, stats.AttackPower: 66, stats.Expertise: 18, stats.Armor: 252, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Gloves of the Tempest", ID: 31055, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 26, stats.Spirit: 21, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 20, stats.SpellCrit: 19, stats.Armor: 153}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Tempest Regalia"},
 	{Name: "Gloves of the Unbound", ID: 28396, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 20, stats.AttackPower: 38, stats.Armor: 183, stats.RangedAttackPower: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Resilience: 3}},
-	{Name: "Glowing Breastplate of Truth", ID: 30065, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 40, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 10, stats.SpellCrit: 42, stats.Armor: 1607}, SocketBonus: stats.Stats{}},
+	{Name: "Glowing Breastplate of Truth", ID: 30065, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 40, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 10, stats.SpellCrit: 42, stats.Armor: 1607}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Gluth's Missing Collar", ID: 22981, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 24, stats.BlockValue: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Glyph of Deflection", ID: 23040, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{stats.BlockValue: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Glyph-Lined Sash", ID: 27843, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellCrit: 9, stats.Armor: 88}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Gnarled Chestpiece of the Ancients", ID: 30075, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 32, stats.Spirit: 34, stats.SpellPower: 37, stats.HealingPower: 147, stats.Armor: 404}, SocketBonus: stats.Stats{}},
 	{Name: "Gnarled Ironwood Pauldrons", ID: 33287, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.Spirit: 26, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 303}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
-	{Name: "Gnomeregan Auto-Blocker 600", ID: 29387, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.BlockValue: 59}, SocketBonus: stats.Stats{}},
+	{Name: "Gnomeregan Auto-Blocker 600", ID: 29387, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.BlockValue: 59}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 41},
 	{Name: "Gnomish Battle Goggles", ID: 23829, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 95, Stats: stats.Stats{stats.Stamina: 21, stats.AttackPower: 100, stats.MeleeCrit: 28, stats.Armor: 249, stats.RangedAttackPower: 100}, SocketBonus: stats.Stats{}},
 	{Name: "Gnomish Casting Boots", ID: 31456, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 8, stats.Spirit: 7, stats.SpellPower: 19, stats.HealingPower: 19, stats.SpellCrit: 28, stats.Armor: 92}, SocketBonus: stats.Stats{}},
 	{Name: "Gnomish Poultryizer", ID: 23835, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 68, Stats: stats.Stats{stats.Stamina: 45}, SocketBonus: stats.Stats{}},
@@ -1747,10 +1747,10 @@ var Items = []Item{
 	{Name: "Gold-Leaf Wildboots", ID: 30737, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Intellect: 19, stats.Spirit: 18, stats.SpellPower: 29, stats.HealingPower: 115, stats.Armor: 261}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
 	{Name: "Gold-Trimmed Cuffs", ID: 30520, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 9, stats.SpellPower: 25, stats.HealingPower: 25, stats.Armor: 60}, SocketBonus: stats.Stats{}},
 	{Name: "Golden Bow of Quel'Thalas", ID: 34196, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 204.0, WeaponDamageMax: 380.0, SwingSpeed: 3.00, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 18, stats.AttackPower: 34, stats.ArmorPenetration: 175, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
-	{Name: "Golden Links of Restoration", ID: 30887, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 35, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 19, stats.Armor: 988}, SocketBonus: stats.Stats{}},
+	{Name: "Golden Links of Restoration", ID: 30887, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 35, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 19, stats.Armor: 988}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Golden Staff of the Sin'dorei", ID: 34337, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 146.0, WeaponDamageMax: 337.0, SwingSpeed: 3.20, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, QualityModifier: -73.000, Stats: stats.Stats{stats.Stamina: 60, stats.Intellect: 54, stats.Spirit: 57, stats.SpellPower: 183, stats.HealingPower: 733, stats.SpellHaste: 32}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}},
 	{Name: "Goldenlink Bracers", ID: 29811, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Intellect: 12, stats.MP5: 7, stats.AttackPower: 38, stats.MeleeCrit: 16, stats.Armor: 251, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
-	{Name: "Gorehowl", ID: 28773, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 345.0, WeaponDamageMax: 518.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 49, stats.Agility: 43, stats.Stamina: 51}, SocketBonus: stats.Stats{}},
+	{Name: "Gorehowl", ID: 28773, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 345.0, WeaponDamageMax: 518.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 49, stats.Agility: 43, stats.Stamina: 51}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Gown of Spiritual Wonder", ID: 34924, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 35, stats.Spirit: 50, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 236}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 2}},
 	{Name: "Grand Magister's Staff of Torrents", ID: 34182, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 146.0, WeaponDamageMax: 326.0, SwingSpeed: 3.20, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, QualityModifier: -66.500, Stats: stats.Stats{stats.Stamina: 57, stats.Intellect: 52, stats.SpellPower: 266, stats.HealingPower: 266, stats.SpellHit: 50, stats.SpellCrit: 49}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Grand Marshal's Aegis", ID: 18825, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Strength: 10, stats.Stamina: 23, stats.Armor: 3366, stats.BlockValue: 73}, SocketBonus: stats.Stats{}},
@@ -1889,7 +1889,7 @@ var Items = []Item{
 	{Name: "Greaves of Shackled Souls", ID: 32400, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 54, stats.Armor: 799, stats.ShadowResistance: 72}, SocketBonus: stats.Stats{}},
 	{Name: "Greaves of Spellpower", ID: 30374, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.SpellPower: 28, stats.HealingPower: 28, stats.MP5: 8, stats.Armor: 426}, SocketBonus: stats.Stats{}},
 	{Name: "Greaves of Ten Storms", ID: 16949, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 16, stats.Spirit: 16, stats.SpellPower: 20, stats.HealingPower: 20, stats.Armor: 379, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "The Ten Storms"},
-	{Name: "Greaves of the Bloodwarder", ID: 29950, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 49, stats.Stamina: 46, stats.MeleeCrit: 31, stats.Armor: 1406}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}},
+	{Name: "Greaves of the Bloodwarder", ID: 29950, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 49, stats.Stamina: 46, stats.MeleeCrit: 31, stats.Armor: 1406}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Greaves of the Martyr", ID: 30536, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 30, stats.Intellect: 27, stats.MP5: 8, stats.Armor: 1216}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}},
 	{Name: "Greaves of the Penitent Knight", ID: 34612, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 25, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 9, stats.Armor: 1080}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 1}},
 	{Name: "Greaves of the Shatterer", ID: 27527, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 25, stats.Stamina: 37, stats.Armor: 1019, stats.Defense: 25}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{}},
@@ -1898,17 +1898,17 @@ var Items = []Item{
 	{Name: "Gressil, Dawn of Ruin", ID: 23054, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 138.0, WeaponDamageMax: 257.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 89, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Grimgrin Faceguard", ID: 33479, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 48, stats.AttackPower: 82, stats.MeleeHit: 24, stats.Armor: 341, stats.RangedAttackPower: 82}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
 	{Name: "Grip of Mannoroth", ID: 34203, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 113.0, WeaponDamageMax: 211.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.AttackPower: 46, stats.MeleeHit: 20, stats.MeleeHaste: 31, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
-	{Name: "Grips of Damnation", ID: 32347, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 38, stats.AttackPower: 76, stats.MeleeHaste: 37, stats.Armor: 277, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
+	{Name: "Grips of Damnation", ID: 32347, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 38, stats.AttackPower: 76, stats.MeleeHaste: 37, stats.Armor: 277, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Grips of Deftness", ID: 30644, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 34, stats.AttackPower: 60, stats.Expertise: 15, stats.Armor: 228, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
 	{Name: "Grips of Nature's Wrath", ID: 33534, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 27, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 21, stats.Armor: 562}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Grips of Silent Justice", ID: 32278, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 37, stats.MeleeHit: 15, stats.ArmorPenetration: 175, stats.Armor: 1103}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Grips of Silent Justice", ID: 32278, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 37, stats.MeleeHit: 15, stats.ArmorPenetration: 175, stats.Armor: 1103}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Grips of the Lunar Eclipse", ID: 28214, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 25, stats.Spirit: 18, stats.SpellPower: 28, stats.HealingPower: 28, stats.Armor: 183}, SocketBonus: stats.Stats{}},
 	{Name: "Grips of the Void", ID: 30930, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 11, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellCrit: 10, stats.Armor: 88}, SocketBonus: stats.Stats{}},
 	{Name: "Grom'tor's Bloodied Bandage", ID: 30923, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 12, stats.Spirit: 11, stats.SpellPower: 35, stats.HealingPower: 35, stats.Armor: 79}, SocketBonus: stats.Stats{}},
 	{Name: "Grom'tor's Charge", ID: 31071, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 75.0, WeaponDamageMax: 140.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 7, stats.Stamina: 30, stats.Defense: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Grom'tor's Friend's Cousin's Tunic", ID: 30945, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 21, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellCrit: 11, stats.Armor: 263}, SocketBonus: stats.Stats{}},
 	{Name: "Grom'tor's Pendant of Conquest", ID: 30981, Type: proto.ItemType_ItemTypeNeck, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 114, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 22, stats.AttackPower: 32, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
-	{Name: "Gronn-Stitched Girdle", ID: 28828, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 72, stats.MeleeCrit: 25, stats.Armor: 222, stats.RangedAttackPower: 72}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
+	{Name: "Gronn-Stitched Girdle", ID: 28828, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 72, stats.MeleeCrit: 25, stats.Armor: 222, stats.RangedAttackPower: 72}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Gronnstalker's Belt", ID: 34549, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 21, stats.Intellect: 17, stats.AttackPower: 86, stats.MeleeHit: 24, stats.MeleeCrit: 19, stats.ArmorPenetration: 140, stats.Armor: 605, stats.RangedAttackPower: 86}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeCrit: 2}, SetName: "Gronnstalker's Armor"},
 	{Name: "Gronnstalker's Boots", ID: 34570, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 21, stats.Intellect: 21, stats.AttackPower: 86, stats.MeleeHit: 14, stats.MeleeCrit: 21, stats.ArmorPenetration: 140, stats.Armor: 740, stats.RangedAttackPower: 86}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 2}, SetName: "Gronnstalker's Armor"},
 	{Name: "Gronnstalker's Bracers", ID: 34443, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 16, stats.Intellect: 16, stats.AttackPower: 64, stats.MeleeCrit: 17, stats.ArmorPenetration: 112, stats.Armor: 471, stats.RangedAttackPower: 64}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 2}, SetName: "Gronnstalker's Armor"},
@@ -1917,7 +1917,7 @@ var Items = []Item{
 	{Name: "Gronnstalker's Helmet", ID: 31003, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 45, stats.Intellect: 29, stats.MP5: 8, stats.AttackPower: 90, stats.Armor: 830, stats.RangedAttackPower: 90}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SetName: "Gronnstalker's Armor"},
 	{Name: "Gronnstalker's Leggings", ID: 31005, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Agility: 37, stats.Stamina: 43, stats.Intellect: 28, stats.MP5: 11, stats.AttackPower: 106, stats.MeleeCrit: 19, stats.Armor: 894, stats.RangedAttackPower: 106}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 2}, SetName: "Gronnstalker's Armor"},
 	{Name: "Gronnstalker's Spaulders", ID: 31006, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Agility: 34, stats.Stamina: 39, stats.Intellect: 17, stats.AttackPower: 68, stats.ArmorPenetration: 126, stats.Armor: 766, stats.RangedAttackPower: 68}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SetName: "Gronnstalker's Armor"},
-	{Name: "Grove-Bands of Remulos", ID: 30062, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.Spirit: 21, stats.SpellPower: 23, stats.HealingPower: 91, stats.Armor: 177}, SocketBonus: stats.Stats{}},
+	{Name: "Grove-Bands of Remulos", ID: 30062, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.Spirit: 21, stats.SpellPower: 23, stats.HealingPower: 91, stats.Armor: 177}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Grovewalker's Leggings", ID: 34901, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 42, stats.Spirit: 44, stats.SpellPower: 38, stats.HealingPower: 152, stats.Armor: 388}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 3}},
 	{Name: "Guardian's Alchemist Stone", ID: 35748, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Defense: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Guardian's Band of Dominance", ID: 35129, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 35, stats.Intellect: 18, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellHaste: 14, stats.SpellPenetration: 14, stats.Resilience: 22}, SocketBonus: stats.Stats{}},
@@ -1977,24 +1977,24 @@ var Items = []Item{
 	{Name: "Guardian's Wyrmhide Bracers", ID: 35180, ClassAllowlist: []proto.Class{proto.Class_ClassRogue, proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 35, stats.Intellect: 23, stats.SpellPower: 27, stats.HealingPower: 27, stats.MP5: 8, stats.Armor: 253, stats.Resilience: 15}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Resilience: 2}},
 	{Name: "Guile of Khoraazi", ID: 29121, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 104.0, WeaponDamageMax: 156.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Agility: 19, stats.AttackPower: 38, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Guise of the Devourer", ID: 21693, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Strength: 17, stats.Agility: 19, stats.Stamina: 36, stats.Armor: 276, stats.Dodge: 12}, SocketBonus: stats.Stats{}},
-	{Name: "Guise of the Tidal Lurker", ID: 32240, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 35, stats.SpellPower: 35, stats.HealingPower: 138, stats.MP5: 15, stats.Armor: 360}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
+	{Name: "Guise of the Tidal Lurker", ID: 32240, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 35, stats.SpellPower: 35, stats.HealingPower: 138, stats.MP5: 15, stats.Armor: 360}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Gurn's Horned Helmet", ID: 31435, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 20, stats.Intellect: 15, stats.AttackPower: 72, stats.Armor: 453, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
 	{Name: "Gyro-Balanced Khorium Destroyer", ID: 32756, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 148.0, WeaponDamageMax: 275.0, SwingSpeed: 2.80, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 27}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Hailstone Band", ID: 23028, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 18, stats.Dodge: 12, stats.FrostResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Hailstone Pendant", ID: 35511, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 33, stats.MeleeHit: 19, stats.Defense: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Halaani Claymore", ID: 30597, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 231.0, WeaponDamageMax: 347.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 28, stats.Stamina: 42, stats.MeleeCrit: 27}, SocketBonus: stats.Stats{}},
-	{Name: "Halberd of Desolation", ID: 32248, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 365.0, WeaponDamageMax: 548.0, SwingSpeed: 3.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 51, stats.Stamina: 57, stats.AttackPower: 100, stats.MeleeHit: 30, stats.RangedAttackPower: 100}, SocketBonus: stats.Stats{}},
+	{Name: "Halberd of Desolation", ID: 32248, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 365.0, WeaponDamageMax: 548.0, SwingSpeed: 3.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 51, stats.Stamina: 57, stats.AttackPower: 100, stats.MeleeHit: 30, stats.RangedAttackPower: 100}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Hallowed Crown", ID: 28413, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 24, stats.Spirit: 26, stats.SpellPower: 19, stats.HealingPower: 76, stats.Armor: 127}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Spirit: 4}, SetName: "Hallowed Raiment"},
 	{Name: "Hallowed Garments", ID: 28230, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 26, stats.Spirit: 26, stats.SpellPower: 19, stats.HealingPower: 76, stats.Armor: 156}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}, SetName: "Hallowed Raiment"},
 	{Name: "Hallowed Handwraps", ID: 27536, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 20, stats.Spirit: 26, stats.SpellPower: 17, stats.HealingPower: 68, stats.Armor: 97}, SocketBonus: stats.Stats{}, SetName: "Hallowed Raiment"},
 	{Name: "Hallowed Pauldrons", ID: 27775, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 17, stats.Spirit: 20, stats.SpellPower: 14, stats.HealingPower: 56, stats.Armor: 117}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 3}, SetName: "Hallowed Raiment"},
 	{Name: "Hallowed Trousers", ID: 27875, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 33, stats.Spirit: 18, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 7, stats.Armor: 136}, SocketBonus: stats.Stats{}, SetName: "Hallowed Raiment"},
 	{Name: "Halo of Transcendence", ID: 16921, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 27, stats.Spirit: 22, stats.SpellPower: 16, stats.HealingPower: 64, stats.Armor: 107, stats.FireResistance: 10, stats.FrostResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Vestments of Transcendence"},
-	{Name: "Hammer of Atonement", ID: 30918, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 20.0, WeaponDamageMax: 129.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 21, stats.SpellPower: 148, stats.HealingPower: 591, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Hammer of Atonement", ID: 30918, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 20.0, WeaponDamageMax: 129.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 21, stats.SpellPower: 148, stats.HealingPower: 591, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Hammer of Judgement", ID: 34009, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 20.0, WeaponDamageMax: 129.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 22, stats.SpellPower: 236, stats.HealingPower: 236, stats.SpellHit: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Hammer of Righteous Might", ID: 32854, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 94.0, WeaponDamageMax: 186.0, SwingSpeed: 2.10, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, QualityModifier: -42.000, Stats: stats.Stats{stats.Stamina: 55, stats.Intellect: 37, stats.SpellPower: 168, stats.HealingPower: 168, stats.MP5: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Hammer of Sanctification", ID: 34335, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 13.0, WeaponDamageMax: 137.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, QualityModifier: -73.000, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 25, stats.SpellPower: 183, stats.HealingPower: 733, stats.MP5: 9, stats.SpellHaste: 23}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Hammer of the Naaru", ID: 28800, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 345.0, WeaponDamageMax: 518.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 44, stats.Stamina: 41, stats.Intellect: 37}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 4}},
+	{Name: "Hammer of the Naaru", ID: 28800, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 345.0, WeaponDamageMax: 518.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 44, stats.Stamina: 41, stats.Intellect: 37}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 4}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Hammer of the Penitent", ID: 28257, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Intellect: 16, stats.Spirit: 13, stats.SpellPower: 76, stats.HealingPower: 303, stats.MP5: 6}, SocketBonus: stats.Stats{}},
 	{Name: "Hammer of the Sun", ID: 23362, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 104.0, WeaponDamageMax: 194.0, SwingSpeed: 2.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 90, Stats: stats.Stats{stats.Strength: 4, stats.Agility: 4, stats.Stamina: 4, stats.MeleeCrit: 14, stats.Armor: 80, stats.FireResistance: 10, stats.ShadowResistance: 10}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{}},
 	{Name: "Hammer of the Twisting Nether", ID: 23056, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 37.0, WeaponDamageMax: 121.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 89, QualityModifier: -31.700, Stats: stats.Stats{stats.Stamina: 8, stats.Intellect: 11, stats.SpellPower: 80, stats.HealingPower: 318, stats.MP5: 8}, SocketBonus: stats.Stats{}},
@@ -2012,11 +2012,11 @@ var Items = []Item{
 	{Name: "Handguards of the Templar", ID: 33519, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 28, stats.SpellPower: 25, stats.HealingPower: 100, stats.MP5: 4, stats.SpellHaste: 25, stats.Armor: 1005}, SocketBonus: stats.Stats{}},
 	{Name: "Hands of Eternal Light", ID: 34367, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 30, stats.Spirit: 37, stats.SpellPower: 33, stats.HealingPower: 132, stats.Armor: 166}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 3}},
 	{Name: "Hands of the Sun", ID: 27764, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.FireSpellPower: 34, stats.SpellCrit: 21, stats.Armor: 97}, SocketBonus: stats.Stats{}},
-	{Name: "Handwraps of Flowing Thought", ID: 28507, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 14, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellHit: 3}},
+	{Name: "Handwraps of Flowing Thought", ID: 28507, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 14, stats.Armor: 121}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellHit: 3}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Handwraps of the Aggressor", ID: 34911, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 30, stats.Agility: 35, stats.Stamina: 36, stats.Intellect: 19, stats.MeleeHaste: 13, stats.Armor: 473}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 2}},
 	{Name: "Handwraps of the Incarnate", ID: 29055, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 26, stats.Spirit: 18, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 10, stats.Armor: 126}, SocketBonus: stats.Stats{}, SetName: "Incarnate Raiment"},
 	{Name: "Haramad's Bargain", ID: 29119, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Strength: 26, stats.Agility: 25}, SocketBonus: stats.Stats{}},
-	{Name: "Harbinger Bands", ID: 28477, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 21, stats.Spirit: 14, stats.SpellPower: 26, stats.HealingPower: 26, stats.Armor: 85}, SocketBonus: stats.Stats{}},
+	{Name: "Harbinger Bands", ID: 28477, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 21, stats.Spirit: 14, stats.SpellPower: 26, stats.HealingPower: 26, stats.Armor: 85}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Harbinger of Doom", ID: 23044, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 83.0, WeaponDamageMax: 126.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Agility: 8, stats.Stamina: 8, stats.MeleeHit: 10, stats.MeleeCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Hard Khorium Band", ID: 34361, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 159, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 42, stats.AttackPower: 58, stats.MeleeHaste: 28, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
 	{Name: "Hard Khorium Battlefists", ID: 34378, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Strength: 45, stats.Stamina: 45, stats.MeleeHit: 23, stats.MeleeHaste: 36, stats.Armor: 1239}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
@@ -2027,7 +2027,7 @@ var Items = []Item{
 	{Name: "Harness of Carnal Instinct", ID: 34211, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Strength: 52, stats.Agility: 44, stats.Stamina: 64, stats.Intellect: 22, stats.ArmorPenetration: 196, stats.Armor: 807}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Harness of the Deep Currents", ID: 27912, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 30, stats.SpellPower: 23, stats.HealingPower: 91, stats.MP5: 10, stats.Armor: 652}, SocketBonus: stats.Stats{}},
 	{Name: "Hatchet of Sundered Bone", ID: 22816, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 119.0, WeaponDamageMax: 221.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.AttackPower: 36, stats.MeleeCrit: 14, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
-	{Name: "Hatefury Mantle", ID: 30884, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 18, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 24, stats.SpellPenetration: 23, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}},
+	{Name: "Hatefury Mantle", ID: 30884, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 18, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 24, stats.SpellPenetration: 23, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Hauberk of Desolation", ID: 28401, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.AttackPower: 50, stats.MeleeCrit: 16, stats.Armor: 652, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SetName: "Desolation Battlegear"},
 	{Name: "Hauberk of Karabor", ID: 30933, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 18, stats.AttackPower: 90, stats.MeleeHit: 12, stats.Armor: 278, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}},
 	{Name: "Hauberk of Whirling Fury", ID: 34933, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 34, stats.SpellPower: 61, stats.HealingPower: 61, stats.SpellHaste: 51, stats.Armor: 988}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
@@ -2035,14 +2035,14 @@ var Items = []Item{
 	{Name: "Hauberk of the Furious Elements", ID: 33965, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 34, stats.SpellPower: 54, stats.HealingPower: 54, stats.SpellCrit: 23, stats.SpellHaste: 35, stats.Armor: 900}, SocketBonus: stats.Stats{}},
 	{Name: "Hauberk of the War Bringer", ID: 34799, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 25, stats.Intellect: 17, stats.AttackPower: 76, stats.MeleeHaste: 20, stats.Armor: 778, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
 	{Name: "Headdress of Alacrity", ID: 27466, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 33, stats.Spirit: 18, stats.SpellPower: 33, stats.HealingPower: 33, stats.SpellCrit: 17, stats.Armor: 127}, SocketBonus: stats.Stats{}},
-	{Name: "Headdress of the High Potentate", ID: 28756, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 38, stats.Spirit: 32, stats.SpellPower: 27, stats.HealingPower: 108, stats.Armor: 158}, SocketBonus: stats.Stats{}},
+	{Name: "Headdress of the High Potentate", ID: 28756, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 38, stats.Spirit: 32, stats.SpellPower: 27, stats.HealingPower: 108, stats.Armor: 158}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Headdress of the Tides", ID: 27759, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 35, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 9, stats.Armor: 530}, SocketBonus: stats.Stats{}},
 	{Name: "Heap Leggings", ID: 30268, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.Spirit: 25, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellHit: 15, stats.Armor: 117}, SocketBonus: stats.Stats{}},
 	{Name: "Heart of the Pit", ID: 34179, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 21, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHaste: 32}, SocketBonus: stats.Stats{}},
-	{Name: "Heart-Flame Leggings", ID: 28751, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 33, stats.SpellPower: 30, stats.HealingPower: 120, stats.Armor: 710}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 4}},
+	{Name: "Heart-Flame Leggings", ID: 28751, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 33, stats.SpellPower: 30, stats.HealingPower: 120, stats.Armor: 710}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 4}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Heartless", ID: 33388, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 174.0, WeaponDamageMax: 323.0, SwingSpeed: 2.60, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 42, stats.MeleeHaste: 20, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
-	{Name: "Heartrazor", ID: 29962, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 121.0, WeaponDamageMax: 226.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Heartshatter Breastplate", ID: 32365, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 63, stats.Stamina: 45, stats.MeleeHit: 30, stats.MeleeCrit: 44, stats.Armor: 1765}, SocketBonus: stats.Stats{}},
+	{Name: "Heartrazor", ID: 29962, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 121.0, WeaponDamageMax: 226.0, SwingSpeed: 1.80, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 30}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
+	{Name: "Heartshatter Breastplate", ID: 32365, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 63, stats.Stamina: 45, stats.MeleeHit: 30, stats.MeleeCrit: 44, stats.Armor: 1765}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Mother Shahraz"},
 	{Name: "Heartstriker", ID: 19350, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 97.0, WeaponDamageMax: 180.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 9, stats.AttackPower: 24, stats.RangedAttackPower: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Heavenly Inspiration", ID: 30293, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 111, Stats: stats.Stats{stats.MP5: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Heavy Clefthoof Boots", ID: 25691, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 113, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 394, stats.Defense: 21}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}, SetName: "Strength of the Clefthoof"},
@@ -2059,11 +2059,11 @@ var Items = []Item{
 	{Name: "Helm of Desolation", ID: 28192, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 25, stats.AttackPower: 66, stats.MeleeCrit: 14, stats.Armor: 530, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MeleeHit: 4}, SetName: "Desolation Battlegear"},
 	{Name: "Helm of Natural Regeneration", ID: 33356, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 39, stats.Spirit: 30, stats.SpellPower: 29, stats.HealingPower: 115, stats.Armor: 328}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
 	{Name: "Helm of Sanctification", ID: 34795, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 10, stats.Armor: 946}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
-	{Name: "Helm of Soothing Currents", ID: 32241, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 10, stats.Armor: 803}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
+	{Name: "Helm of Soothing Currents", ID: 32241, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 10, stats.Armor: 803}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Helm of Uther's Resolve", ID: 34401, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 91, stats.Intellect: 20, stats.SpellPower: 39, stats.HealingPower: 39, stats.Armor: 1611, stats.Defense: 32, stats.BlockValue: 38, stats.Dodge: 41}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
 	{Name: "Helm of Wrath", ID: 16963, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 40, stats.Armor: 795, stats.Defense: 16, stats.FrostResistance: 10, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Battlegear of Wrath"},
 	{Name: "Helm of the Claw", ID: 28182, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 21, stats.AttackPower: 66, stats.MeleeHit: 14, stats.Armor: 237, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Resilience: 4}},
-	{Name: "Helm of the Illidari Shatterer", ID: 32373, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 51, stats.Stamina: 29, stats.MeleeHit: 34, stats.MeleeCrit: 42, stats.Armor: 1434}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Helm of the Illidari Shatterer", ID: 32373, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 51, stats.Stamina: 29, stats.MeleeHit: 34, stats.MeleeCrit: 42, stats.Armor: 1434}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Black Temple"},
 	{Name: "Helm of the Righteous", ID: 28285, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 20, stats.SpellPower: 23, stats.HealingPower: 23, stats.MP5: 6, stats.Armor: 946, stats.Defense: 21}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Intellect: 4}, SetName: "Righteous Armor"},
 	{Name: "Helm of the Stalwart Defender", ID: 23535, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 47, stats.Armor: 1366, stats.Defense: 23, stats.Resilience: 23}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 4}},
 	{Name: "Helm of the Stormcaller", ID: 33964, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 38, stats.SpellPower: 53, stats.HealingPower: 53, stats.SpellCrit: 22, stats.Armor: 731}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
@@ -2219,7 +2219,7 @@ var Items = []Item{
 	{Name: "Hortus' Seal of Brilliance", ID: 27534, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 20, stats.SpellPower: 23, stats.HealingPower: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Hotshot Cattle Prod", ID: 30523, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 125.0, WeaponDamageMax: 234.0, SwingSpeed: 1.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 5, stats.SpellPower: 14, stats.HealingPower: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Hourglass of the Unraveller", ID: 28034, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{stats.MeleeCrit: 32}, SocketBonus: stats.Stats{}},
-	{Name: "Howling Wind Bracers", ID: 30869, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 21, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 8, stats.Armor: 432}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
+	{Name: "Howling Wind Bracers", ID: 30869, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 21, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 8, stats.Armor: 432}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Huhuran's Stinger", ID: 21616, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 105.0, WeaponDamageMax: 196.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Agility: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Hungarhide Gauntlets", ID: 27745, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 21, stats.Intellect: 20, stats.AttackPower: 44, stats.Armor: 407, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
 	{Name: "Hungering Spineripper", ID: 28416, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 103.0, WeaponDamageMax: 155.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 30, stats.MeleeCrit: 16, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
@@ -2240,7 +2240,7 @@ var Items = []Item{
 	{Name: "Iceguard Helm", ID: 31371, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 64, stats.Armor: 1030, stats.Defense: 20, stats.FrostResistance: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Iceguard Leggings", ID: 31370, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 55, stats.Armor: 1110, stats.FrostResistance: 60}, SocketBonus: stats.Stats{}},
 	{Name: "Icon of Unyielding Courage", ID: 28121, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.MeleeHit: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Icon of the Silver Crescent", ID: 29370, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.SpellPower: 43, stats.HealingPower: 43}, SocketBonus: stats.Stats{}},
+	{Name: "Icon of the Silver Crescent", ID: 29370, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.SpellPower: 43, stats.HealingPower: 43}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 41},
 	{Name: "Icy Scale Bracers", ID: 22665, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 80, Stats: stats.Stats{stats.Stamina: 17, stats.AttackPower: 32, stats.Armor: 253, stats.RangedAttackPower: 32, stats.FrostResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Icy Scale Breastplate", ID: 22664, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 80, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 40, stats.Armor: 578, stats.RangedAttackPower: 40, stats.FrostResistance: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Icy Scale Coif", ID: 23033, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 24, stats.Armor: 486, stats.FrostResistance: 44}, SocketBonus: stats.Stats{}},
@@ -2255,16 +2255,16 @@ var Items = []Item{
 	{Name: "Idol of Terror", ID: 33509, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Idol of Ursoc", ID: 27744, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Idol of the Avenger", ID: 31025, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 106, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Idol of the Avian Heart", ID: 28568, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Idol of the Crescent Goddess", ID: 30051, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Idol of the Avian Heart", ID: 28568, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
+	{Name: "Idol of the Crescent Goddess", ID: 30051, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Idol of the Emerald Queen", ID: 27886, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Idol of the Raven Goddess", ID: 32387, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 2, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Idol of the Unseen Moon", ID: 33510, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Idol of the White Stag", ID: 32257, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Idol of the White Stag", ID: 32257, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Illidari Lord's Tunic", ID: 32869, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 24, stats.AttackPower: 44, stats.Armor: 263, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Illidari Rod of Discipline", ID: 32872, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 107.0, WeaponDamageMax: 199.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 9, stats.SpellPower: 13, stats.HealingPower: 13}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Illidari Runeshield", ID: 34011, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 27, stats.SpellPower: 34, stats.HealingPower: 34, stats.Armor: 5930, stats.BlockValue: 160}, SocketBonus: stats.Stats{}},
-	{Name: "Illidari Shoulderpads", ID: 30079, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 23, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 16, stats.Armor: 161}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
+	{Name: "Illidari Shoulderpads", ID: 30079, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 23, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 16, stats.Armor: 161}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
 	{Name: "Illidari-Bane Broadsword", ID: 30788, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 135.0, WeaponDamageMax: 252.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Illidari-Bane Claymore", ID: 30789, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 276.0, WeaponDamageMax: 414.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.MeleeCrit: 37}, SocketBonus: stats.Stats{}},
 	{Name: "Illidari-Bane Dagger", ID: 31745, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 90.0, WeaponDamageMax: 168.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
@@ -2300,23 +2300,23 @@ var Items = []Item{
 	{Name: "Infinity Blade", ID: 30312, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 171.0, WeaponDamageMax: 318.0, SwingSpeed: 2.00, Phase: 0, Quality: proto.ItemQuality_ItemQualityLegendary, Unique: true, Ilvl: 175, Stats: stats.Stats{stats.Stamina: 35, stats.AttackPower: 70, stats.MeleeCrit: 25, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
 	{Name: "Inkling's Leggings", ID: 31519, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 37, stats.Stamina: 22, stats.MeleeCrit: 21, stats.Armor: 872}, SocketBonus: stats.Stats{}},
 	{Name: "Inscribed Legplates of the Aldor", ID: 34946, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 78, stats.SpellPower: 37, stats.HealingPower: 37, stats.Armor: 1544, stats.Defense: 25, stats.Dodge: 43}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
-	{Name: "Insidious Bands", ID: 32324, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 28, stats.AttackPower: 58, stats.MeleeHit: 12, stats.Armor: 194, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 2}},
+	{Name: "Insidious Bands", ID: 32324, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 28, stats.AttackPower: 58, stats.MeleeHit: 12, stats.Armor: 194, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 2}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
 	{Name: "Inuuro's Blade", ID: 34672, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 80.0, WeaponDamageMax: 121.0, SwingSpeed: 1.40, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.MeleeHit: 8, stats.Expertise: 11, stats.Defense: 13}, SocketBonus: stats.Stats{}},
-	{Name: "Iron Gauntlets of the Maiden", ID: 28518, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 39, stats.Armor: 906, stats.Defense: 16, stats.Block: 17, stats.BlockValue: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Block: 4}},
+	{Name: "Iron Gauntlets of the Maiden", ID: 28518, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 39, stats.Armor: 906, stats.Defense: 16, stats.Block: 17, stats.BlockValue: 38}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Block: 4}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Iron Oak Shield", ID: 31415, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Strength: 21, stats.Stamina: 16, stats.Armor: 3278, stats.BlockValue: 89}, SocketBonus: stats.Stats{}},
 	{Name: "Iron-tusk Girdle", ID: 33279, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.Armor: 904, stats.Defense: 21, stats.Dodge: 33}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 3}},
 	{Name: "Irondrake Faceguard", ID: 27455, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 39, stats.Agility: 32, stats.Stamina: 37, stats.Armor: 946}, SocketBonus: stats.Stats{}},
 	{Name: "Ironscale War Cloak", ID: 27550, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 26, stats.Armor: 78, stats.Block: 19, stats.BlockValue: 29}, SocketBonus: stats.Stats{}},
-	{Name: "Ironstriders of Urgency", ID: 28608, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 33, stats.Agility: 20, stats.Stamina: 28, stats.Armor: 997}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
+	{Name: "Ironstriders of Urgency", ID: 28608, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 33, stats.Agility: 20, stats.Stamina: 28, stats.Armor: 997}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Ivory Idol of the Moongoddess", ID: 27518, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeIdol, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Jade Pendant of Blasting", ID: 20966, Type: proto.ItemType_ItemTypeNeck, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 36, Stats: stats.Stats{stats.Intellect: 3, stats.SpellPower: 8, stats.HealingPower: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Jade Ring of the Everliving", ID: 28763, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 23, stats.HealingPower: 91, stats.MP5: 12}, SocketBonus: stats.Stats{}},
+	{Name: "Jade Ring of the Everliving", ID: 28763, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 23, stats.HealingPower: 91, stats.MP5: 12}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Jade-Skull Breastplate", ID: 28262, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 30, stats.Stamina: 50, stats.Armor: 1164, stats.Defense: 25, stats.Block: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Jaded Crystal Dagger", ID: 34604, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 27.0, WeaponDamageMax: 122.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 19, stats.SpellPower: 185, stats.HealingPower: 185, stats.SpellHaste: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Jaedenfire Gloves of Annihilation", ID: 27889, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 25, stats.ShadowSpellPower: 39, stats.Armor: 95}, SocketBonus: stats.Stats{}},
 	{Name: "Jagged Bark Pendant", ID: 28343, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 15, stats.AttackPower: 30, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Jewel of Charismatic Mystique", ID: 27900, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Jewel of Infinite Possibilities", ID: 28734, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 18, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellHit: 21}, SocketBonus: stats.Stats{}},
+	{Name: "Jewel of Infinite Possibilities", ID: 28734, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 18, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellHit: 21}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Jeweled Boots of Sanctification", ID: 27525, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 25, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 6, stats.Armor: 105}, SocketBonus: stats.Stats{}},
 	{Name: "Jeweled Halberd", ID: 30396, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 173.0, WeaponDamageMax: 260.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 21, stats.Intellect: 16, stats.AttackPower: 78, stats.RangedAttackPower: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Jeweled Rod", ID: 32831, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 152.0, WeaponDamageMax: 284.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 18, stats.SpellPower: 15, stats.HealingPower: 15}, SocketBonus: stats.Stats{}},
@@ -2358,12 +2358,12 @@ var Items = []Item{
 	{Name: "K'iru's Presage", ID: 34671, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 45.0, WeaponDamageMax: 145.0, SwingSpeed: 2.30, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 13, stats.Spirit: 8, stats.SpellPower: 76, stats.HealingPower: 304, stats.MP5: 6}, SocketBonus: stats.Stats{}},
 	{Name: "Kalimdor's Revenge", ID: 21679, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 209.0, WeaponDamageMax: 315.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Strength: 24, stats.Stamina: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Kamaei's Cerulean Skirt", ID: 31343, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Intellect: 25, stats.Spirit: 21, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 149}, SocketBonus: stats.Stats{}},
-	{Name: "Karaborian Talisman", ID: 28781, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 23, stats.SpellPower: 35, stats.HealingPower: 35}, SocketBonus: stats.Stats{}},
+	{Name: "Karaborian Talisman", ID: 28781, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 23, stats.SpellPower: 35, stats.HealingPower: 35}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Karja's Medallion", ID: 30377, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Intellect: 15, stats.Spirit: 10, stats.SpellPower: 19, stats.HealingPower: 74}, SocketBonus: stats.Stats{}},
 	{Name: "Karrog's Shard", ID: 32533, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellCrit: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Kaylaan's Signet", ID: 30860, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 50, stats.MeleeHit: 10, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Kaylaan's Spaulders", ID: 30381, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 27, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 787, stats.Defense: 18}, SocketBonus: stats.Stats{}},
-	{Name: "Kaz'rogal's Hardened Heart", ID: 30889, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 43, stats.MeleeHit: 21, stats.Armor: 5930, stats.Defense: 28, stats.BlockValue: 160}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "Kaz'rogal's Hardened Heart", ID: 30889, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 43, stats.MeleeHit: 21, stats.Armor: 5930, stats.Defense: 28, stats.BlockValue: 160}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Keeper's Ring of Piety", ID: 29322, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Spirit: 18, stats.SpellPower: 14, stats.HealingPower: 56, stats.MP5: 7}, SocketBonus: stats.Stats{}},
 	{Name: "Khadgar's Kilt of Abjuration", ID: 28185, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 22, stats.Spirit: 15, stats.SpellPower: 36, stats.HealingPower: 36, stats.Armor: 133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Khadgar's Knapsack", ID: 29273, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.SpellPower: 49, stats.HealingPower: 49}, SocketBonus: stats.Stats{}},
@@ -2377,9 +2377,9 @@ var Items = []Item{
 	{Name: "Khorium Champion", ID: 23541, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 286.0, WeaponDamageMax: 430.0, SwingSpeed: 3.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 50, stats.MeleeHit: 37}, SocketBonus: stats.Stats{}},
 	{Name: "Khorium Inferno Band", ID: 24082, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 113, Stats: stats.Stats{stats.Stamina: 27, stats.FireSpellPower: 27, stats.MP5: 7}, SocketBonus: stats.Stats{}},
 	{Name: "Khorium Pants", ID: 23523, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 28, stats.SpellPower: 33, stats.HealingPower: 33, stats.MP5: 11, stats.Armor: 1010}, SocketBonus: stats.Stats{}, SetName: "Khorium Ward"},
-	{Name: "Kilt of Immortal Nature", ID: 32271, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 10, stats.Armor: 388}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
+	{Name: "Kilt of Immortal Nature", ID: 32271, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 37, stats.HealingPower: 155, stats.MP5: 10, stats.Armor: 388}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Kilt of Spiritual Reconstruction", ID: 34383, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.SpellPower: 45, stats.HealingPower: 179, stats.MP5: 14, stats.SpellHaste: 30, stats.Armor: 971}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
-	{Name: "King's Defender", ID: 28749, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 98.0, WeaponDamageMax: 182.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.MeleeHit: 17, stats.Armor: 182, stats.Defense: 13}, SocketBonus: stats.Stats{}},
+	{Name: "King's Defender", ID: 28749, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 98.0, WeaponDamageMax: 182.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.MeleeHit: 17, stats.Armor: 182, stats.Defense: 13}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Kingsfall", ID: 22802, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 105.0, WeaponDamageMax: 158.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 89, Stats: stats.Stats{stats.Agility: 16, stats.MeleeHit: 10, stats.MeleeCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Kirin Tor Apprentice's Robes", ID: 29780, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 26, stats.Spirit: 17, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellHit: 14, stats.Armor: 148}, SocketBonus: stats.Stats{}},
 	{Name: "Kirin Tor Master's Trousers", ID: 30532, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 29, stats.Spirit: 25, stats.SpellPower: 36, stats.HealingPower: 36, stats.Armor: 163}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellHit: 4}},
@@ -2394,7 +2394,7 @@ var Items = []Item{
 	{Name: "Kodohide Robe", ID: 35365, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 17, stats.SpellPower: 14, stats.HealingPower: 56, stats.MP5: 5, stats.Armor: 322, stats.Resilience: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 4}, SetName: "Kodohide Battlegear"},
 	{Name: "Kodohide Spaulders", ID: 35364, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 16, stats.SpellPower: 9, stats.HealingPower: 35, stats.MP5: 2, stats.Armor: 249, stats.Resilience: 17}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Kodohide Battlegear"},
 	{Name: "Kodohide Spaulders", ID: 35470, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 16, stats.SpellPower: 9, stats.HealingPower: 35, stats.MP5: 2, stats.Armor: 249, stats.Resilience: 17}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Kodohide Battlegear"},
-	{Name: "Krakken-Heart Breastplate", ID: 30102, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Strength: 61, stats.Agility: 43, stats.Stamina: 42, stats.MeleeHit: 30, stats.Armor: 1728}, SocketBonus: stats.Stats{}},
+	{Name: "Krakken-Heart Breastplate", ID: 30102, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Strength: 61, stats.Agility: 43, stats.Stamina: 42, stats.MeleeHit: 30, stats.Armor: 1728}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Kurenai Kilt", ID: 29142, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 11, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 18, stats.Armor: 256}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Lamp of Peaceful Radiance", ID: 28412, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 14, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellHit: 12, stats.SpellCrit: 13}, SocketBonus: stats.Stats{}},
 	{Name: "Lamp of Peaceful Repose", ID: 28387, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 18, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 6}, SocketBonus: stats.Stats{}},
@@ -2407,20 +2407,20 @@ var Items = []Item{
 	{Name: "Leafbeard Ring", ID: 31527, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.Agility: 12, stats.AttackPower: 38, stats.MeleeHit: 12, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Leather Chestguard of the Sun", ID: 34371, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.Spirit: 33, stats.SpellPower: 45, stats.HealingPower: 179, stats.MP5: 13, stats.Armor: 499}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Leather Gauntlets of the Sun", ID: 34372, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.Spirit: 30, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 38, stats.Armor: 312}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 3}},
-	{Name: "Legacy", ID: 28587, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 319.0, WeaponDamageMax: 479.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 46, stats.MP5: 8, stats.AttackPower: 80, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}},
+	{Name: "Legacy", ID: 28587, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 319.0, WeaponDamageMax: 479.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 46, stats.MP5: 8, stats.AttackPower: 80, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan"},
 	{Name: "Leggings of Absolution", ID: 31067, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 41, stats.Spirit: 28, stats.SpellPower: 62, stats.HealingPower: 62, stats.MP5: 8, stats.SpellCrit: 28, stats.Armor: 214}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Absolution Regalia"},
 	{Name: "Leggings of Apocalypse", ID: 23071, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Strength: 15, stats.Agility: 31, stats.Stamina: 23, stats.MeleeCrit: 28, stats.Armor: 241}, SocketBonus: stats.Stats{}},
 	{Name: "Leggings of Assassination", ID: 27908, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 33, stats.AttackPower: 44, stats.MeleeHit: 22, stats.Armor: 256, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SetName: "Assassination Armor"},
 	{Name: "Leggings of Beast Mastery", ID: 31328, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 45, stats.Armor: 621}, SocketBonus: stats.Stats{}},
 	{Name: "Leggings of Calamity", ID: 34181, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellCrit: 33, stats.SpellHaste: 32, stats.Armor: 232}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
-	{Name: "Leggings of Channeled Elements", ID: 30916, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 28, stats.Spirit: 28, stats.SpellPower: 59, stats.HealingPower: 59, stats.SpellHit: 18, stats.SpellCrit: 34, stats.Armor: 207}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
+	{Name: "Leggings of Channeled Elements", ID: 30916, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 28, stats.Spirit: 28, stats.SpellPower: 59, stats.HealingPower: 59, stats.SpellHit: 18, stats.SpellCrit: 34, stats.Armor: 207}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Leggings of Concentrated Power", ID: 30290, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 23, stats.Spirit: 23, stats.SpellPower: 27, stats.HealingPower: 27, stats.Armor: 225}, SocketBonus: stats.Stats{}},
-	{Name: "Leggings of Devastation", ID: 32367, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 60, stats.HealingPower: 60, stats.SpellHit: 26, stats.Armor: 207}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
-	{Name: "Leggings of Divine Retribution", ID: 32341, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 51, stats.Stamina: 51, stats.MeleeCrit: 35, stats.ArmorPenetration: 350, stats.Armor: 1544}, SocketBonus: stats.Stats{}},
+	{Name: "Leggings of Devastation", ID: 32367, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 60, stats.HealingPower: 60, stats.SpellHit: 26, stats.Armor: 207}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Black Temple", SourceDrop: "Mother Shahraz"},
+	{Name: "Leggings of Divine Retribution", ID: 32341, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 51, stats.Stamina: 51, stats.MeleeCrit: 35, stats.ArmorPenetration: 350, stats.Armor: 1544}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Leggings of Elemental Fury", ID: 23665, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 27, stats.SpellPower: 32, stats.HealingPower: 32, stats.SpellCrit: 28, stats.Armor: 535}, SocketBonus: stats.Stats{}},
-	{Name: "Leggings of Eternity", ID: 30912, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 38, stats.SpellPower: 41, stats.HealingPower: 162, stats.MP5: 16, stats.Armor: 221}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
+	{Name: "Leggings of Eternity", ID: 30912, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 38, stats.SpellPower: 41, stats.HealingPower: 162, stats.MP5: 16, stats.Armor: 221}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Leggings of Faith", ID: 22513, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.Spirit: 28, stats.SpellPower: 22, stats.HealingPower: 88, stats.Armor: 133}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
-	{Name: "Leggings of Murderous Intent", ID: 29995, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 31, stats.AttackPower: 92, stats.MeleeCrit: 37, stats.Armor: 380, stats.RangedAttackPower: 92}, SocketBonus: stats.Stats{}},
+	{Name: "Leggings of Murderous Intent", ID: 29995, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 31, stats.AttackPower: 92, stats.MeleeCrit: 37, stats.Armor: 380, stats.RangedAttackPower: 92}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Leggings of Polarity", ID: 23070, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 14, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 28, stats.Armor: 128}, SocketBonus: stats.Stats{}},
 	{Name: "Leggings of Tirisfal", ID: 30207, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 36, stats.Spirit: 26, stats.SpellPower: 54, stats.HealingPower: 54, stats.SpellHit: 26, stats.SpellCrit: 17, stats.Armor: 195}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellHit: 2}, SetName: "Tirisfal Regalia"},
 	{Name: "Leggings of Transcendence", ID: 16922, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 21, stats.Spirit: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 7, stats.Armor: 116, stats.ArcaneResistance: 10, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Vestments of Transcendence"},
@@ -2440,13 +2440,13 @@ var Items = []Item{
 	{Name: "Leggings of the Tempest", ID: 31058, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 47, stats.Spirit: 29, stats.SpellPower: 62, stats.HealingPower: 62, stats.SpellHit: 20, stats.SpellCrit: 29, stats.Armor: 214}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Tempest Regalia"},
 	{Name: "Leggings of the Unrepentant", ID: 27514, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 25, stats.AttackPower: 60, stats.Armor: 256, stats.RangedAttackPower: 60}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Legguards of Contemplation", ID: 32870, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 29, stats.AttackPower: 44, stats.Armor: 514, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
-	{Name: "Legguards of Endless Rage", ID: 30903, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Strength: 70, stats.Stamina: 61, stats.MeleeHit: 19, stats.MeleeCrit: 46, stats.Armor: 1650}, SocketBonus: stats.Stats{}},
+	{Name: "Legguards of Endless Rage", ID: 30903, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Strength: 70, stats.Stamina: 61, stats.MeleeHit: 19, stats.MeleeCrit: 46, stats.Armor: 1650}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Legguards of Malorne", ID: 29088, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 38, stats.Spirit: 26, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 10, stats.Armor: 332}, SocketBonus: stats.Stats{}, SetName: "Malorne Raiment"},
 	{Name: "Legguards of the Fallen Crusader", ID: 19402, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Strength: 28, stats.Agility: 22, stats.Stamina: 22, stats.Intellect: 17, stats.Armor: 845}, SocketBonus: stats.Stats{}},
 	{Name: "Legguards of the Resolute Defender", ID: 29783, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 42, stats.Armor: 942, stats.Defense: 31, stats.Parry: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Legguards of the Shattered Hand", ID: 31298, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 39, stats.Agility: 32, stats.MeleeHit: 24, stats.Armor: 1019}, SocketBonus: stats.Stats{}},
 	{Name: "Legionfoe", ID: 34673, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 276.0, WeaponDamageMax: 414.0, SwingSpeed: 3.70, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Strength: 31, stats.Stamina: 45, stats.MeleeCrit: 26, stats.Expertise: 19}, SocketBonus: stats.Stats{}},
-	{Name: "Legionkiller", ID: 32253, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 184.0, WeaponDamageMax: 342.0, SwingSpeed: 2.90, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 30}, SocketBonus: stats.Stats{}},
+	{Name: "Legionkiller", ID: 32253, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 184.0, WeaponDamageMax: 342.0, SwingSpeed: 2.90, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 30}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Legplates of Blazing Light", ID: 21667, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 9, stats.Stamina: 17, stats.Intellect: 23, stats.SpellPower: 23, stats.HealingPower: 91, stats.SpellCrit: 14, stats.Armor: 856}, SocketBonus: stats.Stats{}},
 	{Name: "Legplates of Carnage", ID: 23068, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Strength: 42, stats.Stamina: 18, stats.MeleeCrit: 28, stats.Armor: 930}, SocketBonus: stats.Stats{}},
 	{Name: "Legplates of Ten Storms", ID: 16946, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 18, stats.Spirit: 20, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellCrit: 14, stats.Armor: 482, stats.ArcaneResistance: 10, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "The Ten Storms"},
@@ -2454,23 +2454,23 @@ var Items = []Item{
 	{Name: "Legplates of Wrath", ID: 16962, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 19, stats.Stamina: 27, stats.Armor: 856, stats.Defense: 16, stats.Dodge: 24, stats.ArcaneResistance: 10, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Battlegear of Wrath"},
 	{Name: "Legplates of the Bold", ID: 27977, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 31, stats.Agility: 19, stats.Stamina: 45, stats.Armor: 1019, stats.Defense: 26}, SocketBonus: stats.Stats{}, SetName: "Bold Armor"},
 	{Name: "Legplates of the Holy Juggernaut", ID: 34167, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 49, stats.Intellect: 41, stats.SpellPower: 33, stats.HealingPower: 132, stats.MP5: 18, stats.SpellCrit: 37, stats.Armor: 1735}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
-	{Name: "Legplates of the Innocent", ID: 28748, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 32, stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 8, stats.SpellCrit: 21, stats.Armor: 1269}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 4}},
+	{Name: "Legplates of the Innocent", ID: 28748, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 32, stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 8, stats.SpellCrit: 21, stats.Armor: 1269}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Intellect: 4}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Legplates of the Righteous", ID: 27839, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 24, stats.SpellPower: 28, stats.HealingPower: 28, stats.MP5: 10, stats.Armor: 1019, stats.Defense: 26}, SocketBonus: stats.Stats{}, SetName: "Righteous Armor"},
 	{Name: "Legwraps of Sweltering Flame", ID: 34918, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 36, stats.Spirit: 30, stats.SpellPower: 62, stats.HealingPower: 62, stats.SpellHit: 25, stats.Armor: 207}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Legwraps of the Aldor", ID: 29078, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 40, stats.Spirit: 23, stats.SpellPower: 49, stats.HealingPower: 49, stats.SpellHit: 24, stats.Armor: 177}, SocketBonus: stats.Stats{}, SetName: "Aldor Regalia"},
 	{Name: "Leonine Breastplate", ID: 31549, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 45, stats.Stamina: 27, stats.Intellect: 16, stats.MP5: 7, stats.Armor: 1106}, SocketBonus: stats.Stats{}},
 	{Name: "Liar's Cord", ID: 28124, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 34, stats.MeleeCrit: 18, stats.Armor: 164, stats.RangedAttackPower: 34}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 3}},
-	{Name: "Liar's Tongue Gloves", ID: 28776, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 31, stats.AttackPower: 72, stats.MeleeCrit: 26, stats.Armor: 247, stats.RangedAttackPower: 72}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
-	{Name: "Libram of Absolute Truth", ID: 30063, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Liar's Tongue Gloves", ID: 28776, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 31, stats.AttackPower: 72, stats.MeleeCrit: 26, stats.Armor: 247, stats.RangedAttackPower: 72}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
+	{Name: "Libram of Absolute Truth", ID: 30063, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Libram of Avengement", ID: 27484, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Libram of Divine Judgement", ID: 33503, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Libram of Divine Purpose", ID: 33504, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Libram of Grace", ID: 22402, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Libram of Light", ID: 23006, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Libram of Mending", ID: 33502, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Libram of Repentance", ID: 29388, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Libram of Repentance", ID: 29388, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 15},
 	{Name: "Libram of Righteous Power", ID: 31033, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 106, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Libram of Souls Redeemed", ID: 28592, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Libram of Souls Redeemed", ID: 28592, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan"},
 	{Name: "Libram of the Eternal Rest", ID: 27917, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Libram of the Lightbringer", ID: 28296, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Lieutenant's Signet of Lordaeron", ID: 28211, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 143, stats.Dodge: 21}, SocketBonus: stats.Stats{}},
@@ -2484,7 +2484,7 @@ var Items = []Item{
 	{Name: "Lifegiving Gem", ID: 19341, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Lifewarden's Breastplate", ID: 29781, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 25, stats.Spirit: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 270}, SocketBonus: stats.Stats{}},
 	{Name: "Light Scribe Bands", ID: 27452, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 15, stats.Spirit: 22, stats.SpellPower: 10, stats.HealingPower: 39, stats.Armor: 68}, SocketBonus: stats.Stats{}},
-	{Name: "Light's Justice", ID: 28771, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 25.0, WeaponDamageMax: 125.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 21, stats.Spirit: 20, stats.SpellPower: 128, stats.HealingPower: 510}, SocketBonus: stats.Stats{}},
+	{Name: "Light's Justice", ID: 28771, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 25.0, WeaponDamageMax: 125.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 21, stats.Spirit: 20, stats.SpellPower: 128, stats.HealingPower: 510}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Light-Bearer's Faith Shield", ID: 29267, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 22, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 4668, stats.BlockValue: 115}, SocketBonus: stats.Stats{}},
 	{Name: "Light-Blessed Bonds", ID: 33587, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 22, stats.Spirit: 25, stats.SpellPower: 25, stats.HealingPower: 100, stats.Armor: 135}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
 	{Name: "Light-Collar of the Incarnate", ID: 29049, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 34, stats.Spirit: 25, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 6, stats.Armor: 164}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SetName: "Incarnate Raiment"},
@@ -2515,7 +2515,7 @@ var Items = []Item{
 	{Name: "Lightbringer Waistguard", ID: 34488, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 64, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 1081, stats.Defense: 28, stats.Block: 20, stats.Dodge: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 2}, SetName: "Lightbringer Armor"},
 	{Name: "Lightbringer War-Helm", ID: 30989, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Strength: 61, stats.Stamina: 60, stats.Intellect: 32, stats.MeleeCrit: 23, stats.Armor: 1483}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MeleeHit: 4}, SetName: "Lightbringer Battlegear"},
 	{Name: "Lightbringer Wristguards", ID: 34433, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 49, stats.SpellPower: 25, stats.HealingPower: 25, stats.Armor: 841, stats.Defense: 20, stats.Block: 15, stats.Dodge: 22}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Lightbringer Armor"},
-	{Name: "Lightfathom Scepter", ID: 30108, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 21.0, WeaponDamageMax: 136.0, SwingSpeed: 1.90, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 20, stats.SpellPower: 148, stats.HealingPower: 591, stats.MP5: 11}, SocketBonus: stats.Stats{}},
+	{Name: "Lightfathom Scepter", ID: 30108, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 21.0, WeaponDamageMax: 136.0, SwingSpeed: 1.90, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 20, stats.SpellPower: 148, stats.HealingPower: 591, stats.MP5: 11}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Lightning Crown", ID: 31330, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.NatureSpellPower: 66, stats.SpellCrit: 43, stats.Armor: 577}, SocketBonus: stats.Stats{}},
 	{Name: "Lightning Etched Specs", ID: 34355, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 47, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellHit: 25, stats.SpellCrit: 53, stats.Armor: 902}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Lightsworn Hammer", ID: 27538, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 11, stats.SpellPower: 76, stats.HealingPower: 303, stats.MP5: 8}, SocketBonus: stats.Stats{}},
@@ -2544,29 +2544,29 @@ var Items = []Item{
 	{Name: "Lost Chestplate of the Reverent", ID: 30296, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 996, stats.Defense: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Lower City Prayerbook", ID: 30841, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 24, stats.HealingPower: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Lucid Dream Bracers", ID: 27827, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 15, stats.Spirit: 17, stats.SpellPower: 13, stats.HealingPower: 50, stats.Armor: 128}, SocketBonus: stats.Stats{}},
-	{Name: "Luminescent Rod of the Naaru", ID: 30080, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 186.0, WeaponDamageMax: 346.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Intellect: 14, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 6}, SocketBonus: stats.Stats{}},
+	{Name: "Luminescent Rod of the Naaru", ID: 30080, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 186.0, WeaponDamageMax: 346.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Intellect: 14, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 6}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
 	{Name: "Lunar Crescent", ID: 28434, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 324.0, WeaponDamageMax: 487.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 107, Stats: stats.Stats{stats.AttackPower: 96, stats.MeleeCrit: 47, stats.RangedAttackPower: 96}, SocketBonus: stats.Stats{}},
 	{Name: "Lunar-Claw Pauldrons", ID: 28255, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.Spirit: 17, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 219}, SocketBonus: stats.Stats{}},
 	{Name: "Lurking Shadow Spaulders", ID: 25796, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 21, stats.AttackPower: 42, stats.MeleeHit: 21, stats.Armor: 198, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
-	{Name: "Madness of the Betrayer", ID: 32505, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 84, stats.MeleeHit: 20, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}},
+	{Name: "Madness of the Betrayer", ID: 32505, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 84, stats.MeleeHit: 20, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Maexxna's Fang", ID: 22804, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 94.0, WeaponDamageMax: 141.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 8, stats.AttackPower: 36, stats.MeleeHit: 10, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Mage's Mark of the Sha'tar", ID: 31382, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.SpellPower: 14, stats.HealingPower: 14, stats.SpellCrit: 29}, SocketBonus: stats.Stats{}},
 	{Name: "Mage-Collar of the Firestorm", ID: 27488, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 32, stats.Intellect: 33, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 23, stats.Armor: 127}, SocketBonus: stats.Stats{}},
 	{Name: "Mage-Fury Girdle", ID: 27742, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 20, stats.Armor: 88}, SocketBonus: stats.Stats{}},
 	{Name: "Magma Plume Boots", ID: 27845, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 26, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellHit: 14, stats.Armor: 448}, SocketBonus: stats.Stats{}},
 	{Name: "Magnified Moon Specs", ID: 32480, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellCrit: 41, stats.Armor: 326}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
-	{Name: "Mail of Fevered Pursuit", ID: 30907, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Agility: 49, stats.Stamina: 66, stats.MP5: 8, stats.AttackPower: 108, stats.MeleeCrit: 29, stats.Armor: 1055, stats.RangedAttackPower: 108}, SocketBonus: stats.Stats{}},
+	{Name: "Mail of Fevered Pursuit", ID: 30907, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Agility: 49, stats.Stamina: 66, stats.MP5: 8, stats.AttackPower: 108, stats.MeleeCrit: 29, stats.Armor: 1055, stats.RangedAttackPower: 108}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Maimfist's Choker", ID: 25809, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 27, stats.Defense: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Maladaar's Blessed Chaplet", ID: 27871, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Defense: 20, stats.Dodge: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Maladath, Runed Blade of the Black Flight", ID: 19351, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 86.0, WeaponDamageMax: 162.0, SwingSpeed: 2.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.Expertise: 9, stats.Parry: 20}, SocketBonus: stats.Stats{}},
-	{Name: "Malchazeen", ID: 28768, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 132.0, WeaponDamageMax: 199.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 16, stats.AttackPower: 50, stats.MeleeHit: 15, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
+	{Name: "Malchazeen", ID: 28768, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 132.0, WeaponDamageMax: 199.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 16, stats.AttackPower: 50, stats.MeleeHit: 15, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Malefactor's Eyepatch", ID: 31547, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 24, stats.Intellect: 18, stats.MP5: 7, stats.AttackPower: 60, stats.Armor: 503, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
-	{Name: "Malefic Girdle", ID: 28654, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 37, stats.HealingPower: 37, stats.SpellCrit: 21, stats.Armor: 109}, SocketBonus: stats.Stats{}},
-	{Name: "Malefic Mask of the Shadows", ID: 28796, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 45, stats.AttackPower: 84, stats.MeleeHit: 31, stats.MeleeCrit: 42, stats.Armor: 321, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}},
+	{Name: "Malefic Girdle", ID: 28654, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 26, stats.SpellPower: 37, stats.HealingPower: 37, stats.SpellCrit: 21, stats.Armor: 109}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
+	{Name: "Malefic Mask of the Shadows", ID: 28796, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 45, stats.AttackPower: 84, stats.MeleeHit: 31, stats.MeleeCrit: 42, stats.Armor: 321, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Malfurion's Blessed Bulwark", ID: 19405, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 22, stats.Armor: 424}, SocketBonus: stats.Stats{}},
 	{Name: "Malice Stone Pendant", ID: 22943, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 8, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellPenetration: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Malistar's Defender", ID: 17106, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 12, stats.MP5: 9, stats.Armor: 3244, stats.BlockValue: 68}, SocketBonus: stats.Stats{}},
-	{Name: "Mallet of the Tides", ID: 30058, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 115.0, WeaponDamageMax: 214.0, SwingSpeed: 1.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, Stats: stats.Stats{stats.Stamina: 34, stats.Expertise: 14, stats.Defense: 16}, SocketBonus: stats.Stats{}},
+	{Name: "Mallet of the Tides", ID: 30058, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 115.0, WeaponDamageMax: 214.0, SwingSpeed: 1.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, Stats: stats.Stats{stats.Stamina: 34, stats.Expertise: 14, stats.Defense: 16}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Mama's Insurance", ID: 30279, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 87.0, WeaponDamageMax: 163.0, SwingSpeed: 2.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 10, stats.AttackPower: 32, stats.MeleeCrit: 6, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Man'kin'do's Belt", ID: 33386, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 26, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 8, stats.SpellHaste: 30, stats.Armor: 506}, SocketBonus: stats.Stats{}},
 	{Name: "Mana Attuned Band", ID: 33497, Type: proto.ItemType_ItemTypeFinger, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Intellect: 19, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellHit: 18, stats.SpellHaste: 29}, SocketBonus: stats.Stats{}},
@@ -2581,14 +2581,14 @@ var Items = []Item{
 	{Name: "Mana-Sphere Shoulderguards", ID: 28374, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.Spirit: 17, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 117}, SocketBonus: stats.Stats{}},
 	{Name: "Manacles of Remembrance", ID: 25778, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 10, stats.Spirit: 9, stats.SpellPower: 16, stats.HealingPower: 16, stats.SpellCrit: 14, stats.Armor: 57}, SocketBonus: stats.Stats{}},
 	{Name: "Manastorm Band", ID: 30366, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Intellect: 15, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellCrit: 10}, SocketBonus: stats.Stats{}},
-	{Name: "Manasurge Pendant", ID: 29368, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 28, stats.HealingPower: 28}, SocketBonus: stats.Stats{}},
+	{Name: "Manasurge Pendant", ID: 29368, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 28, stats.HealingPower: 28}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Manaweave Cloak", ID: 24260, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 20, stats.Spirit: 21, stats.MP5: 8, stats.Armor: 89}, SocketBonus: stats.Stats{}},
 	{Name: "Manimal's Cinch", ID: 30942, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 23, stats.Agility: 12, stats.Stamina: 24, stats.Armor: 348}, SocketBonus: stats.Stats{}},
-	{Name: "Mantle of Abrahmis", ID: 28743, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 21, stats.Stamina: 43, stats.Armor: 1087, stats.Defense: 23}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 3}},
+	{Name: "Mantle of Abrahmis", ID: 28743, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 21, stats.Stamina: 43, stats.Armor: 1087, stats.Defense: 23}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 3}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Mantle of Absolution", ID: 31069, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 30, stats.Spirit: 19, stats.SpellPower: 29, stats.HealingPower: 115, stats.MP5: 8, stats.Armor: 183}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SetName: "Vestments of Absolution"},
 	{Name: "Mantle of Arcane Mastery", ID: 30287, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.Spirit: 19, stats.SpellPower: 21, stats.HealingPower: 21, stats.Armor: 103}, SocketBonus: stats.Stats{}},
 	{Name: "Mantle of Autumn", ID: 28340, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.Spirit: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.Armor: 219}, SocketBonus: stats.Stats{}},
-	{Name: "Mantle of Darkness", ID: 32377, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.AttackPower: 94, stats.MeleeHit: 22, stats.MeleeCrit: 33, stats.Armor: 333, stats.RangedAttackPower: 94}, SocketBonus: stats.Stats{}},
+	{Name: "Mantle of Darkness", ID: 32377, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.AttackPower: 94, stats.MeleeHit: 22, stats.MeleeCrit: 33, stats.Armor: 333, stats.RangedAttackPower: 94}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Mantle of Ill Intent", ID: 33489, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 24, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellHaste: 33, stats.Armor: 161}, SocketBonus: stats.Stats{}},
 	{Name: "Mantle of Malorne", ID: 29100, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 33, stats.Agility: 27, stats.Stamina: 25, stats.Intellect: 9, stats.Armor: 410}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SetName: "Malorne Harness"},
 	{Name: "Mantle of Nimble Thought", ID: 32587, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 26, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellHaste: 38, stats.Armor: 177}, SocketBonus: stats.Stats{}},
@@ -2605,18 +2605,18 @@ var Items = []Item{
 	{Name: "Mantle of the Elven Kings", ID: 30024, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 18, stats.Spirit: 17, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHit: 18, stats.SpellCrit: 25, stats.Armor: 161}, SocketBonus: stats.Stats{}},
 	{Name: "Mantle of the Golden Forest", ID: 34194, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 40, stats.AttackPower: 90, stats.ArmorPenetration: 154, stats.Armor: 832, stats.RangedAttackPower: 90}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 3}},
 	{Name: "Mantle of the Malefic", ID: 31054, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 22, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 21, stats.SpellCrit: 13, stats.Armor: 183}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SetName: "Malefic Raiment"},
-	{Name: "Mantle of the Mind Flayer", ID: 28726, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 29, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellPenetration: 23, stats.Armor: 146}, SocketBonus: stats.Stats{}},
+	{Name: "Mantle of the Mind Flayer", ID: 28726, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 29, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellPenetration: 23, stats.Armor: 146}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Mantle of the Oracle", ID: 21350, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 20, stats.Spirit: 8, stats.SpellPower: 20, stats.HealingPower: 20, stats.MP5: 3, stats.SpellPenetration: 10, stats.Armor: 132}, SocketBonus: stats.Stats{}, SetName: "Garments of the Oracle"},
 	{Name: "Mantle of the Sea Wolf", ID: 27826, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 25, stats.SpellPower: 17, stats.HealingPower: 68, stats.MP5: 8, stats.Armor: 489}, SocketBonus: stats.Stats{}},
 	{Name: "Mantle of the Tempest", ID: 31059, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 21, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellCrit: 21, stats.SpellPenetration: 18, stats.Armor: 183}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SetName: "Tempest Regalia"},
-	{Name: "Mantle of the Tireless Tracker", ID: 30085, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 22, stats.Intellect: 23, stats.AttackPower: 64, stats.MeleeCrit: 33, stats.Armor: 675, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
+	{Name: "Mantle of the Tireless Tracker", ID: 30085, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 22, stats.Intellect: 23, stats.AttackPower: 64, stats.MeleeCrit: 33, stats.Armor: 675, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
 	{Name: "Mantle of the Unforgiven", ID: 27831, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 52, stats.MeleeHit: 12, stats.MeleeCrit: 21, stats.Armor: 219, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
 	{Name: "Manual Crowd Pummeler", ID: 9449, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 46.0, WeaponDamageMax: 70.0, SwingSpeed: 2.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 34, Stats: stats.Stats{stats.Strength: 16, stats.Agility: 5}, SocketBonus: stats.Stats{}},
 	{Name: "Manual of the Nethermancer", ID: 28260, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 15, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellCrit: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Mark V's Throwing Star", ID: 30227, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 61.0, WeaponDamageMax: 115.0, SwingSpeed: 1.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 7, stats.Stamina: 10, stats.AttackPower: 14, stats.MeleeHit: 7, stats.RangedAttackPower: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Mark of C'Thun", ID: 22732, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 24, stats.MeleeHit: 10, stats.Defense: 15, stats.Dodge: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Mark of the Champion", ID: 23206, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Mark of the Champion", ID: 23207, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Mark of the Champion", ID: 23207, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Naxxramas", SourceDrop: "Kel'Thuzad"},
 	{Name: "Mark of the Ravenguard", ID: 29336, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 40, stats.Defense: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Marksman's Bow", ID: 29152, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 144.0, WeaponDamageMax: 269.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Agility: 12, stats.AttackPower: 22, stats.MeleeHit: 11, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Marshal's Chain Bracers", ID: 28973, ClassAllowlist: []proto.Class{proto.Class_ClassHunter, proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 113, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 25, stats.Intellect: 9, stats.AttackPower: 16, stats.MeleeCrit: 7, stats.Armor: 349, stats.RangedAttackPower: 16, stats.Resilience: 11}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 2}},
@@ -2671,7 +2671,7 @@ var Items = []Item{
 	{Name: "Mask of Veiled Death", ID: 31281, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.AttackPower: 80, stats.MeleeHit: 19, stats.MeleeCrit: 30, stats.Armor: 220, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}},
 	{Name: "Mask of the Deceiver", ID: 32087, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 32, stats.Stamina: 36, stats.AttackPower: 64, stats.MeleeHit: 16, stats.Armor: 283, stats.RangedAttackPower: 64}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Mask of the Fury Hunter", ID: 34404, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Strength: 50, stats.Agility: 50, stats.Stamina: 58, stats.Intellect: 20, stats.MeleeCrit: 30, stats.Armor: 643}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Stamina: 6}},
-	{Name: "Masquerade Gown", ID: 28578, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 32, stats.SpellPower: 30, stats.HealingPower: 118, stats.Armor: 194}, SocketBonus: stats.Stats{}},
+	{Name: "Masquerade Gown", ID: 28578, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 32, stats.SpellPower: 30, stats.HealingPower: 118, stats.Armor: 194}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Romulo and Julianne"},
 	{Name: "Master Assassin Wristwraps", ID: 33540, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 17, stats.Stamina: 22, stats.AttackPower: 50, stats.MeleeCrit: 18, stats.Armor: 177, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 2}},
 	{Name: "Master Dragonslayer's Medallion", ID: 19383, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 24, stats.Defense: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Master Dragonslayer's Orb", ID: 19366, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 14, stats.SpellPower: 28, stats.HealingPower: 28}, SocketBonus: stats.Stats{}},
@@ -2679,7 +2679,7 @@ var Items = []Item{
 	{Name: "Master Smith's Hammer", ID: 29787, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 108.0, WeaponDamageMax: 202.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 9, stats.Stamina: 7, stats.AttackPower: 20, stats.RangedAttackPower: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Master Thief's Gloves", ID: 29357, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 25, stats.AttackPower: 58, stats.Armor: 218, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
 	{Name: "Maul of the Redeemed Crusader", ID: 22809, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 244.0, WeaponDamageMax: 367.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 24, stats.SpellPower: 35, stats.HealingPower: 35, stats.MP5: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Maulgar's Warhelm", ID: 28801, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 43, stats.Intellect: 31, stats.AttackPower: 86, stats.MeleeCrit: 42, stats.Armor: 715, stats.RangedAttackPower: 86}, SocketBonus: stats.Stats{}},
+	{Name: "Maulgar's Warhelm", ID: 28801, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 43, stats.Intellect: 31, stats.AttackPower: 86, stats.MeleeCrit: 42, stats.Armor: 715, stats.RangedAttackPower: 86}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "High King Maulgar"},
 	{Name: "Mayhem Projection Goggles", ID: 34354, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Strength: 62, stats.Stamina: 47, stats.MeleeHit: 26, stats.MeleeCrit: 51, stats.Armor: 1611}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
 	{Name: "Mazthoril Honor Shield", ID: 29268, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 17, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 21, stats.Armor: 4668, stats.BlockValue: 115}, SocketBonus: stats.Stats{}},
 	{Name: "Mech Tech Shoulders", ID: 30275, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 23, stats.Stamina: 25, stats.MeleeHit: 9, stats.MeleeCrit: 12, stats.Armor: 747}, SocketBonus: stats.Stats{}},
@@ -2708,8 +2708,8 @@ var Items = []Item{
 	{Name: "Medallion of the Valiant Guardian", ID: 25803, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 27, stats.Defense: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Melia's Lustrous Crown", ID: 25570, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 22, stats.Spirit: 21, stats.SpellPower: 26, stats.HealingPower: 26, stats.Armor: 198}, SocketBonus: stats.Stats{}},
 	{Name: "Melmorta's Twilight Longbow", ID: 27987, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 135.0, WeaponDamageMax: 252.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 30, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Memento of Tyrande", ID: 32496, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.SpellPower: 37, stats.HealingPower: 155}, SocketBonus: stats.Stats{}},
-	{Name: "Mender's Heart-Ring", ID: 28661, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 21, stats.Spirit: 19, stats.SpellPower: 15, stats.HealingPower: 59}, SocketBonus: stats.Stats{}},
+	{Name: "Memento of Tyrande", ID: 32496, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.SpellPower: 37, stats.HealingPower: 155}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
+	{Name: "Mender's Heart-Ring", ID: 28661, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 21, stats.Spirit: 19, stats.SpellPower: 15, stats.HealingPower: 59}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Mennu's Scaled Leggings", ID: 27545, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.AttackPower: 46, stats.MeleeCrit: 32, stats.Armor: 256, stats.RangedAttackPower: 46}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 4}},
 	{Name: "Merciless Gladiator's Barrier", ID: 33313, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 136, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 5727, stats.BlockValue: 152, stats.Resilience: 27}, SocketBonus: stats.Stats{}},
 	{Name: "Merciless Gladiator's Bonecracker", ID: 31958, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 102.0, WeaponDamageMax: 191.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 136, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 30, stats.MeleeHit: 10, stats.MeleeCrit: 19, stats.RangedAttackPower: 30, stats.Resilience: 12}, SocketBonus: stats.Stats{}},
@@ -2832,9 +2832,9 @@ var Items = []Item{
 	{Name: "Merciless Gladiator's Wyrmhide Legguards", ID: 32058, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 136, Stats: stats.Stats{stats.Stamina: 51, stats.Intellect: 31, stats.SpellPower: 56, stats.HealingPower: 56, stats.MP5: 12, stats.Armor: 431, stats.Resilience: 31}, SocketBonus: stats.Stats{}, SetName: "Merciless Gladiator's Wildhide"},
 	{Name: "Merciless Gladiator's Wyrmhide Spaulders", ID: 32059, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 136, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 17, stats.SpellPower: 33, stats.HealingPower: 33, stats.MP5: 4, stats.Armor: 363, stats.Resilience: 22}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Merciless Gladiator's Wildhide"},
 	{Name: "Merciless Gladiator's Wyrmhide Tunic", ID: 32060, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 136, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 24, stats.SpellPower: 49, stats.HealingPower: 49, stats.MP5: 6, stats.Armor: 470, stats.Resilience: 27}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 4}, SetName: "Merciless Gladiator's Wildhide"},
-	{Name: "Messenger of Fate", ID: 32269, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 112.0, WeaponDamageMax: 169.0, SwingSpeed: 1.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 22, stats.Stamina: 31, stats.AttackPower: 44, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
+	{Name: "Messenger of Fate", ID: 32269, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 112.0, WeaponDamageMax: 169.0, SwingSpeed: 1.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 22, stats.Stamina: 31, stats.AttackPower: 44, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Metro's Slimming Legs", ID: 31442, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 15, stats.Spirit: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.Armor: 114}, SocketBonus: stats.Stats{}},
-	{Name: "Midnight Chestguard", ID: 30905, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 64, stats.AttackPower: 106, stats.MeleeHit: 29, stats.MeleeCrit: 46, stats.Armor: 474, stats.RangedAttackPower: 106}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
+	{Name: "Midnight Chestguard", ID: 30905, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 64, stats.AttackPower: 106, stats.MeleeHit: 29, stats.MeleeCrit: 46, stats.Armor: 474, stats.RangedAttackPower: 106}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
 	{Name: "Midnight Haze", ID: 22803, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 41.0, WeaponDamageMax: 109.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 81, QualityModifier: -21.300, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 12, stats.SpellPower: 85, stats.HealingPower: 85}, SocketBonus: stats.Stats{}},
 	{Name: "Midnight Legguards", ID: 30538, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 64, stats.MeleeHit: 17, stats.MeleeCrit: 27, stats.Armor: 305, stats.RangedAttackPower: 64}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeHit: 4}},
 	{Name: "Midrealm Hat", ID: 30271, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 23, stats.Spirit: 18, stats.SpellPower: 27, stats.HealingPower: 27, stats.Armor: 111}, SocketBonus: stats.Stats{}},
@@ -2843,13 +2843,13 @@ var Items = []Item{
 	{Name: "Millennium Blade", ID: 28184, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 97.0, WeaponDamageMax: 181.0, SwingSpeed: 2.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 19, stats.Resilience: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Mind Quickening Gem", ID: 19339, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Mindrage Pauldrons", ID: 27816, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 15, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellPenetration: 10, stats.Armor: 117}, SocketBonus: stats.Stats{}},
-	{Name: "Mindstorm Wristbands", ID: 29918, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 13, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 23, stats.Armor: 94}, SocketBonus: stats.Stats{}},
+	{Name: "Mindstorm Wristbands", ID: 29918, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 13, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 23, stats.Armor: 94}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Mish'undare, Circlet of the Mind Flayer", ID: 19375, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 24, stats.Spirit: 9, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellCrit: 28, stats.Armor: 117}, SocketBonus: stats.Stats{}},
 	{Name: "Misplaced Servo Arm", ID: 23221, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 128.0, WeaponDamageMax: 238.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Mithril Band of the Unscarred", ID: 28730, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 24, stats.MeleeCrit: 22}, SocketBonus: stats.Stats{}},
-	{Name: "Mithril Chain of Heroism", ID: 28745, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 28, stats.Agility: 22, stats.Stamina: 22}, SocketBonus: stats.Stats{}},
+	{Name: "Mithril Band of the Unscarred", ID: 28730, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 24, stats.MeleeCrit: 22}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
+	{Name: "Mithril Chain of Heroism", ID: 28745, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 28, stats.Agility: 22, stats.Stamina: 22}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Mithril-Bark Cloak", ID: 28328, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 32, stats.Armor: 78, stats.Resilience: 26}, SocketBonus: stats.Stats{}},
-	{Name: "Mitts of the Treemender", ID: 28521, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 22, stats.Spirit: 14, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 228}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Spirit: 3}},
+	{Name: "Mitts of the Treemender", ID: 28521, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 22, stats.Spirit: 14, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 228}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Spirit: 3}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Mixologist's Gloves", ID: 30000, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 16, stats.Spirit: 7, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 83}, SocketBonus: stats.Stats{}},
 	{Name: "Mojo-mender's Mask", ID: 33286, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 38, stats.SpellPower: 33, stats.HealingPower: 132, stats.MP5: 9, stats.Armor: 731}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Mok'Nathal Champion's Shoulderguards", ID: 31712, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 16, stats.AttackPower: 30, stats.Armor: 183, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
@@ -2879,7 +2879,7 @@ var Items = []Item{
 	{Name: "Moonglade Shoulders", ID: 27737, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 12, stats.Stamina: 18, stats.Intellect: 19, stats.Spirit: 18, stats.SpellPower: 10, stats.HealingPower: 39, stats.Armor: 219}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}, SetName: "Moonglade Raiment"},
 	{Name: "Moonrage Girdle", ID: 27783, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Intellect: 22, stats.Spirit: 21, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellCrit: 20, stats.Armor: 160}, SocketBonus: stats.Stats{}},
 	{Name: "Moonstrider Boots", ID: 27914, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 22, stats.SpellPower: 25, stats.HealingPower: 25, stats.MP5: 6, stats.SpellCrit: 20, stats.Armor: 196}, SocketBonus: stats.Stats{}},
-	{Name: "Moroes' Lucky Pocket Watch", ID: 28528, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Dodge: 38}, SocketBonus: stats.Stats{}},
+	{Name: "Moroes' Lucky Pocket Watch", ID: 28528, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Dodge: 38}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Mounting Vengeance", ID: 34346, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 196.0, WeaponDamageMax: 365.0, SwingSpeed: 2.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 22, stats.AttackPower: 46, stats.ArmorPenetration: 140, stats.RangedAttackPower: 46}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Muramasa", ID: 34214, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 196.0, WeaponDamageMax: 365.0, SwingSpeed: 2.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 27, stats.MeleeHit: 17, stats.MeleeHaste: 35}, SocketBonus: stats.Stats{}},
 	{Name: "Murkblood Avenger's Chestplate", ID: 25569, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Strength: 26, stats.Agility: 25, stats.Stamina: 39, stats.Armor: 970}, SocketBonus: stats.Stats{}},
@@ -2887,26 +2887,26 @@ var Items = []Item{
 	{Name: "Murkblood Oven Mitts", ID: 25584, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 24, stats.Intellect: 16, stats.AttackPower: 32, stats.Armor: 339, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Muscle Toning Belt", ID: 31455, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Strength: 15, stats.MeleeHit: 11, stats.MeleeCrit: 27, stats.Armor: 546}, SocketBonus: stats.Stats{}},
 	{Name: "Myrmidon's Headdress", ID: 28180, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 17, stats.Stamina: 37, stats.Armor: 946, stats.Defense: 33}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Strength: 4}},
-	{Name: "Myrmidon's Treads", ID: 32268, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.MeleeHit: 17, stats.Armor: 1213, stats.Defense: 30, stats.Dodge: 26}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Dodge: 3}},
+	{Name: "Myrmidon's Treads", ID: 32268, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.MeleeHit: 17, stats.Armor: 1213, stats.Defense: 30, stats.Dodge: 26}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Dodge: 3}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Naaru Belt of Precision", ID: 31464, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 25, stats.AttackPower: 44, stats.MeleeHit: 15, stats.Armor: 164, stats.RangedAttackPower: 44, stats.Dodge: 21}, SocketBonus: stats.Stats{}},
-	{Name: "Naaru Lightwarden's Band", ID: 28790, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 25, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Naaru-Blessed Life Rod", ID: 32363, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 193.0, WeaponDamageMax: 360.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.Spirit: 16, stats.SpellPower: 13, stats.HealingPower: 50}, SocketBonus: stats.Stats{}},
-	{Name: "Nadina's Pendant of Purity", ID: 32370, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 14, stats.SpellPower: 27, stats.HealingPower: 106, stats.MP5: 8, stats.SpellCrit: 19}, SocketBonus: stats.Stats{}},
+	{Name: "Naaru Lightwarden's Band", ID: 28790, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 25, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 8}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair"},
+	{Name: "Naaru-Blessed Life Rod", ID: 32363, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 193.0, WeaponDamageMax: 360.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.Spirit: 16, stats.SpellPower: 13, stats.HealingPower: 50}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
+	{Name: "Nadina's Pendant of Purity", ID: 32370, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 14, stats.SpellPower: 27, stats.HealingPower: 106, stats.MP5: 8, stats.SpellCrit: 19}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Mother Shahraz"},
 	{Name: "Naliko's Revenge", ID: 25804, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 34, stats.MeleeCrit: 18, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
-	{Name: "Nathrezim Mindblade", ID: 28770, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 25.0, WeaponDamageMax: 125.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 18, stats.SpellPower: 203, stats.HealingPower: 203, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Nathrezim Mindblade", ID: 28770, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 25.0, WeaponDamageMax: 125.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, QualityModifier: -50.800, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 18, stats.SpellPower: 203, stats.HealingPower: 203, stats.SpellCrit: 23}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Natural Alignment Crystal", ID: 19344, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Natural Life Leggings", ID: 33530, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 38, stats.SpellPower: 30, stats.HealingPower: 118, stats.MP5: 7, stats.SpellCrit: 17, stats.Armor: 787}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Natural Mender's Wraps", ID: 28268, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 25, stats.Spirit: 18, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 183}, SocketBonus: stats.Stats{}},
-	{Name: "Naturalist's Preserving Cinch", ID: 32258, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 30, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 556}, SocketBonus: stats.Stats{}},
-	{Name: "Naturewarden's Treads", ID: 32352, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 18, stats.SpellPower: 44, stats.HealingPower: 44, stats.MP5: 7, stats.SpellCrit: 26, stats.Armor: 305}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
+	{Name: "Naturalist's Preserving Cinch", ID: 32258, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 30, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 556}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
+	{Name: "Naturewarden's Treads", ID: 32352, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 18, stats.SpellPower: 44, stats.HealingPower: 44, stats.MP5: 7, stats.SpellCrit: 26, stats.Armor: 305}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SourceZone: "Black Temple"},
 	{Name: "Nax PH Crit Plate Shoulders", ID: 23034, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Strength: 33, stats.Stamina: 18, stats.MeleeCrit: 14, stats.Armor: 797}, SocketBonus: stats.Stats{}},
-	{Name: "Necklace of Eternal Hope", ID: 29374, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 16, stats.HealingPower: 64, stats.MP5: 4}, SocketBonus: stats.Stats{}},
+	{Name: "Necklace of Eternal Hope", ID: 29374, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 16, stats.HealingPower: 64, stats.MP5: 4}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Necklace of Necropsy", ID: 23036, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 11, stats.Spirit: 10, stats.SpellPower: 18, stats.HealingPower: 71}, SocketBonus: stats.Stats{}},
 	{Name: "Necklace of Purity", ID: 21678, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 13, stats.SpellPower: 8, stats.HealingPower: 8, stats.NatureResistance: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Necklace of Resplendent Hope", ID: 28233, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 9}, SocketBonus: stats.Stats{}},
 	{Name: "Necklace of Trophies", ID: 31275, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Strength: 22, stats.Agility: 15, stats.MeleeHit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Necklace of the Deep", ID: 32508, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 100, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 20}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeHit: 3}},
-	{Name: "Necklace of the Juggernaut", ID: 29386, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 33, stats.Defense: 22}, SocketBonus: stats.Stats{}},
+	{Name: "Necklace of the Juggernaut", ID: 29386, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 33, stats.Defense: 22}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Necro-Knight's Garb", ID: 23069, ClassAllowlist: []proto.Class{proto.Class_ClassPriest, proto.Class_ClassMage, proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 32, stats.SpellPower: 37, stats.HealingPower: 37, stats.Armor: 467}, SocketBonus: stats.Stats{}},
 	{Name: "Neltharion's Tear", ID: 19379, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellHit: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Nemesis Belt", ID: 16933, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 8, stats.Spirit: 6, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellCrit: 14, stats.Armor: 74, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Nemesis Raiment"},
@@ -2926,12 +2926,12 @@ var Items = []Item{
 	{Name: "Nether Leggings", ID: 29968, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 28, stats.AttackPower: 58, stats.MeleeHit: 17, stats.Armor: 488, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
 	{Name: "Nether Protector's Chest", ID: 31686, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 21, stats.SpellPower: 13, stats.HealingPower: 13, stats.MeleeHit: 15, stats.MeleeCrit: 37, stats.Armor: 996}, SocketBonus: stats.Stats{}},
 	{Name: "Nether Runner's Cowl", ID: 29122, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 45, stats.Intellect: 39, stats.Armor: 145}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Nether Shadow Tunic", ID: 32252, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 52, stats.AttackPower: 86, stats.MeleeHit: 35, stats.Armor: 444, stats.RangedAttackPower: 86}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
+	{Name: "Nether Shadow Tunic", ID: 32252, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 52, stats.AttackPower: 86, stats.MeleeHit: 35, stats.Armor: 444, stats.RangedAttackPower: 86}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Nether Vest", ID: 29967, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 28, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 17, stats.Armor: 250}, SocketBonus: stats.Stats{}},
 	{Name: "Nether-Empowered Footgear", ID: 31711, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 8, stats.Spirit: 7, stats.SpellPower: 18, stats.HealingPower: 18, stats.SpellCrit: 27, stats.Armor: 89}, SocketBonus: stats.Stats{}},
 	{Name: "Nether-Rocket Gloves", ID: 31313, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 11, stats.Spirit: 10, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 156}, SocketBonus: stats.Stats{}},
 	{Name: "Nether-Stalker's Blade", ID: 31703, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 72.0, WeaponDamageMax: 135.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 8, stats.AttackPower: 32, stats.Expertise: 6, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
-	{Name: "Netherbane", ID: 29924, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 175.0, WeaponDamageMax: 327.0, SwingSpeed: 2.60, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 21, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
+	{Name: "Netherbane", ID: 29924, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 175.0, WeaponDamageMax: 327.0, SwingSpeed: 2.60, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 21, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Netherblade Breeches", ID: 29046, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Agility: 43, stats.Stamina: 40, stats.AttackPower: 84, stats.MeleeHit: 26, stats.Armor: 332, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}, SetName: "Netherblade"},
 	{Name: "Netherblade Chestpiece", ID: 29045, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Agility: 35, stats.Stamina: 39, stats.AttackPower: 74, stats.MeleeHit: 11, stats.Armor: 379, stats.RangedAttackPower: 74}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}, SetName: "Netherblade"},
 	{Name: "Netherblade Facemask", ID: 29044, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 39, stats.AttackPower: 78, stats.MeleeHit: 14, stats.Armor: 308, stats.RangedAttackPower: 78}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 4}, SetName: "Netherblade"},
@@ -2949,7 +2949,7 @@ var Items = []Item{
 	{Name: "Netherfury Leggings", ID: 25692, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Stamina: 37, stats.SpellPower: 29, stats.HealingPower: 29, stats.MP5: 10, stats.Armor: 527}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 4}, SetName: "Fury of the Nether"},
 	{Name: "Netherfused Pauldrons", ID: 30373, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.Spirit: 20, stats.SpellPower: 28, stats.HealingPower: 28, stats.Armor: 208}, SocketBonus: stats.Stats{}},
 	{Name: "Netherhide Gloves", ID: 31684, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 11, stats.Spirit: 16, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 156}, SocketBonus: stats.Stats{}},
-	{Name: "Nethershard Girdle", ID: 28565, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 30, stats.Spirit: 22, stats.SpellPower: 35, stats.HealingPower: 35, stats.Armor: 109}, SocketBonus: stats.Stats{}},
+	{Name: "Nethershard Girdle", ID: 28565, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 30, stats.Spirit: 22, stats.SpellPower: 35, stats.HealingPower: 35, stats.Armor: 109}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Nethershrike", ID: 28258, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 82.0, WeaponDamageMax: 124.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.MeleeCrit: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Netherstorm Eyepatch", ID: 29979, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 16, stats.AttackPower: 78, stats.Armor: 214, stats.RangedAttackPower: 78, stats.Dodge: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Netherstrand Longbow", ID: 30318, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 256.0, WeaponDamageMax: 385.0, SwingSpeed: 2.90, Phase: 0, Quality: proto.ItemQuality_ItemQualityLegendary, Unique: true, Ilvl: 175, Stats: stats.Stats{stats.Stamina: 20, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
@@ -2980,7 +2980,7 @@ var Items = []Item{
 	{Name: "Nigh Invulnerability Belt", ID: 23825, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 21, stats.Armor: 223}, SocketBonus: stats.Stats{}},
 	{Name: "Night's End", ID: 32420, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 109, stats.ShadowResistance: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Nightfall Wristguards", ID: 29246, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 22, stats.AttackPower: 46, stats.Armor: 153, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
-	{Name: "Nightstaff of the Everliving", ID: 28604, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 290.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 34, stats.Spirit: 55, stats.SpellPower: 116, stats.HealingPower: 464}, SocketBonus: stats.Stats{}},
+	{Name: "Nightstaff of the Everliving", ID: 28604, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 290.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 34, stats.Spirit: 55, stats.SpellPower: 116, stats.HealingPower: 464}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Nightstalker's Wristguards", ID: 30399, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 12, stats.AttackPower: 42, stats.MeleeHit: 9, stats.Armor: 112, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Nightstrike", ID: 34783, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 113.0, WeaponDamageMax: 171.0, SwingSpeed: 2.20, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.MeleeHit: 10, stats.Expertise: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Nimble-foot Treads", ID: 30707, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 32, stats.Stamina: 24, stats.Armor: 201, stats.Dodge: 25}, SocketBonus: stats.Stats{}},
@@ -3038,7 +3038,7 @@ var Items = []Item{
 	{Name: "Opportunist's Leather Tunic", ID: 35370, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 42, stats.AttackPower: 12, stats.MeleeCrit: 10, stats.Armor: 292, stats.RangedAttackPower: 12, stats.Resilience: 24}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SetName: "Opportunist's Battlegear"},
 	{Name: "Oracle Belt of Timeless Mystery", ID: 27768, ClassAllowlist: []proto.Class{proto.Class_ClassPriest, proto.Class_ClassMage, proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.Spirit: 17, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 205}, SocketBonus: stats.Stats{}},
 	{Name: "Orb of the Soul-Eater", ID: 29272, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 18, stats.ShadowSpellPower: 51}, SocketBonus: stats.Stats{}},
-	{Name: "Orca-Hide Boots", ID: 30092, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 27, stats.Spirit: 19, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 278}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 3}},
+	{Name: "Orca-Hide Boots", ID: 30092, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 27, stats.Spirit: 19, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 278}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 3}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Leotheras the Blind"},
 	{Name: "Ornate Boots of the Sanctified", ID: 27884, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Strength: 37, stats.Stamina: 18, stats.Intellect: 20, stats.Armor: 780}, SocketBonus: stats.Stats{}},
 	{Name: "Ornate Khorium Rifle", ID: 23748, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 144.0, WeaponDamageMax: 268.0, SwingSpeed: 3.10, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 14, stats.MeleeHit: 13}, SocketBonus: stats.Stats{}},
 	{Name: "Ornate Leggings of the Venerated", ID: 27893, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 21, stats.Intellect: 25, stats.Armor: 993}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
@@ -3055,15 +3055,15 @@ var Items = []Item{
 	{Name: "Pantaloons of Calming Strife", ID: 34170, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 36, stats.Spirit: 42, stats.SpellPower: 45, stats.HealingPower: 179, stats.SpellCrit: 25, stats.Armor: 232}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}},
 	{Name: "Pantaloons of Flaming Wrath", ID: 30709, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 28, stats.SpellPower: 33, stats.HealingPower: 33, stats.SpellCrit: 42, stats.Armor: 136}, SocketBonus: stats.Stats{}},
 	{Name: "Pantaloons of Growing Strife", ID: 34386, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 36, stats.Spirit: 25, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellHaste: 42, stats.Armor: 232}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
-	{Name: "Pantaloons of Repentance", ID: 28742, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 34, stats.Spirit: 26, stats.SpellPower: 23, stats.HealingPower: 91, stats.Armor: 170}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}},
+	{Name: "Pantaloons of Repentance", ID: 28742, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 34, stats.Spirit: 26, stats.SpellPower: 23, stats.HealingPower: 91, stats.Armor: 170}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Pants of Living Growth", ID: 31335, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Intellect: 29, stats.Spirit: 35, stats.SpellPower: 26, stats.HealingPower: 103, stats.MP5: 6, stats.Armor: 278}, SocketBonus: stats.Stats{}},
 	{Name: "Pants of Splendid Recovery", ID: 33552, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 40, stats.Spirit: 31, stats.SpellPower: 29, stats.HealingPower: 115, stats.Armor: 353}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Pants of the Naaru", ID: 30256, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.Spirit: 25, stats.SpellPower: 26, stats.HealingPower: 103, stats.Armor: 130}, SocketBonus: stats.Stats{}},
-	{Name: "Panzar'Thar Breastplate", ID: 28597, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 51, stats.Armor: 1450, stats.Defense: 26, stats.Block: 24, stats.BlockValue: 39}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Block: 4}},
+	{Name: "Panzar'Thar Breastplate", ID: 28597, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 51, stats.Armor: 1450, stats.Defense: 26, stats.Block: 24, stats.BlockValue: 39}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Block: 4}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Papa's Armbands", ID: 30274, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 8, stats.SpellPower: 9, stats.HealingPower: 9, stats.MP5: 3, stats.AttackPower: 16, stats.Armor: 244, stats.RangedAttackPower: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Party Hat Mistake", ID: 31444, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.MP5: 6, stats.Armor: 441}, SocketBonus: stats.Stats{}},
 	{Name: "Pathfinder's Band", ID: 31277, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 106, Stats: stats.Stats{stats.Agility: 25, stats.AttackPower: 34, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
-	{Name: "Pauldrons of Abyssal Fury", ID: 32250, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 72, stats.Armor: 1324, stats.Defense: 28, stats.Dodge: 36}, SocketBonus: stats.Stats{}},
+	{Name: "Pauldrons of Abyssal Fury", ID: 32250, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 72, stats.Armor: 1324, stats.Defense: 28, stats.Dodge: 36}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Pauldrons of Berserking", ID: 34388, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Strength: 45, stats.Stamina: 48, stats.MeleeCrit: 30, stats.MeleeHaste: 27, stats.Armor: 1487}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Pauldrons of Desolation", ID: 27713, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 16, stats.Intellect: 19, stats.MeleeHit: 17, stats.Armor: 489}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeHit: 3}, SetName: "Desolation Battlegear"},
 	{Name: "Pauldrons of Elemental Fury", ID: 23664, ClassAllowlist: []proto.Class{proto.Class_ClassShaman}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 21, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellHit: 8, stats.SpellCrit: 14, stats.Armor: 458}, SocketBonus: stats.Stats{}},
@@ -3079,39 +3079,39 @@ var Items = []Item{
 	{Name: "Pauldrons of Wild Magic", ID: 32078, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 28, stats.SpellPower: 33, stats.HealingPower: 33, stats.SpellCrit: 23, stats.Armor: 583}, SocketBonus: stats.Stats{}},
 	{Name: "Pauldrons of Wrath", ID: 16961, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 13, stats.Stamina: 27, stats.Armor: 733, stats.Defense: 10, stats.BlockValue: 27, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Battlegear of Wrath"},
 	{Name: "Pauldrons of the Aldor", ID: 29079, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.Spirit: 16, stats.SpellPower: 27, stats.HealingPower: 27, stats.SpellCrit: 15, stats.Armor: 152}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SetName: "Aldor Regalia"},
-	{Name: "Pauldrons of the Argent Sentinel", ID: 30084, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Intellect: 34, stats.SpellPower: 25, stats.HealingPower: 100, stats.SpellCrit: 32, stats.Armor: 1206}, SocketBonus: stats.Stats{}},
+	{Name: "Pauldrons of the Argent Sentinel", ID: 30084, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Intellect: 34, stats.SpellPower: 25, stats.HealingPower: 100, stats.SpellCrit: 32, stats.Armor: 1206}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
 	{Name: "Pauldrons of the Crimson Flight", ID: 28207, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 40, stats.Stamina: 28, stats.Armor: 873}, SocketBonus: stats.Stats{}},
 	{Name: "Pauldrons of the Furious Elements", ID: 33970, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 24, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellHaste: 33, stats.Armor: 675}, SocketBonus: stats.Stats{}},
-	{Name: "Pauldrons of the Justice-Seeker", ID: 28666, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 7, stats.SpellCrit: 22, stats.Armor: 1087}, SocketBonus: stats.Stats{}},
-	{Name: "Pauldrons of the Solace-Giver", ID: 28612, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 28, stats.Spirit: 22, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 10, stats.Armor: 146}, SocketBonus: stats.Stats{}},
+	{Name: "Pauldrons of the Justice-Seeker", ID: 28666, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 7, stats.SpellCrit: 22, stats.Armor: 1087}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
+	{Name: "Pauldrons of the Solace-Giver", ID: 28612, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 28, stats.Spirit: 22, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 10, stats.Armor: 146}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Curator"},
 	{Name: "Pauldrons of the Unrelenting", ID: 21639, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Strength: 11, stats.Stamina: 30, stats.Armor: 743, stats.Defense: 13, stats.Dodge: 12}, SocketBonus: stats.Stats{}},
-	{Name: "Pauldrons of the Wardancer", ID: 30053, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 21, stats.MeleeCrit: 29, stats.Armor: 1206}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
-	{Name: "Pearl Inlaid Boots", ID: 32243, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 8, stats.SpellCrit: 28, stats.Armor: 1213}, SocketBonus: stats.Stats{}},
+	{Name: "Pauldrons of the Wardancer", ID: 30053, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 21, stats.MeleeCrit: 29, stats.Armor: 1206}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
+	{Name: "Pearl Inlaid Boots", ID: 32243, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 8, stats.SpellCrit: 28, stats.Armor: 1213}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Pendant of Dominance", ID: 28245, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 113, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 12, stats.SpellPower: 26, stats.HealingPower: 26, stats.Resilience: 16}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 2}},
 	{Name: "Pendant of Forgotten Names", ID: 22947, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 85, Stats: stats.Stats{stats.Intellect: 18, stats.Spirit: 18, stats.MP5: 7}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of Frozen Flame", ID: 24092, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 18, stats.FireResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of Shadow's End", ID: 24097, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 18, stats.ShadowResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of Sunfire", ID: 34359, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 25, stats.SpellHaste: 25}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Pendant of Thawing", ID: 24093, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 18, stats.FrostResistance: 30}, SocketBonus: stats.Stats{}},
-	{Name: "Pendant of Titans", ID: 32362, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 43, stats.MeleeHit: 20, stats.Defense: 21, stats.Dodge: 25}, SocketBonus: stats.Stats{}},
+	{Name: "Pendant of Titans", ID: 32362, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 43, stats.MeleeHit: 20, stats.Defense: 21, stats.Dodge: 25}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Pendant of Triumph", ID: 28244, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 113, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 36, stats.MeleeCrit: 10, stats.RangedAttackPower: 36, stats.Resilience: 17}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 2}},
 	{Name: "Pendant of Withering", ID: 24095, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 18, stats.NatureResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of the Battle-Mage", ID: 29775, Type: proto.ItemType_ItemTypeNeck, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 12, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellCrit: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of the Lost Ages", ID: 30008, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 17, stats.SpellPower: 36, stats.HealingPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of the Null Rune", ID: 24098, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 18, stats.ArcaneResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Pendant of the Perilous", ID: 30022, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 32, stats.Stamina: 24, stats.MeleeCrit: 23}, SocketBonus: stats.Stats{}},
-	{Name: "Pendant of the Violet Eye", ID: 28727, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 40}, SocketBonus: stats.Stats{}},
+	{Name: "Pendant of the Violet Eye", ID: 28727, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 40}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Pepe's Shroud of Pacification", ID: 34010, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 45, stats.MeleeHit: 25, stats.Armor: 118, stats.Dodge: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Perdition's Blade", ID: 18816, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 73.0, WeaponDamageMax: 137.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 77, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Petrified Scarab", ID: 21685, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Phaseshift Bulwark", ID: 30314, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 0, Quality: proto.ItemQuality_ItemQualityLegendary, Unique: true, Ilvl: 175, Stats: stats.Stats{stats.Stamina: 40, stats.Armor: 7313, stats.Defense: 40, stats.BlockValue: 208}, SocketBonus: stats.Stats{}},
-	{Name: "Phoenix-Ring of Rebirth", ID: 29920, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Intellect: 24, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 10}, SocketBonus: stats.Stats{}},
-	{Name: "Phoenix-Wing Cloak", ID: 29925, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.Armor: 108, stats.Defense: 22, stats.Dodge: 27}, SocketBonus: stats.Stats{}},
+	{Name: "Phoenix-Ring of Rebirth", ID: 29920, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Intellect: 24, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 10}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
+	{Name: "Phoenix-Wing Cloak", ID: 29925, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.Armor: 108, stats.Defense: 22, stats.Dodge: 27}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Phoenix-fire Band", ID: 31746, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.FireResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Phosphorescent Blade", ID: 27673, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 120.0, WeaponDamageMax: 224.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.AttackPower: 40, stats.MeleeCrit: 11, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Pilfered Ethereal Blade", ID: 30278, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 66.0, WeaponDamageMax: 124.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 15, stats.Expertise: 6, stats.Defense: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Pillager's Gauntlets", ID: 32608, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 45, stats.MeleeHit: 18, stats.MeleeHaste: 38, stats.Armor: 1103}, SocketBonus: stats.Stats{}},
-	{Name: "Pillar of Ferocity", ID: 30883, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 136.0, WeaponDamageMax: 293.0, SwingSpeed: 3.00, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Strength: 47, stats.Stamina: 96, stats.Armor: 550, stats.FeralAttackPower: 1059}, SocketBonus: stats.Stats{}},
+	{Name: "Pillar of Ferocity", ID: 30883, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 136.0, WeaponDamageMax: 293.0, SwingSpeed: 3.00, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Strength: 47, stats.Stamina: 96, stats.Armor: 550, stats.FeralAttackPower: 1059}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Plagueheart Belt", ID: 22510, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 12, stats.SpellPower: 34, stats.HealingPower: 34, stats.SpellCrit: 14, stats.Armor: 85}, SocketBonus: stats.Stats{}, SetName: "Plagueheart Raiment"},
 	{Name: "Plagueheart Bindings", ID: 22511, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 14, stats.SpellPower: 23, stats.HealingPower: 23, stats.Armor: 66}, SocketBonus: stats.Stats{}, SetName: "Plagueheart Raiment"},
 	{Name: "Plagueheart Circlet", ID: 22506, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 33, stats.HealingPower: 33, stats.SpellHit: 8, stats.SpellCrit: 28, stats.Armor: 123}, SocketBonus: stats.Stats{}, SetName: "Plagueheart Raiment"},
@@ -3136,7 +3136,7 @@ var Items = []Item{
 	{Name: "Potent Sha'tari Pendant", ID: 31747, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 27, stats.HealingPower: 27, stats.Resilience: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Powerheal 4000 Lens", ID: 32495, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 38, stats.Spirit: 28, stats.SpellPower: 34, stats.HealingPower: 135, stats.Armor: 174}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
 	{Name: "Powerheal 9000 Lens", ID: 35181, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 51, stats.Spirit: 41, stats.SpellPower: 43, stats.HealingPower: 171, stats.Armor: 216}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
-	{Name: "Praetorian's Legguards", ID: 32263, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 75, stats.MeleeHit: 18, stats.Armor: 1544, stats.Dodge: 35, stats.Parry: 43}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Praetorian's Legguards", ID: 32263, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 75, stats.MeleeHit: 18, stats.Armor: 1544, stats.Dodge: 35, stats.Parry: 43}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Preceptor's Hat", ID: 23035, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 24, stats.SpellPower: 51, stats.HealingPower: 51, stats.Armor: 117}, SocketBonus: stats.Stats{}},
 	{Name: "Precise Gloves of Alacrity", ID: 31439, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 54, stats.MeleeHit: 11, stats.Armor: 152, stats.RangedAttackPower: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Predatory Gloves", ID: 27825, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.AttackPower: 48, stats.MeleeHit: 19, stats.MeleeCrit: 21, stats.Armor: 183, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
@@ -3151,7 +3151,7 @@ var Items = []Item{
 	{Name: "Primalstrike Belt", ID: 29526, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 32, stats.AttackPower: 84, stats.Armor: 205, stats.RangedAttackPower: 84}, SocketBonus: stats.Stats{}, SetName: "Primal Intent"},
 	{Name: "Primalstrike Bracers", ID: 29527, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 15, stats.Stamina: 21, stats.AttackPower: 64, stats.Armor: 159, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}, SetName: "Primal Intent"},
 	{Name: "Primalstrike Vest", ID: 29525, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Agility: 38, stats.Stamina: 39, stats.AttackPower: 108, stats.MeleeHit: 12, stats.Armor: 379, stats.RangedAttackPower: 108}, SocketBonus: stats.Stats{}, SetName: "Primal Intent"},
-	{Name: "Prism of Inner Calm", ID: 30621, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Prism of Inner Calm", ID: 30621, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Prismatic Mittens of Mending", ID: 28304, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 7, stats.Armor: 97}, SocketBonus: stats.Stats{}},
 	{Name: "Protector's Mark of the Redemption", ID: 31078, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 22, stats.Defense: 25, stats.BlockValue: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Protectorate Assassin's Ring", ID: 30339, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 16, stats.Agility: 15, stats.Stamina: 13, stats.MeleeHit: 12}, SocketBonus: stats.Stats{}},
@@ -3180,7 +3180,7 @@ var Items = []Item{
 	{Name: "Quagmirran's Eye", ID: 27683, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 37, stats.HealingPower: 37}, SocketBonus: stats.Stats{}},
 	{Name: "Quantum Blade", ID: 29356, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 304.0, WeaponDamageMax: 456.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 31, stats.AttackPower: 102, stats.MeleeCrit: 30, stats.RangedAttackPower: 102}, SocketBonus: stats.Stats{}},
 	{Name: "Quickening Blade of the Prince", ID: 34609, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 96.0, WeaponDamageMax: 180.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 38, stats.ArmorPenetration: 126, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
-	{Name: "Quickstrider Moccasins", ID: 30880, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 30, stats.Intellect: 31, stats.AttackPower: 58, stats.MeleeHit: 15, stats.Armor: 679, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 3}},
+	{Name: "Quickstrider Moccasins", ID: 30880, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 30, stats.Intellect: 31, stats.AttackPower: 58, stats.MeleeHit: 15, stats.Armor: 679, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 3}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
 	{Name: "Rage", ID: 33495, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 174.0, WeaponDamageMax: 323.0, SwingSpeed: 2.60, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 18, stats.MeleeCrit: 13}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Ragesteel Breastplate", ID: 23522, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 50, stats.MeleeCrit: 29, stats.Armor: 1164}, SocketBonus: stats.Stats{}, SetName: "Burning Rage"},
 	{Name: "Ragesteel Gloves", ID: 23520, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 35, stats.MeleeCrit: 27, stats.Armor: 728}, SocketBonus: stats.Stats{}, SetName: "Burning Rage"},
@@ -3188,14 +3188,14 @@ var Items = []Item{
 	{Name: "Ragesteel Shoulders", ID: 33173, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 32, stats.Stamina: 12, stats.MeleeHit: 9, stats.MeleeCrit: 17, stats.Armor: 873}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SetName: "Burning Rage"},
 	{Name: "Raiments of Nature's Breath", ID: 27456, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 32, stats.Spirit: 18, stats.SpellPower: 25, stats.HealingPower: 100, stats.MP5: 7, stats.Armor: 292}, SocketBonus: stats.Stats{}},
 	{Name: "Ramaladni's Icy Grasp", ID: 22707, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 80, Stats: stats.Stats{stats.Stamina: 15, stats.FrostResistance: 25}, SocketBonus: stats.Stats{}},
-	{Name: "Ranger-General's Chestguard", ID: 30054, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 19, stats.Intellect: 30, stats.AttackPower: 88, stats.MeleeCrit: 14, stats.Armor: 900, stats.RangedAttackPower: 88}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 4}},
-	{Name: "Rapscallion Boots", ID: 28669, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 26, stats.AttackPower: 82, stats.MeleeCrit: 24, stats.Armor: 250, stats.RangedAttackPower: 82}, SocketBonus: stats.Stats{}},
+	{Name: "Ranger-General's Chestguard", ID: 30054, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 19, stats.Intellect: 30, stats.AttackPower: 88, stats.MeleeCrit: 14, stats.Armor: 900, stats.RangedAttackPower: 88}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 4}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
+	{Name: "Rapscallion Boots", ID: 28669, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 26, stats.AttackPower: 82, stats.MeleeCrit: 24, stats.Armor: 250, stats.RangedAttackPower: 82}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Raven's Wood Exorciser's Band", ID: 31526, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 12, stats.Spirit: 12, stats.SpellPower: 10, stats.HealingPower: 39, stats.MP5: 5}, SocketBonus: stats.Stats{}},
 	{Name: "Ravenclaw Band", ID: 27925, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 15, stats.AttackPower: 30, stats.MeleeHit: 13, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Ravencrest's Legacy", ID: 21520, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 84.0, WeaponDamageMax: 157.0, SwingSpeed: 2.10, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 9, stats.Stamina: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Razaani-Buster Leggings", ID: 31458, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 10, stats.MP5: 4, stats.AttackPower: 42, stats.MeleeCrit: 37, stats.Armor: 488, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Razor-Scale Battlecloak", ID: 30098, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 33, stats.Agility: 23, stats.Stamina: 22, stats.Armor: 108}, SocketBonus: stats.Stats{}},
-	{Name: "Razorfury Mantle", ID: 30917, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 55, stats.AttackPower: 76, stats.MeleeCrit: 23, stats.Armor: 333, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
+	{Name: "Razorfury Mantle", ID: 30917, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 55, stats.AttackPower: 76, stats.MeleeCrit: 23, stats.Armor: 333, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Reaver of the Infinites", ID: 28222, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 268.0, WeaponDamageMax: 403.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.AttackPower: 50, stats.RangedAttackPower: 50, stats.Resilience: 27}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Resilience: 4}},
 	{Name: "Reavers' Ring", ID: 27460, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 117, Stats: stats.Stats{stats.Strength: 23, stats.Stamina: 18, stats.MeleeCrit: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Recoilless Rocket Ripper X-54", ID: 27794, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 131.0, WeaponDamageMax: 244.0, SwingSpeed: 2.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 13, stats.MeleeCrit: 16}, SocketBonus: stats.Stats{}},
@@ -3203,7 +3203,7 @@ var Items = []Item{
 	{Name: "Red Belt of Battle", ID: 30032, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 41, stats.Stamina: 21, stats.MeleeHit: 14, stats.MeleeCrit: 24, stats.Armor: 904}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
 	{Name: "Red Havoc Boots", ID: 30031, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 49, stats.Armor: 1105, stats.Defense: 32, stats.Block: 25, stats.BlockValue: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Red Pointy Hat", ID: 30294, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 15, stats.SpellPower: 43, stats.HealingPower: 43, stats.Armor: 108}, SocketBonus: stats.Stats{}},
-	{Name: "Red Riding Hood's Cloak", ID: 28582, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 22, stats.SpellPower: 16, stats.HealingPower: 64, stats.MP5: 7, stats.Armor: 97}, SocketBonus: stats.Stats{}},
+	{Name: "Red Riding Hood's Cloak", ID: 28582, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 22, stats.SpellPower: 16, stats.HealingPower: 64, stats.MP5: 7, stats.Armor: 97}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Big Bad Wolf"},
 	{Name: "Redeemed Soul Cinch", ID: 32393, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 40, stats.Armor: 231, stats.ShadowResistance: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Redeemed Soul Legguards", ID: 32396, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 54, stats.Armor: 359, stats.ShadowResistance: 72}, SocketBonus: stats.Stats{}},
 	{Name: "Redeemed Soul Moccasins", ID: 32394, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 40, stats.Armor: 282, stats.ShadowResistance: 54}, SocketBonus: stats.Stats{}},
@@ -3221,7 +3221,7 @@ var Items = []Item{
 	{Name: "Regenerating Belt of Vek'nilash", ID: 21609, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 18, stats.Spirit: 13, stats.SpellPower: 19, stats.HealingPower: 74, stats.Armor: 152}, SocketBonus: stats.Stats{}},
 	{Name: "Reign of Misery", ID: 34176, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 14.0, WeaponDamageMax: 119.0, SwingSpeed: 1.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, QualityModifier: -66.500, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 25, stats.SpellPower: 266, stats.HealingPower: 266, stats.SpellHaste: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Reinforced Heaume", ID: 29791, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Strength: 17, stats.Agility: 17, stats.Stamina: 39, stats.Armor: 831, stats.Defense: 27}, SocketBonus: stats.Stats{}},
-	{Name: "Rejuvenating Bracers", ID: 30868, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 20, stats.Spirit: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 194}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}},
+	{Name: "Rejuvenating Bracers", ID: 30868, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 20, stats.Spirit: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 194}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 4}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Rejuvenating Gem", ID: 19395, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 9}, SocketBonus: stats.Stats{}},
 	{Name: "Rejuvenating Scepter", ID: 29779, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 100.0, WeaponDamageMax: 186.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.SpellPower: 11, stats.HealingPower: 42, stats.MP5: 2}, SocketBonus: stats.Stats{}},
 	{Name: "Resolute Cape", ID: 24258, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 349, stats.Resilience: 21}, SocketBonus: stats.Stats{}},
@@ -3232,8 +3232,8 @@ var Items = []Item{
 	{Name: "Revenger", ID: 28311, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 75.0, WeaponDamageMax: 140.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Rhok'delar, Longbow of the Ancient Keepers", ID: 18713, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 108.0, WeaponDamageMax: 201.0, SwingSpeed: 2.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.MeleeCrit: 14, stats.RangedAttackPower: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Rhok'delar, Longbow of the Ancient Keepers DEP", ID: 20488, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 108.0, WeaponDamageMax: 201.0, SwingSpeed: 2.90, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.MeleeCrit: 14, stats.RangedAttackPower: 17}, SocketBonus: stats.Stats{}},
-	{Name: "Ribbon of Sacrifice", ID: 28590, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 25, stats.HealingPower: 98}, SocketBonus: stats.Stats{}},
-	{Name: "Rifle of the Stoic Guardian", ID: 32325, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 120.0, WeaponDamageMax: 224.0, SwingSpeed: 1.90, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 31, stats.Dodge: 20}, SocketBonus: stats.Stats{}},
+	{Name: "Ribbon of Sacrifice", ID: 28590, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 25, stats.HealingPower: 98}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan"},
+	{Name: "Rifle of the Stoic Guardian", ID: 32325, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 120.0, WeaponDamageMax: 224.0, SwingSpeed: 1.90, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 31, stats.Dodge: 20}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
 	{Name: "Rift Stalker Gauntlets", ID: 30140, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Agility: 34, stats.Stamina: 29, stats.Intellect: 20, stats.AttackPower: 68, stats.MeleeHit: 19, stats.Armor: 583, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}, SetName: "Rift Stalker Armor"},
 	{Name: "Rift Stalker Hauberk", ID: 30139, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 40, stats.Intellect: 19, stats.MP5: 7, stats.AttackPower: 80, stats.MeleeHit: 19, stats.Armor: 934, stats.RangedAttackPower: 80}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 4}, SetName: "Rift Stalker Armor"},
 	{Name: "Rift Stalker Helm", ID: 30141, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 36, stats.Intellect: 25, stats.MP5: 10, stats.AttackPower: 82, stats.Armor: 759, stats.RangedAttackPower: 82}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Stamina: 6}, SetName: "Rift Stalker Armor"},
@@ -3242,17 +3242,17 @@ var Items = []Item{
 	{Name: "Riftmaker", ID: 29182, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 117.0, WeaponDamageMax: 176.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Agility: 21, stats.AttackPower: 22, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Rime Covered Mantle", ID: 22983, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 14, stats.Armor: 108}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Ancient Knowledge", ID: 32527, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 20, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHaste: 31}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Arathi Warlords", ID: 29379, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 46, stats.MeleeCrit: 23, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Arathi Warlords", ID: 29379, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 46, stats.MeleeCrit: 23, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Ring of Arcane Shielding", ID: 30825, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 12, stats.Resilience: 8, stats.ArcaneResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Blackrock", ID: 19397, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.SpellPower: 19, stats.HealingPower: 19, stats.MP5: 9}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Calming Waves", ID: 32238, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 27, stats.SpellPower: 22, stats.HealingPower: 86, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Captured Storms", ID: 32247, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 19, stats.SpellCrit: 29}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Calming Waves", ID: 32238, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 27, stats.SpellPower: 22, stats.HealingPower: 86, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
+	{Name: "Ring of Captured Storms", ID: 32247, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 19, stats.SpellCrit: 29}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Ring of Conflict Survival", ID: 31922, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 28, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Convalescence", ID: 29169, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 15, stats.SpellPower: 19, stats.HealingPower: 76, stats.MP5: 4}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Cryptic Dreams", ID: 29367, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 17, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 20}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Deceitful Intent", ID: 32266, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 42, stats.AttackPower: 58, stats.MeleeHit: 19, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Cryptic Dreams", ID: 29367, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 17, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellCrit: 20}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
+	{Name: "Ring of Deceitful Intent", ID: 32266, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 42, stats.AttackPower: 58, stats.MeleeHit: 19, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Ring of Emperor Vek'lor", ID: 21601, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 81, Stats: stats.Stats{stats.Agility: 12, stats.Stamina: 18, stats.Armor: 100, stats.Defense: 13}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Endless Coils", ID: 30109, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 31, stats.SpellPower: 37, stats.HealingPower: 37, stats.SpellCrit: 22}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Endless Coils", ID: 30109, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 31, stats.SpellPower: 37, stats.HealingPower: 37, stats.SpellCrit: 22}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Ring of Fabled Hope", ID: 27780, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 12, stats.Spirit: 11, stats.SpellPower: 20, stats.HealingPower: 79}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Faith", ID: 23061, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 92, Stats: stats.Stats{stats.Intellect: 16, stats.Spirit: 16, stats.SpellPower: 19, stats.HealingPower: 74}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
 	{Name: "Ring of Flowing Life", ID: 34363, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 28, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 8, stats.SpellHaste: 30}, SocketBonus: stats.Stats{}},
@@ -3261,18 +3261,18 @@ var Items = []Item{
 	{Name: "Ring of Ghoulish Delight", ID: 34075, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 31, stats.AttackPower: 60, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Hardened Resolve", ID: 34213, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 48, stats.Expertise: 22, stats.Armor: 308, stats.Defense: 32, stats.BlockValue: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Harmonic Beauty", ID: 35733, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.Spirit: 32, stats.SpellPower: 25, stats.HealingPower: 98}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Lethality", ID: 30052, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 19, stats.AttackPower: 50, stats.MeleeHit: 19, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Lethality", ID: 30052, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 19, stats.AttackPower: 50, stats.MeleeHit: 19, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Ring of Omnipotence", ID: 34230, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 14, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellCrit: 22, stats.SpellHaste: 31}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Reciprocity", ID: 30738, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 120, Stats: stats.Stats{stats.AttackPower: 62, stats.MeleeHit: 15, stats.MeleeCrit: 19, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Recurrence", ID: 28753, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 32, stats.HealingPower: 32, stats.SpellCrit: 19}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Recurrence", ID: 28753, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 32, stats.HealingPower: 32, stats.SpellCrit: 19}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Ring of Redemption", ID: 23066, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 92, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 16, stats.SpellPower: 13, stats.HealingPower: 50, stats.MP5: 6}, SocketBonus: stats.Stats{}, SetName: "Redemption Armor"},
 	{Name: "Ring of Spiritual Fervor", ID: 23037, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 11, stats.Intellect: 14, stats.MP5: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Spiritual Precision", ID: 27996, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 18, stats.Spirit: 15, stats.MP5: 7}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Sundered Souls", ID: 30083, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.Defense: 18, stats.Dodge: 25}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Sundered Souls", ID: 30083, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.Defense: 18, stats.Dodge: 25}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
 	{Name: "Ring of Umbral Doom", ID: 28323, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.AttackPower: 40, stats.MeleeCrit: 20, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of Unrelenting Storms", ID: 30667, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 15, stats.NatureSpellPower: 43, stats.SpellCrit: 19}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of Unyielding Force", ID: 29384, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 31, stats.Armor: 294, stats.Defense: 22}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of a Thousand Marks", ID: 28757, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 21, stats.AttackPower: 44, stats.MeleeHit: 19, stats.MeleeCrit: 23, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of Unyielding Force", ID: 29384, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 31, stats.Armor: 294, stats.Defense: 22}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
+	{Name: "Ring of a Thousand Marks", ID: 28757, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 21, stats.AttackPower: 44, stats.MeleeHit: 19, stats.MeleeCrit: 23, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Ring of the Cryptstalker", ID: 23067, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 92, Stats: stats.Stats{stats.Agility: 20, stats.MP5: 6, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}, SetName: "Cryptstalker Armor"},
 	{Name: "Ring of the Devoured", ID: 21681, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 13, stats.SpellPower: 5, stats.HealingPower: 20, stats.MP5: 8}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of the Dreadnaught", ID: 23059, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 92, Stats: stats.Stats{stats.Stamina: 27, stats.Defense: 15, stats.Dodge: 12}, SocketBonus: stats.Stats{}, SetName: "Dreadnaught's Battlegear"},
@@ -3284,33 +3284,33 @@ var Items = []Item{
 	{Name: "Ring of the Martyr", ID: 21620, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 10, stats.SpellPower: 17, stats.HealingPower: 68, stats.MP5: 5}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of the Overseer", ID: 32942, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Agility: 16, stats.AttackPower: 56, stats.MeleeHit: 12, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of the Qiraji Fury", ID: 21677, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 12, stats.AttackPower: 40, stats.MeleeCrit: 14, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
-	{Name: "Ring of the Recalcitrant", ID: 28791, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 27, stats.AttackPower: 54, stats.RangedAttackPower: 54}, SocketBonus: stats.Stats{}},
+	{Name: "Ring of the Recalcitrant", ID: 28791, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 27, stats.AttackPower: 54, stats.RangedAttackPower: 54}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair"},
 	{Name: "Ring of the Shadow Deeps", ID: 27761, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 24, stats.MeleeHit: 13, stats.MeleeCrit: 21, stats.RangedAttackPower: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of the Silver Hand", ID: 27805, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 18, stats.MP5: 5, stats.Defense: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of the Stalwart Protector", ID: 34888, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 45, stats.Armor: 392, stats.Dodge: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Ring of the Stonebark", ID: 31528, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.Agility: 12, stats.Stamina: 18, stats.Defense: 19}, SocketBonus: stats.Stats{}},
-	{Name: "Rip-Flayer Leggings", ID: 28740, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 30, stats.MP5: 8, stats.AttackPower: 56, stats.MeleeCrit: 28, stats.Armor: 710, stats.RangedAttackPower: 56}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Rip-Flayer Leggings", ID: 28740, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 30, stats.MP5: 8, stats.AttackPower: 56, stats.MeleeCrit: 28, stats.Armor: 710, stats.RangedAttackPower: 56}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Ripfang Paw", ID: 30277, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 113.0, WeaponDamageMax: 210.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 10, stats.Stamina: 9, stats.AttackPower: 32, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Ripfiend Shoulderplates", ID: 30740, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 39, stats.MeleeHit: 13, stats.MeleeCrit: 22, stats.Armor: 1133}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Strength: 3}},
-	{Name: "Rising Tide", ID: 32236, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 208.0, WeaponDamageMax: 313.0, SwingSpeed: 2.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 33, stats.AttackPower: 44, stats.MeleeHit: 21, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
+	{Name: "Rising Tide", ID: 32236, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 208.0, WeaponDamageMax: 313.0, SwingSpeed: 2.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 33, stats.AttackPower: 44, stats.MeleeHit: 21, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Ritssyn's Lost Pendant", ID: 30666, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.ShadowSpellPower: 51}, SocketBonus: stats.Stats{}},
 	{Name: "Ritualist's Helm", ID: 31713, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 20, stats.SpellPower: 42, stats.HealingPower: 42, stats.MP5: 6, stats.Armor: 441}, SocketBonus: stats.Stats{}},
 	{Name: "Robe of Departed Spirits", ID: 33317, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 31, stats.Spirit: 30, stats.SpellPower: 54, stats.HealingPower: 54, stats.SpellHaste: 35, stats.Armor: 215}, SocketBonus: stats.Stats{}},
 	{Name: "Robe of Effervescent Light", ID: 27506, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 33, stats.Spirit: 18, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 7, stats.Armor: 156}, SocketBonus: stats.Stats{}},
 	{Name: "Robe of Eternal Light", ID: 34365, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 34, stats.SpellPower: 45, stats.HealingPower: 179, stats.MP5: 16, stats.SpellHaste: 40, stats.Armor: 266}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Robe of Faith", ID: 22512, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 92, Stats: stats.Stats{stats.Stamina: 26, stats.Intellect: 27, stats.Spirit: 21, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 5, stats.Armor: 158}, SocketBonus: stats.Stats{}, SetName: "Vestments of Faith"},
-	{Name: "Robe of Hateful Echoes", ID: 30056, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 36, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellCrit: 25, stats.Armor: 215}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Robe of Hateful Echoes", ID: 30056, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 36, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellCrit: 25, stats.Armor: 215}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Robe of Oblivion", ID: 28232, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 20, stats.SpellPower: 40, stats.HealingPower: 40, stats.Armor: 156}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}, SetName: "Oblivion Raiment"},
 	{Name: "Robe of the Corruptor", ID: 30214, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 33, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellHit: 23, stats.Armor: 223}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Corruptor Raiment"},
 	{Name: "Robe of the Crimson Order", ID: 31297, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Intellect: 23, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellHit: 30, stats.Armor: 152}, SocketBonus: stats.Stats{}},
-	{Name: "Robe of the Elder Scribes", ID: 28602, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 29, stats.Spirit: 24, stats.SpellPower: 32, stats.HealingPower: 32, stats.SpellCrit: 24, stats.Armor: 194}, SocketBonus: stats.Stats{}},
+	{Name: "Robe of the Elder Scribes", ID: 28602, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 29, stats.Spirit: 24, stats.SpellPower: 32, stats.HealingPower: 32, stats.SpellCrit: 24, stats.Armor: 194}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Robe of the Great Dark Beyond", ID: 27824, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 30, stats.Spirit: 18, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellCrit: 23, stats.Armor: 156}, SocketBonus: stats.Stats{}},
 	{Name: "Robe of the Malefic", ID: 31052, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 66, stats.Intellect: 29, stats.SpellPower: 63, stats.HealingPower: 63, stats.SpellHit: 28, stats.Armor: 244}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Malefic Raiment"},
-	{Name: "Robe of the Shadow Council", ID: 32327, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 36, stats.Spirit: 26, stats.SpellPower: 73, stats.HealingPower: 73, stats.SpellCrit: 28, stats.Armor: 236}, SocketBonus: stats.Stats{}},
+	{Name: "Robe of the Shadow Council", ID: 32327, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 36, stats.Spirit: 26, stats.SpellPower: 73, stats.HealingPower: 73, stats.SpellCrit: 28, stats.Armor: 236}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
 	{Name: "Robes of Faltered Light", ID: 34233, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 40, stats.Spirit: 40, stats.SpellPower: 45, stats.HealingPower: 179, stats.SpellHaste: 32, stats.Armor: 257}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Spirit: 4}},
 	{Name: "Robes of Ghostly Hatred", ID: 34399, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 40, stats.Spirit: 32, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellCrit: 26, stats.SpellHaste: 27, stats.Armor: 266}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Robes of Heavenly Purpose", ID: 33203, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.Spirit: 45, stats.SpellPower: 34, stats.HealingPower: 135, stats.Armor: 215}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
-	{Name: "Robes of Rhonin", ID: 30913, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 55, stats.Intellect: 38, stats.SpellPower: 81, stats.HealingPower: 81, stats.SpellHit: 27, stats.SpellCrit: 24, stats.Armor: 253}, SocketBonus: stats.Stats{}},
+	{Name: "Robes of Rhonin", ID: 30913, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 55, stats.Intellect: 38, stats.SpellPower: 81, stats.HealingPower: 81, stats.SpellHit: 27, stats.SpellCrit: 24, stats.Armor: 253}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Robes of Summer Flame", ID: 34796, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 18, stats.Spirit: 26, stats.SpellPower: 25, stats.HealingPower: 98, stats.Armor: 292}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Robes of Tirisfal", ID: 30196, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 35, stats.Spirit: 20, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellCrit: 19, stats.Armor: 223}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Tirisfal Regalia"},
 	{Name: "Robes of Transcendence", ID: 16923, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 27, stats.Spirit: 16, stats.SpellPower: 19, stats.HealingPower: 76, stats.Armor: 132, stats.FireResistance: 10, stats.NatureResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Vestments of Transcendence"},
@@ -3325,17 +3325,17 @@ var Items = []Item{
 	{Name: "Rockhide Strongfish", ID: 19808, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 46.0, WeaponDamageMax: 87.0, SwingSpeed: 2.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 45, Stats: stats.Stats{stats.Stamina: 8}, SocketBonus: stats.Stats{}},
 	{Name: "Rod of Dire Shadows", ID: 25808, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 152.0, WeaponDamageMax: 284.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Intellect: 10, stats.SpellPower: 11, stats.HealingPower: 11, stats.SpellCrit: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Rod of the Blazing Light", ID: 34608, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 290.0, SwingSpeed: 3.20, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 32, stats.Spirit: 41, stats.SpellPower: 116, stats.HealingPower: 464}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Spirit: 4}},
-	{Name: "Rod of the Sun King", ID: 29996, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 189.0, WeaponDamageMax: 352.0, SwingSpeed: 2.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 52, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
-	{Name: "Romulo's Poison Vial", ID: 28579, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.MeleeHit: 35}, SocketBonus: stats.Stats{}},
-	{Name: "Royal Cloak of Arathi Kings", ID: 28529, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 31, stats.MeleeHit: 16, stats.Armor: 97}, SocketBonus: stats.Stats{}},
-	{Name: "Royal Cloak of the Sunstriders", ID: 29992, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.SpellPower: 44, stats.HealingPower: 44, stats.Armor: 116}, SocketBonus: stats.Stats{}},
-	{Name: "Royal Gauntlets of Silvermoon", ID: 29998, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 57, stats.Armor: 1080, stats.Defense: 24, stats.Dodge: 32}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}},
+	{Name: "Rod of the Sun King", ID: 29996, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 189.0, WeaponDamageMax: 352.0, SwingSpeed: 2.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 52, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
+	{Name: "Romulo's Poison Vial", ID: 28579, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.MeleeHit: 35}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Romulo and Julianne"},
+	{Name: "Royal Cloak of Arathi Kings", ID: 28529, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 31, stats.MeleeHit: 16, stats.Armor: 97}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
+	{Name: "Royal Cloak of the Sunstriders", ID: 29992, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 22, stats.SpellPower: 44, stats.HealingPower: 44, stats.Armor: 116}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
+	{Name: "Royal Gauntlets of Silvermoon", ID: 29998, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 57, stats.Armor: 1080, stats.Defense: 24, stats.Dodge: 32}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Royal Qiraji Belt", ID: 21598, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 13, stats.Stamina: 22, stats.Armor: 584, stats.Defense: 12, stats.Parry: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Royal Scepter of Vek'lor", ID: 21597, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 9, stats.SpellPower: 20, stats.HealingPower: 20, stats.SpellHit: 8, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Rubium War-Girdle", ID: 28375, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 31, stats.Stamina: 29, stats.MeleeCrit: 18, stats.Armor: 655}, SocketBonus: stats.Stats{}},
-	{Name: "Ruby Drape of the Mysticant", ID: 28766, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellHit: 18, stats.Armor: 105}, SocketBonus: stats.Stats{}},
+	{Name: "Ruby Drape of the Mysticant", ID: 28766, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellHit: 18, stats.Armor: 105}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Ruby Helm of the Just", ID: 27505, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 36, stats.Stamina: 24, stats.Intellect: 25, stats.MP5: 10, stats.MeleeCrit: 16, stats.Armor: 946}, SocketBonus: stats.Stats{}},
-	{Name: "Ruby Slippers", ID: 28585, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 29, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 16, stats.Armor: 134}, SocketBonus: stats.Stats{}},
+	{Name: "Ruby Slippers", ID: 28585, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 29, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellHit: 16, stats.Armor: 134}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Crone"},
 	{Name: "Rune of Metamorphosis", ID: 19340, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Rune-Engraved Belt", ID: 25789, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 114, Stats: stats.Stats{stats.Agility: 25, stats.AttackPower: 50, stats.MeleeHit: 24, stats.Armor: 364, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Runed Dagger of Solace", ID: 28322, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 30.0, WeaponDamageMax: 95.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 24, stats.SpellPower: 76, stats.HealingPower: 303, stats.Resilience: 21}, SocketBonus: stats.Stats{}},
@@ -3345,14 +3345,14 @@ var Items = []Item{
 	{Name: "Runed Spell-cuffs", ID: 33588, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 18, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellHaste: 25, stats.Armor: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Runesong Dagger", ID: 27868, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 28.0, WeaponDamageMax: 91.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 11, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellCrit: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Runesword of the Red", ID: 21521, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 53.0, WeaponDamageMax: 129.0, SwingSpeed: 2.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, QualityModifier: -16.000, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 9, stats.Spirit: 7, stats.SpellPower: 64, stats.HealingPower: 64}, SocketBonus: stats.Stats{}},
-	{Name: "Runetotem's Mantle", ID: 30111, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.Spirit: 32, stats.SpellPower: 34, stats.HealingPower: 135, stats.Armor: 326}, SocketBonus: stats.Stats{}},
+	{Name: "Runetotem's Mantle", ID: 30111, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.Spirit: 32, stats.SpellPower: 34, stats.HealingPower: 135, stats.Armor: 326}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Runic Hammer", ID: 23544, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 140.0, WeaponDamageMax: 261.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 16, stats.AttackPower: 50, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Rushing Storm Kilt", ID: 34934, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 38, stats.SpellPower: 61, stats.HealingPower: 61, stats.SpellHaste: 38, stats.Armor: 864}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Ruuan Weald Wristguards", ID: 31793, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 21, stats.Agility: 11, stats.Stamina: 12, stats.Armor: 109}, SocketBonus: stats.Stats{}},
 	{Name: "Ryngo's Band of Ingenuity", ID: 28394, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 14, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Sabatons of Wrath", ID: 16965, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Strength: 13, stats.Stamina: 30, stats.Armor: 672, stats.Defense: 10, stats.BlockValue: 14, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Battlegear of Wrath"},
 	{Name: "Sabatons of the Righteous Defender", ID: 33523, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 45, stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellHit: 18, stats.Armor: 1105, stats.Defense: 18, stats.BlockValue: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}},
-	{Name: "Saberclaw Talisman", ID: 28674, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 33, stats.AttackPower: 46, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
+	{Name: "Saberclaw Talisman", ID: 28674, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 33, stats.AttackPower: 46, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Saboteur's Axe", ID: 31701, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 198.0, WeaponDamageMax: 298.0, SwingSpeed: 3.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 37, stats.Stamina: 22, stats.MP5: 7, stats.AttackPower: 72, stats.MeleeCrit: 21, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
 	{Name: "Sadist's Collar", ID: 23023, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 20, stats.MeleeCrit: 14, stats.RangedAttackPower: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Salvager's Hauberk", ID: 30835, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 49, stats.AttackPower: 66, stats.MeleeCrit: 33, stats.Armor: 652, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}},
@@ -3377,8 +3377,8 @@ var Items = []Item{
 	{Name: "Savage Plate Helm", ID: 35478, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 20, stats.Stamina: 34, stats.MeleeCrit: 24, stats.Armor: 946, stats.Resilience: 19}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 4}, SetName: "Savage Plate Battlegear"},
 	{Name: "Savage Plate Legguards", ID: 35410, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 28, stats.Stamina: 46, stats.MeleeCrit: 28, stats.Armor: 1019, stats.Resilience: 23}, SocketBonus: stats.Stats{}, SetName: "Savage Plate Battlegear"},
 	{Name: "Savage Plate Shoulders", ID: 35411, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 14, stats.Stamina: 36, stats.MeleeCrit: 17, stats.Armor: 873, stats.Resilience: 13}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Savage Plate Battlegear"},
-	{Name: "Savior's Grasp", ID: 30904, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 69, stats.Intellect: 48, stats.SpellPower: 36, stats.HealingPower: 142, stats.SpellCrit: 46, stats.Armor: 1886}, SocketBonus: stats.Stats{}},
-	{Name: "Scaled Breastplate of Carnage", ID: 28599, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 38, stats.MP5: 8, stats.AttackPower: 80, stats.MeleeCrit: 24, stats.Armor: 812, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}},
+	{Name: "Savior's Grasp", ID: 30904, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 69, stats.Intellect: 48, stats.SpellPower: 36, stats.HealingPower: 142, stats.SpellCrit: 46, stats.Armor: 1886}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Azgalor"},
+	{Name: "Scaled Breastplate of Carnage", ID: 28599, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 38, stats.MP5: 8, stats.AttackPower: 80, stats.MeleeCrit: 24, stats.Armor: 812, stats.RangedAttackPower: 80}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Scaled Draenic Boots", ID: 25659, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 30, stats.SpellPower: 22, stats.HealingPower: 22, stats.MP5: 8, stats.Armor: 384}, SocketBonus: stats.Stats{}, SetName: "Scaled Draenic Armor"},
 	{Name: "Scaled Drakeskin Chestguard", ID: 34912, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 47, stats.Stamina: 39, stats.Intellect: 26, stats.AttackPower: 102, stats.MeleeHaste: 25, stats.Armor: 988, stats.RangedAttackPower: 102}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Scaled Greaves of the Marksman", ID: 30739, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Agility: 37, stats.AttackPower: 76, stats.MeleeHit: 16, stats.Armor: 740, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 4}},
@@ -3386,11 +3386,11 @@ var Items = []Item{
 	{Name: "Scaled Sand Reaver Leggings", ID: 21651, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 10, stats.AttackPower: 62, stats.MeleeCrit: 28, stats.Armor: 488, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
 	{Name: "Scalewing Gloves", ID: 31454, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 16, stats.Intellect: 16, stats.MP5: 6, stats.AttackPower: 32, stats.Armor: 339, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
 	{Name: "Scarab Brooch", ID: 21625, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Scarab of Displacement", ID: 30629, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Defense: 42}, SocketBonus: stats.Stats{}},
+	{Name: "Scarab of Displacement", ID: 30629, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Defense: 42}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Scarab of the Infinite Cycle", ID: 28190, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellPower: 24, stats.HealingPower: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Scarlet Sin'dorei Robes", ID: 34610, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.SpellPower: 51, stats.HealingPower: 51, stats.SpellCrit: 36, stats.Armor: 210}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Scavenged Breastplate", ID: 30270, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 14, stats.Agility: 15, stats.Stamina: 36, stats.MeleeHit: 14, stats.Armor: 996, stats.Defense: 25}, SocketBonus: stats.Stats{}},
-	{Name: "Scepter of Purification", ID: 30911, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 17, stats.Spirit: 25, stats.SpellPower: 26, stats.HealingPower: 103}, SocketBonus: stats.Stats{}},
+	{Name: "Scepter of Purification", ID: 30911, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 17, stats.Spirit: 25, stats.SpellPower: 26, stats.HealingPower: 103}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
 	{Name: "Scepter of Sha'tar", ID: 27840, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 245.0, WeaponDamageMax: 408.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 31, stats.Stamina: 28, stats.Intellect: 26, stats.MeleeCrit: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Scepter of the False Prophet", ID: 21839, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 38.0, WeaponDamageMax: 111.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 84, QualityModifier: -24.800, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 19, stats.SpellPower: 63, stats.HealingPower: 250, stats.MP5: 3}, SocketBonus: stats.Stats{}},
 	{Name: "Scintillating Coral Band", ID: 27784, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 15, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}},
@@ -3399,7 +3399,7 @@ var Items = []Item{
 	{Name: "Scout's Throwing Knives", ID: 32832, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 106.0, WeaponDamageMax: 160.0, SwingSpeed: 2.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 18, stats.MeleeCrit: 13}, SocketBonus: stats.Stats{}},
 	{Name: "Scrolls of Blinding Light", ID: 19343, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Scryer's Blade of Focus", ID: 34895, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 19.0, WeaponDamageMax: 130.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 146, QualityModifier: -61.800, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 28, stats.SpellPower: 247, stats.HealingPower: 247}, SocketBonus: stats.Stats{}},
-	{Name: "Scryer's Bloodgem", ID: 29132, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellHit: 32}, SocketBonus: stats.Stats{}},
+	{Name: "Scryer's Bloodgem", ID: 29132, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellHit: 32}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Shattrath City", SourceVendor: "Quartermaster Enuril", ReputationFaction: "The Scryers", ReputationLevel: proto.RepLevel_RepLevelRevered},
 	{Name: "Seal of the Damned", ID: 23025, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 85, Stats: stats.Stats{stats.Stamina: 17, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellHit: 8, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Seal of the Exorcist", ID: 28555, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 95, Stats: stats.Stats{stats.Stamina: 24, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellHit: 12, stats.Resilience: 11}, SocketBonus: stats.Stats{}},
 	{Name: "Searing Sunblade", ID: 29275, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 77.0, WeaponDamageMax: 145.0, SwingSpeed: 1.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 22}, SocketBonus: stats.Stats{}},
@@ -3428,7 +3428,7 @@ var Items = []Item{
 	{Name: "Sergeant's Heavy Cape", ID: 28379, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 12, stats.SpellPower: 26, stats.HealingPower: 26, stats.Armor: 104, stats.Resilience: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Sergeant's Heavy Cloak", ID: 28377, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 38, stats.MeleeCrit: 19, stats.Armor: 104, stats.RangedAttackPower: 38, stats.Resilience: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Sergeant's Heavy Cloak", ID: 28380, Type: proto.ItemType_ItemTypeBack, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 123, Stats: stats.Stats{stats.Stamina: 27, stats.AttackPower: 38, stats.MeleeCrit: 19, stats.Armor: 104, stats.RangedAttackPower: 38, stats.Resilience: 19}, SocketBonus: stats.Stats{}},
-	{Name: "Serpent Spine Longbow", ID: 30105, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 217.0, WeaponDamageMax: 327.0, SwingSpeed: 3.00, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 17, stats.AttackPower: 38, stats.MeleeCrit: 16, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
+	{Name: "Serpent Spine Longbow", ID: 30105, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 217.0, WeaponDamageMax: 327.0, SwingSpeed: 3.00, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 17, stats.AttackPower: 38, stats.MeleeCrit: 16, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Serpent-Coil Braid", ID: 30720, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.SpellHit: 12, stats.SpellCrit: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Serpentcrest Life-Staff", ID: 27791, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 100.0, WeaponDamageMax: 187.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 46, stats.SpellPower: 76, stats.HealingPower: 303}, SocketBonus: stats.Stats{}},
 	{Name: "Serpentshrine Shuriken", ID: 30025, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 97.0, WeaponDamageMax: 146.0, SwingSpeed: 1.40, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Stamina: 14, stats.MeleeHit: 12, stats.MeleeCrit: 20}, SocketBonus: stats.Stats{}},
@@ -3438,7 +3438,7 @@ var Items = []Item{
 	{Name: "Seventh Ring of the Tirisfalen", ID: 30028, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.Defense: 17, stats.Block: 24, stats.BlockValue: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Severance", ID: 22815, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 235.0, WeaponDamageMax: 354.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Strength: 43, stats.Stamina: 18, stats.MeleeCrit: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Severin's Cane", ID: 32830, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 105.0, WeaponDamageMax: 190.0, SwingSpeed: 2.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, QualityModifier: -25.800, Stats: stats.Stats{stats.Stamina: 60, stats.Intellect: 40, stats.SpellPower: 65, stats.HealingPower: 259}, SocketBonus: stats.Stats{}},
-	{Name: "Sextant of Unstable Currents", ID: 30626, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.SpellCrit: 40}, SocketBonus: stats.Stats{}},
+	{Name: "Sextant of Unstable Currents", ID: 30626, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.SpellCrit: 40}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Fathom-Lord Karathress"},
 	{Name: "Sha'tari Anchorite's Cloak", ID: 31465, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 27, stats.SpellPower: 13, stats.HealingPower: 50, stats.Armor: 78}, SocketBonus: stats.Stats{}},
 	{Name: "Sha'tari Vindicator's Waistguard", ID: 31460, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 655, stats.Defense: 20, stats.Block: 24, stats.BlockValue: 29}, SocketBonus: stats.Stats{}},
 	{Name: "Sha'tari Wrought Armguards", ID: 28167, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 20, stats.Stamina: 18, stats.Armor: 509, stats.Defense: 12}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
@@ -3449,20 +3449,20 @@ var Items = []Item{
 	{Name: "Shadesteel Greaves", ID: 32404, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 54, stats.Armor: 1428, stats.ShadowResistance: 72}, SocketBonus: stats.Stats{}},
 	{Name: "Shadesteel Sabots", ID: 32402, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 40, stats.Armor: 1122, stats.ShadowResistance: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Shadow Wing Focus Staff", ID: 19355, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 142.0, WeaponDamageMax: 237.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, QualityModifier: -14.000, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 40, stats.Spirit: 17, stats.SpellPower: 56, stats.HealingPower: 56}, SocketBonus: stats.Stats{}},
-	{Name: "Shadow-Cloak of Dalaran", ID: 28570, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 18, stats.SpellPower: 36, stats.HealingPower: 36, stats.Armor: 97}, SocketBonus: stats.Stats{}},
-	{Name: "Shadow-walker's Cord", ID: 32265, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 38, stats.AttackPower: 76, stats.MeleeHaste: 37, stats.Armor: 249, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
+	{Name: "Shadow-Cloak of Dalaran", ID: 28570, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 18, stats.SpellPower: 36, stats.HealingPower: 36, stats.Armor: 97}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
+	{Name: "Shadow-walker's Cord", ID: 32265, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 38, stats.AttackPower: 76, stats.MeleeHaste: 37, stats.Armor: 249, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Shadowcaster's Drape", ID: 33591, Type: proto.ItemType_ItemTypeBack, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 20, stats.SpellPower: 27, stats.HealingPower: 27, stats.SpellHaste: 25, stats.Armor: 108}, SocketBonus: stats.Stats{}},
 	{Name: "Shadowed Gauntlets of Paroxysm", ID: 34234, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Agility: 41, stats.Stamina: 33, stats.AttackPower: 82, stats.MeleeHaste: 30, stats.Armor: 312, stats.RangedAttackPower: 82}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 3}},
 	{Name: "Shadowhunter's Treads", ID: 33805, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 26, stats.Stamina: 26, stats.Intellect: 25, stats.AttackPower: 66, stats.ArmorPenetration: 105, stats.Armor: 619, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}},
-	{Name: "Shadowmaster's Boots", ID: 32366, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 38, stats.AttackPower: 76, stats.MeleeCrit: 17, stats.Armor: 305, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
-	{Name: "Shadowmoon Destroyer's Drape", ID: 32323, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 72, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 118, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}},
-	{Name: "Shadowmoon Insignia", ID: 32501, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Defense: 36, stats.Dodge: 32}, SocketBonus: stats.Stats{}},
+	{Name: "Shadowmaster's Boots", ID: 32366, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 38, stats.AttackPower: 76, stats.MeleeCrit: 17, stats.Armor: 305, stats.RangedAttackPower: 76}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}, SourceZone: "Black Temple", SourceDrop: "Mother Shahraz"},
+	{Name: "Shadowmoon Destroyer's Drape", ID: 32323, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.AttackPower: 72, stats.MeleeHit: 17, stats.MeleeCrit: 24, stats.Armor: 118, stats.RangedAttackPower: 72}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
+	{Name: "Shadowmoon Insignia", ID: 32501, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Defense: 36, stats.Dodge: 32}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Shadowprowler's Chestguard", ID: 33204, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 32, stats.Agility: 40, stats.Stamina: 12, stats.MeleeHit: 8, stats.Armor: 333}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Shadowsong's Sorrow", ID: 21522, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 68.0, WeaponDamageMax: 127.0, SwingSpeed: 1.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{stats.Strength: 9, stats.Agility: 13, stats.Stamina: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Shadowstep Striders", ID: 29248, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 31, stats.Stamina: 30, stats.AttackPower: 54, stats.Armor: 240, stats.RangedAttackPower: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Shadowtooth Trollskin Cuirass", ID: 33329, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 46, stats.AttackPower: 92, stats.ArmorPenetration: 210, stats.Armor: 404, stats.RangedAttackPower: 92}, SocketBonus: stats.Stats{}},
-	{Name: "Shadowvine Cloak of Infusion", ID: 28653, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 22, stats.MP5: 9, stats.Armor: 97}, SocketBonus: stats.Stats{}},
-	{Name: "Shady Dealer's Pantaloons", ID: 30898, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 50, stats.Stamina: 61, stats.AttackPower: 102, stats.ArmorPenetration: 175, stats.Armor: 388, stats.RangedAttackPower: 102}, SocketBonus: stats.Stats{}},
+	{Name: "Shadowvine Cloak of Infusion", ID: 28653, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 22, stats.MP5: 9, stats.Armor: 97}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
+	{Name: "Shady Dealer's Pantaloons", ID: 30898, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 50, stats.Stamina: 61, stats.AttackPower: 102, stats.ArmorPenetration: 175, stats.Armor: 388, stats.RangedAttackPower: 102}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Shaffar's Band of Brutality", ID: 31920, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.AttackPower: 40, stats.MeleeHit: 19, stats.MeleeCrit: 20, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Shallow-grave Trousers", ID: 33538, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 46, stats.AttackPower: 92, stats.MeleeHaste: 30, stats.Armor: 353, stats.RangedAttackPower: 92}, SocketBonus: stats.Stats{}},
 	{Name: "Shamanistic Helmet of Second Sight", ID: 31107, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 15, stats.SpellPower: 35, stats.HealingPower: 35, stats.MP5: 4, stats.SpellCrit: 24, stats.Armor: 503}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
@@ -3471,7 +3471,7 @@ var Items = []Item{
 	{Name: "Shard of Azzinoth", ID: 32471, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 161.0, WeaponDamageMax: 242.0, SwingSpeed: 1.90, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.AttackPower: 64, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
 	{Name: "Shard of Contempt", ID: 34472, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Expertise: 44}, SocketBonus: stats.Stats{}},
 	{Name: "Shard of the Fallen Star", ID: 21891, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Shard of the Virtuous", ID: 28522, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 28.0, WeaponDamageMax: 129.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 20, stats.SpellPower: 116, stats.HealingPower: 464, stats.MP5: 6}, SocketBonus: stats.Stats{}},
+	{Name: "Shard of the Virtuous", ID: 28522, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 28.0, WeaponDamageMax: 129.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 20, stats.SpellPower: 116, stats.HealingPower: 464, stats.MP5: 6}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Shard-bound Bracers", ID: 32647, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 18, stats.AttackPower: 42, stats.Armor: 146, stats.RangedAttackPower: 42}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Sharpened Silithid Femur", ID: 21622, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 54.0, WeaponDamageMax: 137.0, SwingSpeed: 2.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, QualityModifier: -18.000, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 7, stats.SpellPower: 72, stats.HealingPower: 72, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Shattered Sun Pendant of Acumen", ID: 34678, Type: proto.ItemType_ItemTypeNeck, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 18, stats.SpellPower: 37, stats.HealingPower: 37}, SocketBonus: stats.Stats{}},
@@ -3484,18 +3484,18 @@ var Items = []Item{
 	{Name: "Shattrath Protectorate's Breastplate", ID: 34945, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 78, stats.SpellPower: 60, stats.HealingPower: 60, stats.SpellHit: 34, stats.Armor: 1765, stats.Defense: 26}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 2}},
 	{Name: "Shattrath Wraps", ID: 28174, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 21, stats.HealingPower: 21, stats.Armor: 68}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Shattrath's Champion Belt", ID: 31462, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 22, stats.AttackPower: 50, stats.MeleeHit: 15, stats.MeleeCrit: 21, stats.Armor: 367, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
-	{Name: "Shawl of Shifting Probabilities", ID: 29369, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellCrit: 22, stats.Armor: 93}, SocketBonus: stats.Stats{}},
+	{Name: "Shawl of Shifting Probabilities", ID: 29369, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellCrit: 22, stats.Armor: 93}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 25},
 	{Name: "Shawl of Wonderment", ID: 34202, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 26, stats.SpellPower: 33, stats.HealingPower: 132, stats.MP5: 9, stats.SpellHaste: 33, stats.Armor: 199}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 1}},
-	{Name: "Shermanar Great-Ring", ID: 28675, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 36, stats.Armor: 223, stats.Defense: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Shermanar Great-Ring", ID: 28675, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 36, stats.Armor: 223, stats.Defense: 23}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Shield of Condemnation", ID: 22819, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 92, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 10, stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 6, stats.Armor: 3936, stats.BlockValue: 90}, SocketBonus: stats.Stats{}},
-	{Name: "Shield of Impenetrable Darkness", ID: 28606, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 4872, stats.Defense: 22, stats.BlockValue: 155}, SocketBonus: stats.Stats{}},
+	{Name: "Shield of Impenetrable Darkness", ID: 28606, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 4872, stats.Defense: 22, stats.BlockValue: 155}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Shiffar's Nexus-Horn", ID: 28418, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellCrit: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Shifting Camouflage Pants", ID: 33527, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 34, stats.Stamina: 45, stats.Intellect: 30, stats.AttackPower: 90, stats.ArmorPenetration: 175, stats.Armor: 787, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}},
 	{Name: "Shifting Naaru Sliver", ID: 34429, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.SpellHaste: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Shimmer-pelt Vest", ID: 33322, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 35, stats.Spirit: 30, stats.SpellPower: 34, stats.HealingPower: 135, stats.MP5: 12, stats.Armor: 404}, SocketBonus: stats.Stats{}},
 	{Name: "Shimmering Azure Boots", ID: 29808, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Intellect: 19, stats.SpellPower: 23, stats.HealingPower: 23, stats.MP5: 5, stats.SpellHit: 16, stats.Armor: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Shimmering Geta", ID: 19391, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 17, stats.MP5: 12, stats.Armor: 92}, SocketBonus: stats.Stats{}},
-	{Name: "Shining Chain of the Afterworld", ID: 28731, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 21, stats.Spirit: 19, stats.SpellPower: 16, stats.HealingPower: 62}, SocketBonus: stats.Stats{}},
+	{Name: "Shining Chain of the Afterworld", ID: 28731, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 21, stats.Spirit: 19, stats.SpellPower: 16, stats.HealingPower: 62}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Shiv of Exsanguination", ID: 34197, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 136.0, WeaponDamageMax: 253.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 25, stats.AttackPower: 42, stats.ArmorPenetration: 196, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Shivering Felspine", ID: 34183, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 393.0, WeaponDamageMax: 590.0, SwingSpeed: 3.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Agility: 52, stats.AttackPower: 120, stats.MeleeHaste: 53, stats.RangedAttackPower: 120}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
 	{Name: "Shockwave Truncheon", ID: 29353, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 31.0, WeaponDamageMax: 127.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, QualityModifier: -42.000, Stats: stats.Stats{stats.Stamina: 24, stats.SpellPower: 106, stats.HealingPower: 423, stats.MP5: 6, stats.SpellCrit: 16}, SocketBonus: stats.Stats{}},
@@ -3509,14 +3509,14 @@ var Items = []Item{
 	{Name: "Shoulderpads of Renewed Life", ID: 32583, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 29, stats.SpellPower: 27, stats.HealingPower: 108, stats.SpellHaste: 38, stats.Armor: 333}, SocketBonus: stats.Stats{}},
 	{Name: "Shoulderpads of Vehemence", ID: 34195, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 45, stats.AttackPower: 90, stats.MeleeHit: 26, stats.MeleeHaste: 30, stats.Armor: 374, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}},
 	{Name: "Shoulderpads of the Silvermoon Retainer", ID: 34613, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 35, stats.Stamina: 32, stats.AttackPower: 62, stats.MeleeHit: 23, stats.Armor: 296, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
-	{Name: "Shoulderpads of the Stranger", ID: 30055, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 28, stats.AttackPower: 60, stats.MeleeCrit: 16, stats.Expertise: 10, stats.Armor: 303, stats.RangedAttackPower: 60}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 2}},
+	{Name: "Shoulderpads of the Stranger", ID: 30055, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 28, stats.AttackPower: 60, stats.MeleeCrit: 16, stats.Expertise: 10, stats.Armor: 303, stats.RangedAttackPower: 60}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Agility: 2}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Hydross the Unstable"},
 	{Name: "Shoulderplates of Everlasting Pain", ID: 34601, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 30, stats.Stamina: 33, stats.MeleeHaste: 21, stats.Armor: 1087}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Shoulders of Lightning Reflexes", ID: 32575, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 19, stats.Intellect: 21, stats.AttackPower: 74, stats.MeleeHaste: 37, stats.Armor: 741, stats.RangedAttackPower: 74}, SocketBonus: stats.Stats{}},
-	{Name: "Shoulders of the Hidden Predator", ID: 32264, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 38, stats.Stamina: 37, stats.AttackPower: 76, stats.MeleeCrit: 26, stats.Armor: 741, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
+	{Name: "Shoulders of the Hidden Predator", ID: 32264, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 38, stats.Stamina: 37, stats.AttackPower: 76, stats.MeleeCrit: 26, stats.Armor: 741, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "Shroud of Absolution", ID: 31065, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 39, stats.SpellPower: 61, stats.HealingPower: 61, stats.MP5: 12, stats.SpellHit: 20, stats.SpellCrit: 21, stats.Armor: 244}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Absolution Regalia"},
 	{Name: "Shroud of Chieftain Ner'zhul", ID: 34402, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.SpellPower: 45, stats.HealingPower: 179, stats.MP5: 13, stats.SpellHaste: 33, stats.Armor: 902}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Shroud of Dominion", ID: 23045, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 90, Stats: stats.Stats{stats.Stamina: 11, stats.AttackPower: 50, stats.MeleeCrit: 14, stats.Armor: 77, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
-	{Name: "Shroud of Forgiveness", ID: 32337, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.Spirit: 20, stats.SpellPower: 27, stats.HealingPower: 106, stats.Armor: 118}, SocketBonus: stats.Stats{}},
+	{Name: "Shroud of Forgiveness", ID: 32337, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 19, stats.Spirit: 20, stats.SpellPower: 27, stats.HealingPower: 106, stats.Armor: 118}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Shroud of Nature's Harmony", ID: 34900, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 40, stats.Spirit: 46, stats.SpellPower: 37, stats.HealingPower: 155, stats.Armor: 444}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 2}},
 	{Name: "Shroud of Pure Thought", ID: 19430, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 11, stats.SpellPower: 11, stats.HealingPower: 44, stats.MP5: 6, stats.Armor: 65}, SocketBonus: stats.Stats{}},
 	{Name: "Shroud of Redeemed Souls", ID: 34205, Type: proto.ItemType_ItemTypeBack, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 24, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 13, stats.Armor: 129}, SocketBonus: stats.Stats{}},
@@ -3524,10 +3524,10 @@ var Items = []Item{
 	{Name: "Shroud of Winter's Chill", ID: 35494, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 17, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 8, stats.Armor: 93}, SocketBonus: stats.Stats{}},
 	{Name: "Shroud of the Avatar", ID: 30159, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 35, stats.Spirit: 20, stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellHit: 19, stats.Armor: 223}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Avatar Regalia"},
 	{Name: "Shroud of the Final Stand", ID: 34012, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 22, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 11, stats.Armor: 118}, SocketBonus: stats.Stats{}},
-	{Name: "Shroud of the Highborne", ID: 32524, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 23, stats.SpellPower: 23, stats.HealingPower: 91, stats.SpellHaste: 32, stats.Armor: 126}, SocketBonus: stats.Stats{}},
+	{Name: "Shroud of the Highborne", ID: 32524, Type: proto.ItemType_ItemTypeBack, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 23, stats.SpellPower: 23, stats.HealingPower: 91, stats.SpellHaste: 32, stats.Armor: 126}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Shroud of the Incarnate", ID: 29056, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.Spirit: 21, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 17, stats.Armor: 202}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 4}, SetName: "Incarnate Regalia"},
 	{Name: "Shroud of the Lore`nial", ID: 34917, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 35, stats.Spirit: 33, stats.SpellPower: 61, stats.HealingPower: 61, stats.SpellHit: 29, stats.Armor: 236}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
-	{Name: "Shuriken of Negation", ID: 28826, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 79.0, WeaponDamageMax: 120.0, SwingSpeed: 1.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 42, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
+	{Name: "Shuriken of Negation", ID: 28826, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 79.0, WeaponDamageMax: 120.0, SwingSpeed: 1.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 42, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Sigil-Laced Boots", ID: 28406, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 18, stats.SpellPower: 20, stats.HealingPower: 20, stats.SpellCrit: 17, stats.Armor: 107}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}},
 	{Name: "Signet Ring of the Bronze Dragonflight", ID: 21199, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 75, Stats: stats.Stats{stats.Strength: 12, stats.Stamina: 22, stats.Defense: 9}, SocketBonus: stats.Stats{}},
 	{Name: "Signet Ring of the Bronze Dragonflight", ID: 21200, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 80, Stats: stats.Stats{stats.Strength: 13, stats.Stamina: 24, stats.Defense: 10}, SocketBonus: stats.Stats{}},
@@ -3539,7 +3539,7 @@ var Items = []Item{
 	{Name: "Signet of Eternal Life", ID: 33500, Type: proto.ItemType_ItemTypeFinger, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 54, stats.Resilience: 37}, SocketBonus: stats.Stats{}},
 	{Name: "Signet of Primal Wrath", ID: 33496, Type: proto.ItemType_ItemTypeFinger, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 30, stats.AttackPower: 58, stats.ArmorPenetration: 126, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
 	{Name: "Signet of Repose", ID: 27491, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 18, stats.Spirit: 20, stats.SpellPower: 9, stats.HealingPower: 35}, SocketBonus: stats.Stats{}},
-	{Name: "Signet of Unshakable Faith", ID: 28525, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 21, stats.Spirit: 22, stats.SpellPower: 13, stats.HealingPower: 50}, SocketBonus: stats.Stats{}},
+	{Name: "Signet of Unshakable Faith", ID: 28525, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 21, stats.Spirit: 22, stats.SpellPower: 13, stats.HealingPower: 50}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Moroes"},
 	{Name: "Signet of the Fallen Defender", ID: 23018, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 24, stats.MeleeHit: 10, stats.Armor: 140}, SocketBonus: stats.Stats{}},
 	{Name: "Signet of the Last Defender", ID: 33499, Type: proto.ItemType_ItemTypeFinger, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 45, stats.Block: 28, stats.BlockValue: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Signet of the Quiet Forest", ID: 33498, Type: proto.ItemType_ItemTypeFinger, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Intellect: 17, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 8, stats.SpellHaste: 30}, SocketBonus: stats.Stats{}},
@@ -3560,7 +3560,7 @@ var Items = []Item{
 	{Name: "Sinister Area 52 Boots", ID: 31312, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 11, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 92}, SocketBonus: stats.Stats{}},
 	{Name: "Skeletal Necklace of Battlerage", ID: 27551, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 26, stats.Stamina: 21, stats.MeleeHit: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Sketh'lon Survivor's Tunic", ID: 30928, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 16, stats.SpellPower: 44, stats.HealingPower: 44, stats.Armor: 137}, SocketBonus: stats.Stats{}},
-	{Name: "Skulker's Greaves", ID: 28741, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 32, stats.Stamina: 28, stats.AttackPower: 64, stats.MeleeHit: 28, stats.Armor: 319, stats.RangedAttackPower: 64}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 4}},
+	{Name: "Skulker's Greaves", ID: 28741, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 32, stats.Stamina: 28, stats.AttackPower: 64, stats.MeleeHit: 28, stats.Armor: 319, stats.RangedAttackPower: 64}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 4}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Skullshatter Warboots", ID: 33303, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 30, stats.Stamina: 37, stats.MeleeCrit: 29, stats.Armor: 1105}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 2}},
 	{Name: "Sky Breaker", ID: 27937, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 20, stats.SpellPower: 132, stats.HealingPower: 132}, SocketBonus: stats.Stats{}},
 	{Name: "Sky-Hunter Swift Boots", ID: 27915, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 19, stats.Intellect: 24, stats.MP5: 5, stats.AttackPower: 26, stats.Armor: 437, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
@@ -3610,7 +3610,7 @@ var Items = []Item{
 	{Name: "Slime-coated Leggings", ID: 21626, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 16, stats.SpellPower: 11, stats.HealingPower: 11, stats.AttackPower: 40, stats.Armor: 494, stats.RangedAttackPower: 40, stats.NatureResistance: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Slippers of Dutiful Mending", ID: 34926, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.Spirit: 34, stats.SpellPower: 29, stats.HealingPower: 115, stats.Armor: 162}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 2}},
 	{Name: "Slippers of the High Priestess", ID: 30368, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.Spirit: 20, stats.SpellPower: 28, stats.HealingPower: 28, stats.Armor: 102}, SocketBonus: stats.Stats{}},
-	{Name: "Slippers of the Seacaller", ID: 32239, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 18, stats.Spirit: 18, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 29, stats.Armor: 162}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
+	{Name: "Slippers of the Seacaller", ID: 32239, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 18, stats.Spirit: 18, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 29, stats.Armor: 162}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Slow Death Dirk", ID: 31476, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 70.0, WeaponDamageMax: 131.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Agility: 17, stats.AttackPower: 14, stats.RangedAttackPower: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Smuggler's Mitts", ID: 25576, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 19, stats.Stamina: 28, stats.AttackPower: 36, stats.Armor: 152, stats.RangedAttackPower: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Socrethar's Girdle", ID: 30372, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 23, stats.Stamina: 21, stats.AttackPower: 48, stats.MeleeHit: 20, stats.Armor: 156, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
@@ -3620,12 +3620,12 @@ var Items = []Item{
 	{Name: "Sonic Spear", ID: 27903, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 261.0, WeaponDamageMax: 392.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 35, stats.Stamina: 30, stats.AttackPower: 62, stats.MeleeHit: 24, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
 	{Name: "Soothsayer's Kilt", ID: 30929, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 16, stats.SpellPower: 30, stats.HealingPower: 30, stats.SpellCrit: 25, stats.Armor: 120}, SocketBonus: stats.Stats{}},
 	{Name: "Sorcerer's Alchemist Stone", ID: 35749, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 125, Stats: stats.Stats{stats.SpellPower: 63, stats.HealingPower: 63}, SocketBonus: stats.Stats{}},
-	{Name: "Soul Cleaver", ID: 32348, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 386.0, WeaponDamageMax: 579.0, SwingSpeed: 3.70, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 65, stats.Stamina: 63, stats.ArmorPenetration: 315}, SocketBonus: stats.Stats{}},
+	{Name: "Soul Cleaver", ID: 32348, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 386.0, WeaponDamageMax: 579.0, SwingSpeed: 3.70, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 65, stats.Stamina: 63, stats.ArmorPenetration: 315}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Soul Saver's Chest Plate", ID: 31459, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 21, stats.SpellPower: 13, stats.HealingPower: 13, stats.MeleeHit: 15, stats.MeleeCrit: 37, stats.Armor: 996}, SocketBonus: stats.Stats{}},
 	{Name: "Soul-Collar of the Incarnate", ID: 29058, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 35, stats.Spirit: 24, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHit: 16, stats.Armor: 164}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Incarnate Regalia"},
-	{Name: "Soul-Eater's Handwraps", ID: 28780, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 24, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 21, stats.Armor: 132}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
+	{Name: "Soul-Eater's Handwraps", ID: 28780, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 24, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 21, stats.Armor: 132}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Soul-Mantle of the Incarnate", ID: 29060, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 28, stats.Spirit: 18, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 152}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellHit: 3}, SetName: "Incarnate Regalia"},
-	{Name: "Soul-Strider Boots", ID: 30100, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 19, stats.Spirit: 33, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 148}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
+	{Name: "Soul-Strider Boots", ID: 30100, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 19, stats.Spirit: 33, stats.SpellPower: 22, stats.HealingPower: 86, stats.Armor: 148}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Fathom-Lord Karathress"},
 	{Name: "Soul-Wand of the Aldor", ID: 27885, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 158.0, WeaponDamageMax: 293.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 8, stats.Spirit: 10, stats.SpellPower: 8, stats.HealingPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Soulcloth Gloves", ID: 21863, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 13, stats.Spirit: 9, stats.Armor: 106, stats.ArcaneResistance: 35}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}, SetName: "Soulcloth Embrace"},
 	{Name: "Soulcloth Shoulders", ID: 21864, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 15, stats.Spirit: 11, stats.Armor: 127, stats.ArcaneResistance: 30}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 3}, SetName: "Soulcloth Embrace"},
@@ -3652,7 +3652,7 @@ var Items = []Item{
 	{Name: "Spaulders of the Thalassian Defender", ID: 34389, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 60, stats.Intellect: 24, stats.SpellPower: 37, stats.HealingPower: 37, stats.Armor: 1487, stats.Defense: 27, stats.BlockValue: 51}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}},
 	{Name: "Spaulders of the Thalassian Savior", ID: 34193, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 25, stats.SpellPower: 33, stats.HealingPower: 132, stats.MP5: 14, stats.SpellCrit: 22, stats.Armor: 1487}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}},
 	{Name: "Spaulders of the Torn-heart", ID: 30925, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 7, stats.Spirit: 8, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellCrit: 18, stats.Armor: 111}, SocketBonus: stats.Stats{}},
-	{Name: "Spectral Band of Innervation", ID: 28510, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.SpellPower: 29, stats.HealingPower: 29}, SocketBonus: stats.Stats{}},
+	{Name: "Spectral Band of Innervation", ID: 28510, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.SpellPower: 29, stats.HealingPower: 29}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Spellbreaker's Buckler", ID: 30984, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 10, stats.SpellPower: 29, stats.HealingPower: 29, stats.Armor: 3615, stats.BlockValue: 80}, SocketBonus: stats.Stats{}},
 	{Name: "Spellfire Belt", ID: 21846, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 18, stats.ArcaneSpellPower: 50, stats.FireSpellPower: 50, stats.SpellCrit: 18, stats.Armor: 100}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}, SetName: "Wrath of Spellfire"},
 	{Name: "Spellfire Gloves", ID: 21847, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 10, stats.ArcaneSpellPower: 50, stats.FireSpellPower: 50, stats.SpellCrit: 23, stats.Armor: 111}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}, SetName: "Wrath of Spellfire"},
@@ -3667,8 +3667,8 @@ var Items = []Item{
 	{Name: "Spiritcaller's Mask", ID: 31688, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 22, stats.AttackPower: 42, stats.MeleeCrit: 37, stats.Armor: 203, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
 	{Name: "Spiritualist's Gauntlets", ID: 29959, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 27, stats.SpellPower: 20, stats.HealingPower: 20, stats.MP5: 5, stats.Armor: 623, stats.Defense: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Spiritualist's Mark of the Sha'tar", ID: 31383, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.Spirit: 12, stats.SpellPower: 22, stats.HealingPower: 86}, SocketBonus: stats.Stats{}},
-	{Name: "Spiritwalker Gauntlets", ID: 32275, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 38, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 617}, SocketBonus: stats.Stats{}},
-	{Name: "Spiteblade", ID: 28729, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 165.0, WeaponDamageMax: 308.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 16, stats.AttackPower: 46, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
+	{Name: "Spiritwalker Gauntlets", ID: 32275, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 38, stats.Intellect: 27, stats.SpellPower: 28, stats.HealingPower: 112, stats.SpellHaste: 37, stats.Armor: 617}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
+	{Name: "Spiteblade", ID: 28729, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 165.0, WeaponDamageMax: 308.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 16, stats.AttackPower: 46, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
 	{Name: "Spyglass of the Hidden Fleet", ID: 30620, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Spymaster's Crossbow", ID: 30397, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 104.0, WeaponDamageMax: 195.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 7, stats.Intellect: 5, stats.AttackPower: 24, stats.RangedAttackPower: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Spymistress's Boots", ID: 28178, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 36, stats.Armor: 201, stats.RangedAttackPower: 36, stats.Dodge: 27}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 4}},
@@ -3676,8 +3676,8 @@ var Items = []Item{
 	{Name: "Staff of Dark Mending", ID: 33490, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 144.0, WeaponDamageMax: 303.0, SwingSpeed: 3.20, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, QualityModifier: -54.300, Stats: stats.Stats{stats.Stamina: 46, stats.Intellect: 47, stats.SpellPower: 136, stats.HealingPower: 543, stats.MP5: 16}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
 	{Name: "Staff of Disintegration", ID: 30313, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 131.0, WeaponDamageMax: 309.0, SwingSpeed: 2.80, Phase: 0, Quality: proto.ItemQuality_ItemQualityLegendary, Unique: true, Ilvl: 175, QualityModifier: -80.300, Stats: stats.Stats{stats.Stamina: 75, stats.Intellect: 50, stats.SpellPower: 325, stats.HealingPower: 325, stats.SpellCrit: 75, stats.FeralAttackPower: 1459}, SocketBonus: stats.Stats{}},
 	{Name: "Staff of Divine Infusion", ID: 31289, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 154.0, WeaponDamageMax: 277.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, QualityModifier: -25.800, Stats: stats.Stats{stats.Intellect: 23, stats.Spirit: 50, stats.SpellPower: 65, stats.HealingPower: 259}, SocketBonus: stats.Stats{}},
-	{Name: "Staff of Immaculate Recovery", ID: 32344, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 145.0, WeaponDamageMax: 312.0, SwingSpeed: 3.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 73, stats.Intellect: 51, stats.Spirit: 35, stats.SpellPower: 148, stats.HealingPower: 591, stats.MP5: 14}, SocketBonus: stats.Stats{}},
-	{Name: "Staff of Infinite Mysteries", ID: 28633, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 290.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 61, stats.Intellect: 51, stats.SpellPower: 185, stats.HealingPower: 185, stats.SpellHit: 23}, SocketBonus: stats.Stats{}},
+	{Name: "Staff of Immaculate Recovery", ID: 32344, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 145.0, WeaponDamageMax: 312.0, SwingSpeed: 3.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 73, stats.Intellect: 51, stats.Spirit: 35, stats.SpellPower: 148, stats.HealingPower: 591, stats.MP5: 14}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
+	{Name: "Staff of Infinite Mysteries", ID: 28633, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 143.0, WeaponDamageMax: 290.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, QualityModifier: -46.300, Stats: stats.Stats{stats.Stamina: 61, stats.Intellect: 51, stats.SpellPower: 185, stats.HealingPower: 185, stats.SpellHit: 23}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Curator"},
 	{Name: "Staff of Natural Fury", ID: 31334, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 134.0, WeaponDamageMax: 261.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, QualityModifier: -39.800, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 31, stats.Armor: 281, stats.FeralAttackPower: 712}, SocketBonus: stats.Stats{}},
 	{Name: "Staff of Primal Fury", ID: 33465, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 135.0, WeaponDamageMax: 285.0, SwingSpeed: 3.00, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, QualityModifier: -54.300, Stats: stats.Stats{stats.Agility: 49, stats.Stamina: 70, stats.ArmorPenetration: 315, stats.FeralAttackPower: 973}, SocketBonus: stats.Stats{}},
 	{Name: "Staff of the Ashtongue Deathsworn", ID: 31417, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 163.0, WeaponDamageMax: 293.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, QualityModifier: -25.800, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 45, stats.Spirit: 25, stats.SpellPower: 65, stats.HealingPower: 259}, SocketBonus: stats.Stats{}},
@@ -3686,7 +3686,7 @@ var Items = []Item{
 	{Name: "Staff of the Redeemer", ID: 31038, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 152.0, WeaponDamageMax: 273.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 108, QualityModifier: -25.800, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 45, stats.SpellPower: 65, stats.HealingPower: 259, stats.MP5: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Staff of the Shadow Flame", ID: 19356, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 142.0, WeaponDamageMax: 248.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, QualityModifier: -21.000, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 29, stats.Spirit: 18, stats.SpellPower: 84, stats.HealingPower: 84, stats.SpellCrit: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Stag-Helm of Malorne", ID: 29098, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 41, stats.Agility: 33, stats.Stamina: 30, stats.Intellect: 19, stats.Armor: 490}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Agility: 4}, SetName: "Malorne Harness"},
-	{Name: "Stainless Cloak of the Pure Hearted", ID: 28765, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 7, stats.Armor: 105}, SocketBonus: stats.Stats{}},
+	{Name: "Stainless Cloak of the Pure Hearted", ID: 28765, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 23, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 7, stats.Armor: 105}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Stalker's Chain Armor", ID: 35376, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 42, stats.Intellect: 15, stats.AttackPower: 12, stats.MeleeCrit: 12, stats.Armor: 652, stats.RangedAttackPower: 12, stats.Resilience: 16}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SetName: "Stalker's Chain Battlegear"},
 	{Name: "Stalker's Chain Gauntlets", ID: 35377, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 31, stats.Intellect: 6, stats.AttackPower: 20, stats.MeleeCrit: 11, stats.Armor: 407, stats.RangedAttackPower: 20, stats.Resilience: 14}, SocketBonus: stats.Stats{}, SetName: "Stalker's Chain Battlegear"},
 	{Name: "Stalker's Chain Gauntlets", ID: 35475, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 31, stats.Intellect: 6, stats.AttackPower: 20, stats.MeleeCrit: 11, stats.Armor: 407, stats.RangedAttackPower: 20, stats.Resilience: 14}, SocketBonus: stats.Stats{}, SetName: "Stalker's Chain Battlegear"},
@@ -3694,11 +3694,11 @@ var Items = []Item{
 	{Name: "Stalker's Chain Leggings", ID: 35379, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 42, stats.Intellect: 8, stats.AttackPower: 28, stats.MeleeCrit: 14, stats.Armor: 570, stats.RangedAttackPower: 28, stats.Resilience: 25}, SocketBonus: stats.Stats{}, SetName: "Stalker's Chain Battlegear"},
 	{Name: "Stalker's Chain Spaulders", ID: 35380, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 31, stats.Intellect: 4, stats.AttackPower: 22, stats.MeleeCrit: 17, stats.Armor: 489, stats.RangedAttackPower: 22, stats.Resilience: 16}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Resilience: 3}, SetName: "Stalker's Chain Battlegear"},
 	{Name: "Stalker's Helmet of Second Sight", ID: 31106, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 31, stats.Stamina: 12, stats.Intellect: 8, stats.MP5: 4, stats.AttackPower: 60, stats.Armor: 503, stats.RangedAttackPower: 60}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
-	{Name: "Stalker's War Bands", ID: 28454, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 18, stats.Intellect: 17, stats.AttackPower: 44, stats.Armor: 355, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
+	{Name: "Stalker's War Bands", ID: 28454, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 18, stats.Intellect: 17, stats.AttackPower: 44, stats.Armor: 355, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Stanchion of Primal Instinct", ID: 34198, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 137.0, WeaponDamageMax: 306.0, SwingSpeed: 3.00, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, QualityModifier: -66.500, Stats: stats.Stats{stats.Strength: 47, stats.Agility: 75, stats.Stamina: 50, stats.ArmorPenetration: 350, stats.FeralAttackPower: 1197}, SocketBonus: stats.Stats{}},
 	{Name: "Star-Heart Lamp", ID: 28187, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 18, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellHit: 12}, SocketBonus: stats.Stats{}},
-	{Name: "Star-Soul Breeches", ID: 29977, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 52, stats.SpellPower: 34, stats.HealingPower: 135, stats.Armor: 188}, SocketBonus: stats.Stats{}},
-	{Name: "Star-Strider Boots", ID: 29951, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 13, stats.Intellect: 18, stats.MP5: 4, stats.AttackPower: 66, stats.Armor: 619, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
+	{Name: "Star-Soul Breeches", ID: 29977, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 27, stats.Spirit: 52, stats.SpellPower: 34, stats.HealingPower: 135, stats.Armor: 188}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
+	{Name: "Star-Strider Boots", ID: 29951, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 33, stats.Stamina: 13, stats.Intellect: 18, stats.MP5: 4, stats.AttackPower: 66, stats.Armor: 619, stats.RangedAttackPower: 66}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Starbolt Longbow", ID: 27817, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 130.0, WeaponDamageMax: 242.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.MeleeCrit: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Starcaller's Plated Belt", ID: 30330, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 16, stats.SpellPower: 23, stats.HealingPower: 23, stats.Armor: 590, stats.Defense: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Starcaller's Plated Legguards", ID: 30299, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 18, stats.SpellPower: 30, stats.HealingPower: 118, stats.MP5: 7, stats.SpellCrit: 17, stats.Armor: 968}, SocketBonus: stats.Stats{}},
@@ -3713,14 +3713,14 @@ var Items = []Item{
 	{Name: "Stealther's Helmet of Second Sight", ID: 31109, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 18, stats.AttackPower: 60, stats.MeleeHit: 13, stats.Armor: 226, stats.RangedAttackPower: 60}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
 	{Name: "Steam-Hinge Chain of Valor", ID: 27792, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 26, stats.Block: 19, stats.BlockValue: 29}, SocketBonus: stats.Stats{}},
 	{Name: "Steelgrip Gauntlets", ID: 23533, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 28, stats.Stamina: 36, stats.Armor: 831}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 3}},
-	{Name: "Steelhawk Crossbow", ID: 28504, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 155.0, WeaponDamageMax: 288.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 30, stats.MeleeHit: 16, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
+	{Name: "Steelhawk Crossbow", ID: 28504, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 155.0, WeaponDamageMax: 288.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 30, stats.MeleeHit: 16, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Steelscale Crushfish", ID: 6360, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 22.0, WeaponDamageMax: 42.0, SwingSpeed: 2.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 25, Stats: stats.Stats{stats.Strength: 4}, SocketBonus: stats.Stats{}},
-	{Name: "Steelspine Faceguard", ID: 28671, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 34, stats.Intellect: 34, stats.MP5: 9, stats.AttackPower: 62, stats.Armor: 659, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
+	{Name: "Steelspine Faceguard", ID: 28671, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 36, stats.Stamina: 34, stats.Intellect: 34, stats.MP5: 9, stats.AttackPower: 62, stats.Armor: 659, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Steely Naaru Sliver", ID: 34428, Type: proto.ItemType_ItemTypeTrinket, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 154, Stats: stats.Stats{stats.Expertise: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Stellaris", ID: 28263, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 95.0, WeaponDamageMax: 177.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 21, stats.Stamina: 12, stats.AttackPower: 22, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
-	{Name: "Stillwater Boots", ID: 30873, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 36, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 10, stats.Armor: 679}, SocketBonus: stats.Stats{}},
+	{Name: "Stillwater Boots", ID: 30873, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 36, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 10, stats.Armor: 679}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Stillwater Girdle", ID: 27835, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 26, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 8, stats.Armor: 367}, SocketBonus: stats.Stats{}},
-	{Name: "Stonebough Jerkin", ID: 28600, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 35, stats.Spirit: 31, stats.SpellPower: 26, stats.HealingPower: 103, stats.MP5: 9, stats.Armor: 364}, SocketBonus: stats.Stats{}},
+	{Name: "Stonebough Jerkin", ID: 28600, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 35, stats.Spirit: 31, stats.SpellPower: 26, stats.HealingPower: 103, stats.MP5: 9, stats.Armor: 364}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Stonebreaker's Totem", ID: 33507, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Storm Gauntlets", ID: 12632, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 59, Stats: stats.Stats{stats.Intellect: 7, stats.NatureSpellPower: 21, stats.Armor: 218, stats.FireResistance: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Storm Helm", ID: 23534, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 28, stats.MP5: 9, stats.AttackPower: 44, stats.MeleeCrit: 22, stats.Armor: 604, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
@@ -3741,7 +3741,7 @@ var Items = []Item{
 	{Name: "Stormrage Handguards", ID: 16899, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 19, stats.Spirit: 15, stats.SpellPower: 14, stats.HealingPower: 56, stats.Armor: 160, stats.ShadowResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Stormrage Raiment"},
 	{Name: "Stormrage Legguards", ID: 16901, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 26, stats.Spirit: 16, stats.SpellPower: 16, stats.HealingPower: 64, stats.MP5: 6, stats.Armor: 224, stats.ArcaneResistance: 10, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Stormrage Raiment"},
 	{Name: "Stormrage Pauldrons", ID: 16902, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 21, stats.Spirit: 10, stats.SpellPower: 10, stats.HealingPower: 39, stats.MP5: 4, stats.Armor: 192, stats.FireResistance: 10}, SocketBonus: stats.Stats{}, SetName: "Stormrage Raiment"},
-	{Name: "Stormrage Signet Ring", ID: 32497, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 33, stats.AttackPower: 66, stats.MeleeHit: 30, stats.ArmorPenetration: 126, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}},
+	{Name: "Stormrage Signet Ring", ID: 32497, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.Stamina: 33, stats.AttackPower: 66, stats.MeleeHit: 30, stats.ArmorPenetration: 126, stats.RangedAttackPower: 66}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Stormrage's Talisman of Seething", ID: 23053, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 92, Stats: stats.Stats{stats.Stamina: 12, stats.AttackPower: 26, stats.MeleeCrit: 28, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Stormreaver Warblades", ID: 28315, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 80.0, WeaponDamageMax: 149.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 22, stats.MeleeCrit: 21, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Stormshield of Renewal", ID: 27772, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 16, stats.SpellPower: 19, stats.HealingPower: 76, stats.Armor: 3806, stats.BlockValue: 86}, SocketBonus: stats.Stats{}},
@@ -3769,20 +3769,20 @@ var Items = []Item{
 	{Name: "Sun-Gilded Shouldercaps", ID: 27995, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Agility: 25, stats.Stamina: 26, stats.AttackPower: 48, stats.MeleeHit: 15, stats.Armor: 214, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "Sun-forged Cleaver", ID: 34699, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 130.0, WeaponDamageMax: 243.0, SwingSpeed: 2.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 28, stats.MeleeCrit: 13, stats.MeleeHaste: 15, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Sun-infused Focus Staff", ID: 34797, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 106.0, WeaponDamageMax: 196.0, SwingSpeed: 2.40, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 27, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellHit: 23}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
-	{Name: "Sun-touched Chain Leggings", ID: 30893, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 28, stats.SpellPower: 37, stats.HealingPower: 147, stats.MP5: 16, stats.Armor: 864}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
+	{Name: "Sun-touched Chain Leggings", ID: 30893, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 28, stats.SpellPower: 37, stats.HealingPower: 147, stats.MP5: 16, stats.Armor: 864}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SourceZone: "Hyjal Summit", SourceDrop: "Kaz'rogal"},
 	{Name: "Sunblessed Breastplate", ID: 34379, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 51, stats.SpellPower: 38, stats.HealingPower: 152, stats.MP5: 14, stats.SpellCrit: 34, stats.Armor: 1983}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Sunblessed Gauntlets", ID: 34380, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 31, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 8, stats.SpellCrit: 36, stats.Armor: 1239}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MP5: 1}},
 	{Name: "Sunfire Handwraps", ID: 34366, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 30, stats.SpellPower: 53, stats.HealingPower: 53, stats.SpellCrit: 37, stats.Armor: 166}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
 	{Name: "Sunfire Robe", ID: 34364, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 34, stats.SpellPower: 71, stats.HealingPower: 71, stats.SpellCrit: 40, stats.SpellHaste: 40, stats.Armor: 266}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
 	{Name: "Sunflare", ID: 34336, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 13.0, WeaponDamageMax: 137.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, QualityModifier: -73.000, Stats: stats.Stats{stats.Stamina: 17, stats.Intellect: 20, stats.SpellPower: 292, stats.HealingPower: 292, stats.SpellCrit: 30, stats.SpellHaste: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Sunfury Blade", ID: 30394, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 224.0, WeaponDamageMax: 337.0, SwingSpeed: 3.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 39, stats.MeleeCrit: 21, stats.Expertise: 16}, SocketBonus: stats.Stats{}},
-	{Name: "Sunfury Bow of the Phoenix", ID: 28772, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 169.0, WeaponDamageMax: 314.0, SwingSpeed: 2.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 19, stats.AttackPower: 34, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
+	{Name: "Sunfury Bow of the Phoenix", ID: 28772, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 169.0, WeaponDamageMax: 314.0, SwingSpeed: 2.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 19, stats.AttackPower: 34, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "Sunfury Legguards", ID: 30948, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 25, stats.Intellect: 12, stats.MP5: 5, stats.AttackPower: 90, stats.Armor: 542, stats.RangedAttackPower: 90}, SocketBonus: stats.Stats{}},
 	{Name: "Sunglow Vest", ID: 34212, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 41, stats.SpellPower: 45, stats.HealingPower: 179, stats.MP5: 13, stats.SpellHaste: 33, stats.Armor: 499}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MP5: 2}},
 	{Name: "Sunguard Legplates", ID: 34940, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 78, stats.Expertise: 23, stats.Armor: 1544, stats.Defense: 43, stats.Dodge: 35}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 3}},
-	{Name: "Sunhawk Leggings", ID: 29991, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 31, stats.SpellPower: 36, stats.HealingPower: 144, stats.MP5: 13, stats.Armor: 847}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
+	{Name: "Sunhawk Leggings", ID: 29991, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 31, stats.SpellPower: 36, stats.HealingPower: 144, stats.MP5: 13, stats.Armor: 847}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Sunrage Treads", ID: 34809, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 28, stats.AttackPower: 58, stats.ArmorPenetration: 126, stats.Armor: 240, stats.RangedAttackPower: 58}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
-	{Name: "Sunshower Light Cloak", ID: 29989, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 24, stats.Spirit: 20, stats.SpellPower: 26, stats.HealingPower: 103, stats.Armor: 116}, SocketBonus: stats.Stats{}},
+	{Name: "Sunshower Light Cloak", ID: 29989, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 24, stats.Spirit: 20, stats.SpellPower: 26, stats.HealingPower: 103, stats.Armor: 116}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Sunstrider Warboots", ID: 34807, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 29, stats.Stamina: 30, stats.ArmorPenetration: 140, stats.Armor: 955}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Strength: 3}},
 	{Name: "Sunward Crest", ID: 34675, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 31, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellHit: 13, stats.Armor: 4872, stats.Block: 18, stats.BlockValue: 122}, SocketBonus: stats.Stats{}},
 	{Name: "Supple Leather Boots", ID: 31532, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 22, stats.Agility: 11, stats.Stamina: 24, stats.Armor: 352}, SocketBonus: stats.Stats{}},
@@ -3803,23 +3803,23 @@ var Items = []Item{
 	{Name: "Sword Breaker's Bulwark", ID: 34185, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 49, stats.Armor: 6459, stats.Defense: 20, stats.Block: 16, stats.BlockValue: 225}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Sylvanaar Champion's Shoulders", ID: 31436, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Strength: 23, stats.Stamina: 22, stats.MeleeCrit: 20, stats.Armor: 747}, SocketBonus: stats.Stats{}},
 	{Name: "Sylvanaar Elite Caster's Armor", ID: 31433, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 20, stats.Spirit: 10, stats.SpellPower: 42, stats.HealingPower: 42, stats.Armor: 130}, SocketBonus: stats.Stats{}},
-	{Name: "Syphon of the Nathrezim", ID: 32262, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 196.0, WeaponDamageMax: 365.0, SwingSpeed: 2.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 50, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
+	{Name: "Syphon of the Nathrezim", ID: 32262, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 196.0, WeaponDamageMax: 365.0, SwingSpeed: 2.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 50, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Syrannis' Mystic Sheen", ID: 28301, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Armor: 234, stats.ArcaneResistance: 12, stats.FireResistance: 12, stats.FrostResistance: 12, stats.NatureResistance: 12, stats.ShadowResistance: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Talbuk Cape", ID: 25636, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 21, stats.AttackPower: 28, stats.Armor: 65, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Talbuk Dirk", ID: 25545, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 70.0, WeaponDamageMax: 131.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 20, stats.MeleeHit: 11, stats.RangedAttackPower: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Talbuk Hide Spaulders", ID: 29147, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.AttackPower: 70, stats.MeleeHit: 15, stats.Armor: 219, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
 	{Name: "Talbuk Sticker", ID: 25543, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 36.0, WeaponDamageMax: 87.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, QualityModifier: -14.500, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 11, stats.SpellPower: 58, stats.HealingPower: 58, stats.SpellCrit: 10}, SocketBonus: stats.Stats{}},
 	{Name: "Talisman of Kalecgos", ID: 29271, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Intellect: 14, stats.ArcaneSpellPower: 50}, SocketBonus: stats.Stats{}},
-	{Name: "Talisman of Nightbane", ID: 28603, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 19, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}},
+	{Name: "Talisman of Nightbane", ID: 28603, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 19, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Nightbane"},
 	{Name: "Talisman of the Alliance", ID: 25829, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{stats.Resilience: 34}, SocketBonus: stats.Stats{}},
 	{Name: "Talisman of the Breaker", ID: 29347, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 17, stats.SpellPower: 23, stats.HealingPower: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Talisman of the Horde", ID: 24551, Type: proto.ItemType_ItemTypeTrinket, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 90, Stats: stats.Stats{stats.Resilience: 34}, SocketBonus: stats.Stats{}},
-	{Name: "Talisman of the Sun King", ID: 29923, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 24, stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 6}, SocketBonus: stats.Stats{}},
+	{Name: "Talisman of the Sun King", ID: 29923, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 24, stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 6}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Talon Lord's Collar", ID: 29335, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.AttackPower: 38, stats.MeleeHit: 21, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Talon of Al'ar", ID: 30448, ClassAllowlist: []proto.Class{proto.Class_ClassHunter}, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Talon of Anzu", ID: 32781, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 75.0, WeaponDamageMax: 140.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 10, stats.AttackPower: 36, stats.RangedAttackPower: 36}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
-	{Name: "Talon of Azshara", ID: 30082, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 182.0, WeaponDamageMax: 339.0, SwingSpeed: 2.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 40, stats.MeleeHit: 20, stats.Armor: 168, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
-	{Name: "Talon of the Phoenix", ID: 32944, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 182.0, WeaponDamageMax: 339.0, SwingSpeed: 2.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.AttackPower: 52, stats.MeleeHit: 15, stats.MeleeCrit: 19, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}},
+	{Name: "Talon of Azshara", ID: 30082, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 182.0, WeaponDamageMax: 339.0, SwingSpeed: 2.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 134, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 40, stats.MeleeHit: 20, stats.Armor: 168, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
+	{Name: "Talon of the Phoenix", ID: 32944, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 182.0, WeaponDamageMax: 339.0, SwingSpeed: 2.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.AttackPower: 52, stats.MeleeHit: 15, stats.MeleeCrit: 19, stats.RangedAttackPower: 52}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Al'ar"},
 	{Name: "Talon of the Tempest", ID: 30723, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 26.0, WeaponDamageMax: 123.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 120, QualityModifier: -48.500, Stats: stats.Stats{stats.Intellect: 10, stats.SpellPower: 194, stats.HealingPower: 194, stats.SpellHit: 9, stats.SpellCrit: 19}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Intellect: 3}},
 	{Name: "Tameless Breeches", ID: 34910, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 39, stats.Agility: 45, stats.Stamina: 52, stats.Intellect: 20, stats.MeleeHaste: 17, stats.Armor: 654}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
 	{Name: "Tankatronic Goggles", ID: 32473, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Stamina: 67, stats.MeleeHit: 13, stats.Armor: 1296, stats.Defense: 38, stats.Dodge: 22}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
@@ -3829,14 +3829,14 @@ var Items = []Item{
 	{Name: "Taut Dragonhide Gloves", ID: 19390, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 20, stats.Intellect: 20, stats.MP5: 6, stats.SpellCrit: 14, stats.Armor: 162}, SocketBonus: stats.Stats{}},
 	{Name: "Taut Dragonhide Shoulderpads", ID: 19389, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 77, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 46, stats.Armor: 195, stats.RangedAttackPower: 46}, SocketBonus: stats.Stats{}},
 	{Name: "Tears of Heaven", ID: 29274, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.SpellPower: 25, stats.HealingPower: 100, stats.MP5: 6}, SocketBonus: stats.Stats{}},
-	{Name: "Teeth of Gruul", ID: 28822, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 19, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 8}, SocketBonus: stats.Stats{}},
+	{Name: "Teeth of Gruul", ID: 28822, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Intellect: 21, stats.Spirit: 19, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 8}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Telaari Hunting Girdle", ID: 27521, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 24, stats.Intellect: 17, stats.MP5: 6, stats.AttackPower: 50, stats.Armor: 367, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "Telescopic Sharprifle", ID: 28286, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 139.0, WeaponDamageMax: 259.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 13, stats.AttackPower: 28, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "Telonicus's Pendant of Mayhem", ID: 30017, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 26, stats.AttackPower: 70, stats.RangedAttackPower: 70}, SocketBonus: stats.Stats{}},
 	{Name: "Tempest Leggings", ID: 29141, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 11, stats.SpellPower: 44, stats.HealingPower: 44, stats.SpellCrit: 18, stats.Armor: 256}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 2}},
-	{Name: "Tempest of Chaos", ID: 30910, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 16.0, WeaponDamageMax: 131.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 22, stats.SpellPower: 259, stats.HealingPower: 259, stats.SpellHit: 17, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}},
-	{Name: "Tempest-Strider Boots", ID: 30066, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.SpellPower: 17, stats.HealingPower: 68, stats.MP5: 16, stats.Armor: 619}, SocketBonus: stats.Stats{}},
-	{Name: "Terestian's Stranglestaff", ID: 28658, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 133.0, WeaponDamageMax: 271.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, QualityModifier: -46.800, Stats: stats.Stats{stats.Strength: 38, stats.Agility: 37, stats.Stamina: 48, stats.MeleeHit: 25, stats.FeralAttackPower: 829}, SocketBonus: stats.Stats{}},
+	{Name: "Tempest of Chaos", ID: 30910, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 16.0, WeaponDamageMax: 131.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 22, stats.SpellPower: 259, stats.HealingPower: 259, stats.SpellHit: 17, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
+	{Name: "Tempest-Strider Boots", ID: 30066, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 25, stats.Intellect: 26, stats.SpellPower: 17, stats.HealingPower: 68, stats.MP5: 16, stats.Armor: 619}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
+	{Name: "Terestian's Stranglestaff", ID: 28658, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 133.0, WeaponDamageMax: 271.0, SwingSpeed: 3.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, QualityModifier: -46.800, Stats: stats.Stats{stats.Strength: 38, stats.Agility: 37, stats.Stamina: 48, stats.MeleeHit: 25, stats.FeralAttackPower: 829}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
 	{Name: "Ternary Mantle", ID: 21694, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 17, stats.Spirit: 20, stats.SpellPower: 15, stats.HealingPower: 59, stats.Armor: 98}, SocketBonus: stats.Stats{}},
 	{Name: "Terokk's Mask", ID: 29332, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.AttackPower: 66, stats.MeleeCrit: 30, stats.Armor: 237, stats.RangedAttackPower: 66, stats.Dodge: 36}, SocketBonus: stats.Stats{}},
 	{Name: "Terokk's Might", ID: 32540, Type: proto.ItemType_ItemTypeBack, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Agility: 16, stats.Stamina: 18, stats.AttackPower: 56, stats.Armor: 89, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
@@ -3845,11 +3845,11 @@ var Items = []Item{
 	{Name: "Terokk's Shadowstaff", ID: 29355, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 144.0, WeaponDamageMax: 283.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, QualityModifier: -42.000, Stats: stats.Stats{stats.Stamina: 40, stats.Intellect: 42, stats.SpellPower: 168, stats.HealingPower: 168, stats.SpellCrit: 37}, SocketBonus: stats.Stats{}},
 	{Name: "Terokk's Wisdom", ID: 32541, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 16, stats.SpellPower: 33, stats.HealingPower: 33, stats.Armor: 89}, SocketBonus: stats.Stats{}},
 	{Name: "Terror Flame Dagger", ID: 27463, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 103.0, WeaponDamageMax: 155.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 15, stats.AttackPower: 34, stats.MeleeHit: 14, stats.RangedAttackPower: 34}, SocketBonus: stats.Stats{}},
-	{Name: "Terror Pit Girdle", ID: 28778, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 22, stats.MP5: 8, stats.AttackPower: 44, stats.MeleeCrit: 21, stats.Armor: 495, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Terror Pit Girdle", ID: 28778, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 22, stats.MP5: 8, stats.AttackPower: 44, stats.MeleeCrit: 21, stats.Armor: 495, stats.RangedAttackPower: 44}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Terrorweave Tunic", ID: 30730, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.AttackPower: 96, stats.MeleeHit: 21, stats.MeleeCrit: 25, stats.Armor: 379, stats.RangedAttackPower: 96}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}},
 	{Name: "Thadell's Bracers", ID: 30400, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 12, stats.SpellPower: 16, stats.HealingPower: 16, stats.Armor: 448, stats.Defense: 9}, SocketBonus: stats.Stats{}},
 	{Name: "Thalassian Ranger Gauntlets", ID: 34343, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 164, Stats: stats.Stats{stats.Agility: 40, stats.Stamina: 43, stats.Intellect: 27, stats.AttackPower: 80, stats.MeleeCrit: 23, stats.ArmorPenetration: 182, stats.Armor: 715, stats.RangedAttackPower: 80}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Agility: 3}},
-	{Name: "Thalassian Wildercloak", ID: 29994, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 28, stats.AttackPower: 68, stats.Armor: 116, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}},
+	{Name: "Thalassian Wildercloak", ID: 29994, Type: proto.ItemType_ItemTypeBack, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 28, stats.AttackPower: 68, stats.Armor: 116, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "Thalodien's Charm", ID: 30378, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 37, stats.Defense: 15, stats.BlockValue: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Thatia's Self-Correcting Gauntlets", ID: 28390, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 16, stats.Stamina: 35, stats.Armor: 728, stats.Defense: 18, stats.BlockValue: 39}, SocketBonus: stats.Stats{}},
 	{Name: "The 2 Ring", ID: 34837, Type: proto.ItemType_ItemTypeFinger, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 135, Stats: stats.Stats{stats.Strength: 22, stats.Agility: 22, stats.Stamina: 22, stats.Intellect: 22, stats.Spirit: 22}, SocketBonus: stats.Stats{}},
@@ -3860,12 +3860,12 @@ var Items = []Item{
 	{Name: "The Bladefist", ID: 29348, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 152.0, WeaponDamageMax: 283.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "The Boomstick", ID: 32780, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 92.0, WeaponDamageMax: 173.0, SwingSpeed: 2.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Defense: 13}, SocketBonus: stats.Stats{}},
 	{Name: "The Bringer of Death", ID: 31308, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 106.0, WeaponDamageMax: 196.0, SwingSpeed: 2.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 32, stats.Intellect: 31, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellCrit: 42}, SocketBonus: stats.Stats{}},
-	{Name: "The Brutalizer", ID: 32254, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 128.0, WeaponDamageMax: 193.0, SwingSpeed: 1.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 33, stats.Expertise: 21, stats.Defense: 22}, SocketBonus: stats.Stats{}},
+	{Name: "The Brutalizer", ID: 32254, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 128.0, WeaponDamageMax: 193.0, SwingSpeed: 1.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 33, stats.Expertise: 21, stats.Defense: 22}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "The Burning Crusader", ID: 30009, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 251.0, WeaponDamageMax: 378.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 27, stats.Agility: 27, stats.Stamina: 27, stats.MeleeHit: 26, stats.Expertise: 17}, SocketBonus: stats.Stats{}},
 	{Name: "The Burrower's Shell", ID: 23558, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 81, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "The Castigator", ID: 22808, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 119.0, WeaponDamageMax: 221.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 9, stats.AttackPower: 16, stats.MeleeHit: 10, stats.MeleeCrit: 14, stats.RangedAttackPower: 16}, SocketBonus: stats.Stats{}},
 	{Name: "The Darkener's Grasp", ID: 30007, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 40, stats.Defense: 27, stats.Block: 14, stats.Dodge: 23}, SocketBonus: stats.Stats{}},
-	{Name: "The Decapitator", ID: 28767, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 167.0, WeaponDamageMax: 312.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.MeleeCrit: 27}, SocketBonus: stats.Stats{}},
+	{Name: "The Decapitator", ID: 28767, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 167.0, WeaponDamageMax: 312.0, SwingSpeed: 2.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.MeleeCrit: 27}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Prince Malchezaar"},
 	{Name: "The End of Dreams", ID: 22988, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 45.0, WeaponDamageMax: 121.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, QualityModifier: -21.800, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 13, stats.SpellPower: 95, stats.HealingPower: 95, stats.MP5: 5, stats.FeralAttackPower: 423}, SocketBonus: stats.Stats{}},
 	{Name: "The Essence Focuser", ID: 31304, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.SpellPower: 76, stats.HealingPower: 303, stats.MP5: 11}, SocketBonus: stats.Stats{}},
 	{Name: "The Eye of Nerub", ID: 23039, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 251.0, WeaponDamageMax: 378.0, SwingSpeed: 3.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 22, stats.AttackPower: 60, stats.MeleeHit: 16, stats.RangedAttackPower: 60}, SocketBonus: stats.Stats{}},
@@ -3881,12 +3881,12 @@ var Items = []Item{
 	{Name: "The Horseman's Signet Ring", ID: 33958, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 17, stats.SpellPower: 35, stats.HealingPower: 35}, SocketBonus: stats.Stats{}},
 	{Name: "The Horseman's Signet Ring", ID: 34073, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 17, stats.SpellPower: 35, stats.HealingPower: 35}, SocketBonus: stats.Stats{}},
 	{Name: "The Hungering Cold", ID: 23577, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 76.0, WeaponDamageMax: 143.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 89, Stats: stats.Stats{stats.Stamina: 14, stats.Expertise: 14, stats.Armor: 140}, SocketBonus: stats.Stats{}},
-	{Name: "The Lightning Capacitor", ID: 28785, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "The Maelstrom's Fury", ID: 32237, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 20.0, WeaponDamageMax: 129.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 21, stats.SpellPower: 236, stats.HealingPower: 236, stats.SpellCrit: 22}, SocketBonus: stats.Stats{}},
+	{Name: "The Lightning Capacitor", ID: 28785, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
+	{Name: "The Maelstrom's Fury", ID: 32237, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 20.0, WeaponDamageMax: 129.0, SwingSpeed: 1.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 21, stats.SpellPower: 236, stats.HealingPower: 236, stats.SpellCrit: 22}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "The Master's Treads", ID: 31288, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 19, stats.AttackPower: 48, stats.MeleeHit: 16, stats.Armor: 191, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "The Mutilator", ID: 34952, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 130.0, WeaponDamageMax: 241.0, SwingSpeed: 1.80, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 44, stats.MeleeHit: 24, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
 	{Name: "The Natural Ward", ID: 31399, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.NatureResistance: 35}, SocketBonus: stats.Stats{}},
-	{Name: "The Nexus Key", ID: 29988, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 145.0, WeaponDamageMax: 312.0, SwingSpeed: 3.20, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 76, stats.Intellect: 52, stats.SpellPower: 236, stats.HealingPower: 236, stats.SpellCrit: 51}, SocketBonus: stats.Stats{}},
+	{Name: "The Nexus Key", ID: 29988, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 145.0, WeaponDamageMax: 312.0, SwingSpeed: 3.20, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, QualityModifier: -59.000, Stats: stats.Stats{stats.Stamina: 76, stats.Intellect: 52, stats.SpellPower: 236, stats.HealingPower: 236, stats.SpellCrit: 51}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
 	{Name: "The Night Blade", ID: 31331, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 117.0, WeaponDamageMax: 176.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "The Night Watchman", ID: 31333, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 27, stats.AttackPower: 68, stats.Armor: 259, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}},
 	{Name: "The Oathkeeper", ID: 31299, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 252.0, WeaponDamageMax: 420.0, SwingSpeed: 3.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 35, stats.Stamina: 24, stats.Intellect: 32, stats.MeleeHit: 26}, SocketBonus: stats.Stats{}},
@@ -3896,16 +3896,16 @@ var Items = []Item{
 	{Name: "The Saga of Terokk", ID: 29330, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 23, stats.SpellPower: 28, stats.HealingPower: 28}, SocketBonus: stats.Stats{}},
 	{Name: "The Savage's Choker", ID: 33297, Type: proto.ItemType_ItemTypeNeck, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 37, stats.AttackPower: 50, stats.MeleeHaste: 25, stats.RangedAttackPower: 50}, SocketBonus: stats.Stats{}},
 	{Name: "The Seal of Danzalar", ID: 33054, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 33, stats.SpellPower: 25, stats.HealingPower: 25, stats.Armor: 295, stats.Resilience: 21}, SocketBonus: stats.Stats{}},
-	{Name: "The Seeker's Wristguards", ID: 32279, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 43, stats.SpellPower: 22, stats.HealingPower: 22, stats.Armor: 772, stats.Defense: 21, stats.Block: 28}, SocketBonus: stats.Stats{}},
+	{Name: "The Seeker's Wristguards", ID: 32279, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 43, stats.SpellPower: 22, stats.HealingPower: 22, stats.Armor: 772, stats.Defense: 21, stats.Block: 28}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Shade of Akama"},
 	{Name: "The Sharp Cookie", ID: 30568, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 51.0, WeaponDamageMax: 95.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.AttackPower: 22, stats.MeleeCrit: 10, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
-	{Name: "The Skull of Gul'dan", ID: 32483, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellHit: 25}, SocketBonus: stats.Stats{}},
+	{Name: "The Skull of Gul'dan", ID: 32483, Type: proto.ItemType_ItemTypeTrinket, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 151, Stats: stats.Stats{stats.SpellPower: 55, stats.HealingPower: 55, stats.SpellHit: 25}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "The Sleeper's Cord", ID: 28398, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 24, stats.Spirit: 21, stats.SpellPower: 18, stats.HealingPower: 71, stats.Armor: 164}, SocketBonus: stats.Stats{}},
 	{Name: "The Soul Harvester's Bindings", ID: 23021, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 11, stats.SpellPower: 21, stats.HealingPower: 21, stats.SpellCrit: 14, stats.Armor: 63}, SocketBonus: stats.Stats{}},
 	{Name: "The Sun Eater", ID: 29362, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 93.0, WeaponDamageMax: 174.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 22, stats.Defense: 13, stats.Dodge: 19}, SocketBonus: stats.Stats{}},
 	{Name: "The Sun King's Talisman", ID: 30015, Type: proto.ItemType_ItemTypeNeck, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 16, stats.SpellPower: 41, stats.HealingPower: 41, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}},
 	{Name: "The Sunbreaker", ID: 34666, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 155.0, WeaponDamageMax: 233.0, SwingSpeed: 2.70, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 14, stats.Stamina: 12, stats.AttackPower: 26, stats.MeleeHit: 11, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
-	{Name: "The Unbreakable Will", ID: 30874, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 112.0, WeaponDamageMax: 209.0, SwingSpeed: 1.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 308, stats.Defense: 21}, SocketBonus: stats.Stats{}},
-	{Name: "The Wavemender's Mantle", ID: 32517, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 26, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 15, stats.Armor: 741}, SocketBonus: stats.Stats{}},
+	{Name: "The Unbreakable Will", ID: 30874, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 112.0, WeaponDamageMax: 209.0, SwingSpeed: 1.60, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 308, stats.Defense: 21}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Anetheron"},
+	{Name: "The Wavemender's Mantle", ID: 32517, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 26, stats.SpellPower: 28, stats.HealingPower: 112, stats.MP5: 15, stats.Armor: 741}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "The Widow's Embrace", ID: 22942, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 43.0, WeaponDamageMax: 116.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 81, QualityModifier: -21.300, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 12, stats.Spirit: 14, stats.SpellPower: 54, stats.HealingPower: 215}, SocketBonus: stats.Stats{}},
 	{Name: "The Willbreaker", ID: 27512, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 35.0, WeaponDamageMax: 113.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, QualityModifier: -30.300, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 14, stats.SpellPower: 121, stats.HealingPower: 121, stats.SpellCrit: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Therazane's Link", ID: 19380, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 12, stats.Spirit: 12, stats.AttackPower: 44, stats.MeleeCrit: 14, stats.Armor: 336, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
@@ -3944,10 +3944,10 @@ var Items = []Item{
 	{Name: "Thunderheart Vest", ID: 31043, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 40, stats.Spirit: 27, stats.SpellPower: 62, stats.HealingPower: 62, stats.SpellHit: 17, stats.SpellCrit: 25, stats.Armor: 459}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Thunderheart Regalia"},
 	{Name: "Thunderheart Waistguard", ID: 34556, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Strength: 38, stats.Agility: 40, stats.Stamina: 33, stats.Intellect: 20, stats.MeleeHit: 23, stats.Armor: 342}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}, SetName: "Thunderheart Harness"},
 	{Name: "Thunderheart Wristguards", ID: 34444, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Strength: 28, stats.Agility: 28, stats.Stamina: 39, stats.Intellect: 13, stats.ArmorPenetration: 91, stats.Armor: 281}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}, SetName: "Thunderheart Harness"},
-	{Name: "Thundering Greathelm", ID: 28775, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 50, stats.Agility: 43, stats.Stamina: 49, stats.Armor: 1276}, SocketBonus: stats.Stats{}},
+	{Name: "Thundering Greathelm", ID: 28775, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Strength: 50, stats.Agility: 43, stats.Stamina: 49, stats.Armor: 1276}, SocketBonus: stats.Stats{}, SourceZone: "Magtheridon's Lair", SourceDrop: "Magtheridon"},
 	{Name: "Thunderlord Scalpel", ID: 31475, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 34.0, WeaponDamageMax: 82.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, QualityModifier: -14.500, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 11, stats.SpellPower: 37, stats.HealingPower: 146, stats.MP5: 4}, SocketBonus: stats.Stats{}},
 	{Name: "Tiara of the Oracle", ID: 21348, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.Spirit: 16, stats.SpellPower: 28, stats.HealingPower: 28, stats.MP5: 7, stats.SpellHit: 8, stats.Armor: 164}, SocketBonus: stats.Stats{}, SetName: "Garments of the Oracle"},
-	{Name: "Tide-stomper's Greaves", ID: 32245, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 1213, stats.Defense: 19, stats.Dodge: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Tide-stomper's Greaves", ID: 32245, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 56, stats.SpellPower: 30, stats.HealingPower: 30, stats.Armor: 1213, stats.Defense: 19, stats.Dodge: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Black Temple", SourceDrop: "High Warlord Naj'entus"},
 	{Name: "Tidefury Chestpiece", ID: 28231, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 22, stats.SpellPower: 36, stats.HealingPower: 36, stats.MP5: 4, stats.SpellHit: 10, stats.Armor: 652}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Tidefury Raiment"},
 	{Name: "Tidefury Gauntlets", ID: 27510, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 26, stats.SpellPower: 29, stats.HealingPower: 29, stats.MP5: 7, stats.Armor: 407}, SocketBonus: stats.Stats{}, SetName: "Tidefury Raiment"},
 	{Name: "Tidefury Helm", ID: 28349, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 26, stats.SpellPower: 32, stats.HealingPower: 32, stats.MP5: 6, stats.Armor: 530}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{stats.Intellect: 4}, SetName: "Tidefury Raiment"},
@@ -3959,7 +3959,7 @@ var Items = []Item{
 	{Name: "Timeslicer", ID: 28226, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 80.0, WeaponDamageMax: 121.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 13, stats.AttackPower: 30, stats.Expertise: 15, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Timewarden's Leggings", ID: 29184, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 57, stats.Armor: 1019, stats.Defense: 18, stats.Dodge: 11}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{}},
 	{Name: "Tiny Voodoo Mask", ID: 34029, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Tirisfal Wand of Ascendancy", ID: 28673, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 169.0, WeaponDamageMax: 314.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 9, stats.SpellPower: 15, stats.HealingPower: 15, stats.SpellHit: 11}, SocketBonus: stats.Stats{}},
+	{Name: "Tirisfal Wand of Ascendancy", ID: 28673, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 169.0, WeaponDamageMax: 314.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 9, stats.SpellPower: 15, stats.HealingPower: 15, stats.SpellHit: 11}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Shade of Aran"},
 	{Name: "Titanic Breastplate", ID: 23363, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 90, Stats: stats.Stats{stats.Strength: 15, stats.Stamina: 15, stats.MeleeCrit: 14, stats.Armor: 1148, stats.Defense: 15, stats.ArcaneResistance: 10, stats.NatureResistance: 10}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorMeta, proto.GemColor_GemColorMeta}, SocketBonus: stats.Stats{}},
 	{Name: "Tom's Legs 1", ID: 32417, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 41, stats.Stamina: 60, stats.MeleeHit: 20, stats.MeleeCrit: 31, stats.Armor: 1269}, SocketBonus: stats.Stats{}},
 	{Name: "Tom's Legs 2", ID: 32418, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Strength: 46, stats.Stamina: 69, stats.MeleeHit: 23, stats.MeleeCrit: 35, stats.Armor: 1428}, SocketBonus: stats.Stats{}},
@@ -3969,18 +3969,18 @@ var Items = []Item{
 	{Name: "Tom's Legs C", ID: 32416, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Strength: 45, stats.Stamina: 66, stats.MeleeHit: 22, stats.MeleeCrit: 35, stats.Armor: 1512}, SocketBonus: stats.Stats{}},
 	{Name: "Tome of Diabolic Remedy", ID: 33828, Type: proto.ItemType_ItemTypeTrinket, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 133, Stats: stats.Stats{stats.MP5: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Tome of Fiery Redemption", ID: 30447, ClassAllowlist: []proto.Class{proto.Class_ClassPaladin}, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Tome of the Lightbringer", ID: 32368, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Tome of the Lightbringer", ID: 32368, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeLibram, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Mother Shahraz"},
 	{Name: "Topaz-Studded Battlegrips", ID: 30741, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 49, stats.Armor: 944, stats.Defense: 31}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{}},
 	{Name: "Torc of the Sethekk Prophet", ID: 29333, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 18, stats.SpellPower: 19, stats.HealingPower: 19, stats.SpellCrit: 21}, SocketBonus: stats.Stats{}},
-	{Name: "Torch of the Damned", ID: 32332, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 396.0, WeaponDamageMax: 595.0, SwingSpeed: 3.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 51, stats.Stamina: 45, stats.MeleeCrit: 38, stats.MeleeHaste: 50}, SocketBonus: stats.Stats{}},
+	{Name: "Torch of the Damned", ID: 32332, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 396.0, WeaponDamageMax: 595.0, SwingSpeed: 3.80, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Strength: 51, stats.Stamina: 45, stats.MeleeCrit: 38, stats.MeleeHaste: 50}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Tormented Demonsoul Robes", ID: 34936, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 38, stats.SpellPower: 62, stats.HealingPower: 62, stats.SpellCrit: 50, stats.Armor: 236}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Torn-heart Axe of Battle", ID: 31062, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 230.0, WeaponDamageMax: 346.0, SwingSpeed: 3.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.Strength: 30, stats.Stamina: 27, stats.Intellect: 30, stats.SpellCrit: 17, stats.MeleeCrit: 17}, SocketBonus: stats.Stats{}},
 	{Name: "Torn-heart Cloak", ID: 30971, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 12, stats.SpellPower: 27, stats.HealingPower: 27, stats.Armor: 70}, SocketBonus: stats.Stats{}},
 	{Name: "Torn-heart Family Tunic", ID: 30959, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 39, stats.Stamina: 24, stats.MeleeCrit: 21, stats.Armor: 1050}, SocketBonus: stats.Stats{}},
 	{Name: "Toshley's Station Hero's Hat", ID: 31457, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 22, stats.AttackPower: 42, stats.MeleeCrit: 37, stats.Armor: 203, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
-	{Name: "Totem of Ancestral Guidance", ID: 32330, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Totem of Ancestral Guidance", ID: 32330, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
 	{Name: "Totem of Flowing Water", ID: 23005, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Totem of Healing Rains", ID: 28523, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Totem of Healing Rains", ID: 28523, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Maiden of Virtue"},
 	{Name: "Totem of Impact", ID: 27947, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 91, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Totem of Life", ID: 22396, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 78, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Totem of Living Water", ID: 33505, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
@@ -3988,19 +3988,19 @@ var Items = []Item{
 	{Name: "Totem of Spontaneous Regrowth", ID: 27544, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Totem of the Astral Winds", ID: 27815, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Totem of the Maelstrom", ID: 30023, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
-	{Name: "Totem of the Pulsing Earth", ID: 29389, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
+	{Name: "Totem of the Pulsing Earth", ID: 29389, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{}, SocketBonus: stats.Stats{}, SourceZone: "Shattrath City", SourceVendor: "G'eras", BadgeCost: 15},
 	{Name: "Totem of the Void", ID: 28248, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeTotem, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Touch of Frost", ID: 22935, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 20, stats.FrostResistance: 24}, SocketBonus: stats.Stats{}},
-	{Name: "Touch of Inspiration", ID: 32350, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 12}, SocketBonus: stats.Stats{}},
+	{Name: "Touch of Inspiration", ID: 32350, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeOffHand, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.SpellPower: 22, stats.HealingPower: 86, stats.MP5: 12}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Touch of Victory", ID: 32482, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 231.0, WeaponDamageMax: 429.0, SwingSpeed: 1.90, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 13, stats.SpellPower: 14, stats.HealingPower: 14, stats.Resilience: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Tourmaline Crown", ID: 31546, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 18, stats.Spirit: 17, stats.SpellPower: 35, stats.HealingPower: 35, stats.SpellCrit: 30, stats.Armor: 120}, SocketBonus: stats.Stats{}},
 	{Name: "Towering Mantle of the Hunt", ID: 28306, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 23, stats.AttackPower: 48, stats.MeleeCrit: 21, stats.Armor: 489, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
 	{Name: "Toxin Injector", ID: 22810, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 82.0, WeaponDamageMax: 153.0, SwingSpeed: 2.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 10, stats.AttackPower: 28, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
-	{Name: "Tracker's Blade", ID: 30865, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 105.0, WeaponDamageMax: 196.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 44, stats.MeleeHit: 20, stats.MeleeCrit: 23, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
+	{Name: "Tracker's Blade", ID: 30865, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 105.0, WeaponDamageMax: 196.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.AttackPower: 44, stats.MeleeHit: 20, stats.MeleeCrit: 23, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Rage Winterchill"},
 	{Name: "Traitor's Noose", ID: 27546, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.AttackPower: 38, stats.MeleeHit: 12, stats.MeleeCrit: 18, stats.RangedAttackPower: 38}, SocketBonus: stats.Stats{}},
 	{Name: "Tranquil Majesty Wraps", ID: 34351, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 28, stats.Spirit: 30, stats.SpellPower: 32, stats.HealingPower: 127, stats.MP5: 8, stats.Armor: 302}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 3}},
 	{Name: "Tranquil Moonlight Wraps", ID: 34407, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 28, stats.Spirit: 20, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellCrit: 30, stats.Armor: 302}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}},
-	{Name: "Translucent Spellthread Necklace", ID: 32349, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 15, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}},
+	{Name: "Translucent Spellthread Necklace", ID: 32349, Type: proto.ItemType_ItemTypeNeck, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 15, stats.SpellCrit: 24}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Trashbringer", ID: 32824, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 381.0, WeaponDamageMax: 466.0, SwingSpeed: 3.00, Phase: 0, Quality: proto.ItemQuality_ItemQualityLegendary, Unique: true, Ilvl: 155, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Treads of Absolution", ID: 34563, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 30, stats.Spirit: 22, stats.SpellPower: 50, stats.HealingPower: 50, stats.SpellCrit: 16, stats.SpellHaste: 30, stats.Armor: 177}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}, SetName: "Absolution Regalia"},
 	{Name: "Treads of Booming Thunder", ID: 33537, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 33, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellCrit: 14, stats.Armor: 619}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellCrit: 3}},
@@ -4011,21 +4011,21 @@ var Items = []Item{
 	{Name: "Treebole's Hoop", ID: 31523, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 12, stats.SpellPower: 15, stats.HealingPower: 15, stats.MP5: 5, stats.SpellCrit: 12}, SocketBonus: stats.Stats{}},
 	{Name: "Trep's Shoulderguards", ID: 30262, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 13, stats.SpellPower: 15, stats.HealingPower: 15, stats.MP5: 10, stats.Armor: 188}, SocketBonus: stats.Stats{}},
 	{Name: "Triad Girdle", ID: 21692, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 75, Stats: stats.Stats{stats.Strength: 26, stats.Agility: 19, stats.Stamina: 17, stats.Armor: 543}, SocketBonus: stats.Stats{}},
-	{Name: "Trial-Fire Trousers", ID: 28594, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 40, stats.SpellPower: 49, stats.HealingPower: 49, stats.Armor: 170}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
+	{Name: "Trial-Fire Trousers", ID: 28594, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 40, stats.SpellPower: 49, stats.HealingPower: 49, stats.Armor: 170}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Karazhan"},
 	{Name: "Trickster's Stickyfingers", ID: 33539, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 30, stats.Stamina: 28, stats.AttackPower: 68, stats.MeleeHaste: 25, stats.Armor: 252, stats.RangedAttackPower: 68}, SocketBonus: stats.Stats{}},
 	{Name: "Trident of the Outcast Tribe", ID: 30830, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypePolearm, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 270.0, WeaponDamageMax: 406.0, SwingSpeed: 3.20, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 100, Stats: stats.Stats{stats.Strength: 37, stats.Stamina: 54, stats.MeleeHit: 36}, SocketBonus: stats.Stats{}},
-	{Name: "Triptych Shield of the Ancients", ID: 28754, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.SpellPower: 14, stats.HealingPower: 56, stats.MP5: 8, stats.Armor: 4872, stats.BlockValue: 122}, SocketBonus: stats.Stats{}},
+	{Name: "Triptych Shield of the Ancients", ID: 28754, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 21, stats.SpellPower: 14, stats.HealingPower: 56, stats.MP5: 8, stats.Armor: 4872, stats.BlockValue: 122}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Chess Event"},
 	{Name: "Trollbane", ID: 33492, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 358.0, WeaponDamageMax: 537.0, SwingSpeed: 3.60, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.Agility: 39, stats.Stamina: 58, stats.AttackPower: 94, stats.RangedAttackPower: 94}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
 	{Name: "Trousers of Oblivion", ID: 27948, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 42, stats.Intellect: 33, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHit: 12, stats.Armor: 136}, SocketBonus: stats.Stats{}, SetName: "Oblivion Raiment"},
-	{Name: "Trousers of the Astromancer", ID: 29972, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 36, stats.Spirit: 22, stats.SpellPower: 54, stats.HealingPower: 54, stats.Armor: 188}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
+	{Name: "Trousers of the Astromancer", ID: 29972, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 36, stats.Spirit: 22, stats.SpellPower: 54, stats.HealingPower: 54, stats.Armor: 188}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Trousers of the Incarnate", ID: 29053, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 39, stats.Intellect: 37, stats.Spirit: 36, stats.SpellPower: 30, stats.HealingPower: 118, stats.Armor: 177}, SocketBonus: stats.Stats{}, SetName: "Incarnate Raiment"},
 	{Name: "Trousers of the Oracle", ID: 21352, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 24, stats.Spirit: 14, stats.SpellPower: 33, stats.HealingPower: 33, stats.MP5: 6, stats.Armor: 173}, SocketBonus: stats.Stats{}, SetName: "Garments of the Oracle"},
 	{Name: "Trousers of the Scryers' Retainer", ID: 34928, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 43, stats.Stamina: 45, stats.AttackPower: 104, stats.MeleeHit: 30, stats.Armor: 388, stats.RangedAttackPower: 104}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeHit: 3}},
-	{Name: "True-Aim Stalker Bands", ID: 30091, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 12, stats.AttackPower: 50, stats.MeleeCrit: 24, stats.Armor: 394, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}},
+	{Name: "True-Aim Stalker Bands", ID: 30091, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 12, stats.AttackPower: 50, stats.MeleeCrit: 24, stats.Armor: 394, stats.RangedAttackPower: 50}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 3}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Leotheras the Blind"},
 	{Name: "Truestrike Crossbow", ID: 34674, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeCrossbow, WeaponDamageMin: 138.0, WeaponDamageMax: 207.0, SwingSpeed: 2.60, Phase: 5, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 10, stats.Stamina: 12, stats.Intellect: 6, stats.AttackPower: 20, stats.RangedAttackPower: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Truestrike Ring", ID: 31326, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Agility: 21, stats.MP5: 7, stats.AttackPower: 40, stats.RangedAttackPower: 40}, SocketBonus: stats.Stats{}},
 	{Name: "Truncheon of Five Hells", ID: 27476, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 90.0, WeaponDamageMax: 168.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.MeleeHit: 14, stats.Defense: 15}, SocketBonus: stats.Stats{}},
-	{Name: "Tsunami Talisman", ID: 30627, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.MeleeHit: 10, stats.MeleeCrit: 38}, SocketBonus: stats.Stats{}},
+	{Name: "Tsunami Talisman", ID: 30627, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.MeleeHit: 10, stats.MeleeCrit: 38}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Serpentshrine Cavern", SourceDrop: "Leotheras the Blind"},
 	{Name: "Tunic of Assassination", ID: 28204, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Agility: 28, stats.Stamina: 21, stats.AttackPower: 54, stats.Armor: 292, stats.RangedAttackPower: 54}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Dodge: 4}, SetName: "Assassination Armor"},
 	{Name: "Tunic of the Dark Hour", ID: 34927, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 44, stats.Stamina: 51, stats.AttackPower: 102, stats.MeleeHit: 34, stats.Armor: 444, stats.RangedAttackPower: 102}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Stamina: 3}},
 	{Name: "Tunic of the Ranger Lord", ID: 34614, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Agility: 45, stats.Stamina: 34, stats.Intellect: 23, stats.AttackPower: 88, stats.MeleeCrit: 23, stats.Armor: 879, stats.RangedAttackPower: 88}, SocketBonus: stats.Stats{}},
@@ -4033,8 +4033,8 @@ var Items = []Item{
 	{Name: "Twin Moon Shoulderguards", ID: 31538, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 14, stats.Intellect: 14, stats.Spirit: 14, stats.SpellPower: 16, stats.HealingPower: 16, stats.SpellCrit: 14, stats.Armor: 183}, SocketBonus: stats.Stats{}},
 	{Name: "Twin-Bladed Ripper", ID: 30013, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 84.0, WeaponDamageMax: 157.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 109, Stats: stats.Stats{stats.Agility: 12, stats.Stamina: 12, stats.AttackPower: 24, stats.MeleeHit: 11, stats.Expertise: 7, stats.RangedAttackPower: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Twinblade of Mastery", ID: 27814, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 80.0, WeaponDamageMax: 121.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 12, stats.MeleeCrit: 21, stats.Expertise: 11}, SocketBonus: stats.Stats{}},
-	{Name: "Twinblade of the Phoenix", ID: 29993, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 375.0, WeaponDamageMax: 564.0, SwingSpeed: 3.60, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 53, stats.AttackPower: 110, stats.MeleeCrit: 37, stats.RangedAttackPower: 108}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}},
-	{Name: "Twisted Blades of Zarak", ID: 32326, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 101.0, WeaponDamageMax: 152.0, SwingSpeed: 1.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 23, stats.MeleeCrit: 16}, SocketBonus: stats.Stats{}},
+	{Name: "Twinblade of the Phoenix", ID: 29993, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 375.0, WeaponDamageMax: 564.0, SwingSpeed: 3.60, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 53, stats.AttackPower: 110, stats.MeleeCrit: 37, stats.RangedAttackPower: 108}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 8, stats.RangedAttackPower: 8}, SourceZone: "Tempest Keep", SourceDrop: "Kael'thas Sunstrider"},
+	{Name: "Twisted Blades of Zarak", ID: 32326, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 101.0, WeaponDamageMax: 152.0, SwingSpeed: 1.40, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 23, stats.MeleeCrit: 16}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Teron Gorefiend"},
 	{Name: "Twisting Nether Chain Shirt", ID: 23564, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 22, stats.MP5: 9, stats.AttackPower: 68, stats.MeleeCrit: 33, stats.Armor: 893, stats.RangedAttackPower: 68}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}},
 	{Name: "Two-toed Sandals", ID: 33471, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 30, stats.SpellPower: 22, stats.HealingPower: 88, stats.MP5: 9, stats.Armor: 154}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 7}},
 	{Name: "Ukko's Ring of Darkness", ID: 21687, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{stats.Stamina: 13, stats.ShadowResistance: 20}, SocketBonus: stats.Stats{}},
@@ -4042,8 +4042,8 @@ var Items = []Item{
 	{Name: "Umberhowl's Collar", ID: 30944, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 20, stats.Agility: 10, stats.Stamina: 22, stats.Armor: 281}, SocketBonus: stats.Stats{}},
 	{Name: "Umbral Shiv", ID: 33493, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 137.0, WeaponDamageMax: 207.0, SwingSpeed: 1.80, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 132, Stats: stats.Stats{stats.Agility: 12, stats.Stamina: 30, stats.MeleeHit: 13}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.AttackPower: 6, stats.RangedAttackPower: 6}},
 	{Name: "Unearthed Enkaat Wand", ID: 30252, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 121.0, WeaponDamageMax: 226.0, SwingSpeed: 1.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 7, stats.Spirit: 7, stats.SpellPower: 8, stats.HealingPower: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Uni-Mind Headdress", ID: 28744, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 40, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 19, stats.SpellCrit: 25, stats.Armor: 158}, SocketBonus: stats.Stats{}},
-	{Name: "Unstoppable Aggressor's Ring", ID: 32335, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Strength: 36, stats.Stamina: 28, stats.MeleeCrit: 30}, SocketBonus: stats.Stats{}},
+	{Name: "Uni-Mind Headdress", ID: 28744, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 40, stats.SpellPower: 46, stats.HealingPower: 46, stats.SpellHit: 19, stats.SpellCrit: 25, stats.Armor: 158}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Netherspite"},
+	{Name: "Unstoppable Aggressor's Ring", ID: 32335, Type: proto.ItemType_ItemTypeFinger, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 141, Stats: stats.Stats{stats.Strength: 36, stats.Stamina: 28, stats.MeleeCrit: 30}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Unusually Slow Sword", ID: 32949, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 430.0, WeaponDamageMax: 431.0, SwingSpeed: 6.00, Phase: 0, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Unusually Slow Two-Hander", ID: 32951, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 746.0, WeaponDamageMax: 747.0, SwingSpeed: 8.00, Phase: 0, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Unwavering Legguards", ID: 33515, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 73, stats.Armor: 1406, stats.Defense: 22, stats.Block: 30, stats.BlockValue: 59}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Stamina: 6}},
@@ -4055,10 +4055,10 @@ var Items = []Item{
 	{Name: "Uvuros Hide Gloves", ID: 31111, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 12, stats.Spirit: 11, stats.SpellPower: 35, stats.HealingPower: 35, stats.Armor: 88}, SocketBonus: stats.Stats{}},
 	{Name: "Uvuros Plated Spaulders", ID: 31115, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Strength: 23, stats.Stamina: 16, stats.Intellect: 11, stats.SpellPower: 28, stats.HealingPower: 28, stats.Armor: 787}, SocketBonus: stats.Stats{}},
 	{Name: "Valanos' Longbow", ID: 31303, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeBow, WeaponDamageMin: 130.0, WeaponDamageMax: 242.0, SwingSpeed: 2.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 10, stats.AttackPower: 22, stats.MeleeCrit: 10, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
-	{Name: "Valestalker Girdle", ID: 30919, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 25, stats.Intellect: 18, stats.AttackPower: 76, stats.MeleeHaste: 36, stats.Armor: 556, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}},
-	{Name: "Vambraces of Courage", ID: 28502, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 634, stats.Defense: 15, stats.BlockValue: 33}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 2}},
+	{Name: "Valestalker Girdle", ID: 30919, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 27, stats.Stamina: 25, stats.Intellect: 18, stats.AttackPower: 76, stats.MeleeHaste: 36, stats.Armor: 556, stats.RangedAttackPower: 76}, SocketBonus: stats.Stats{}, SourceZone: "Hyjal Summit", SourceDrop: "Archimonde"},
+	{Name: "Vambraces of Courage", ID: 28502, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Armor: 634, stats.Defense: 15, stats.BlockValue: 33}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Dodge: 2}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Vambraces of Daring", ID: 27459, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 18, stats.Stamina: 26, stats.Armor: 509, stats.Defense: 11, stats.BlockValue: 23}, SocketBonus: stats.Stats{}},
-	{Name: "Vambraces of Ending", ID: 29966, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 24, stats.AttackPower: 52, stats.Armor: 177, stats.RangedAttackPower: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}},
+	{Name: "Vambraces of Ending", ID: 29966, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 24, stats.Stamina: 24, stats.AttackPower: 52, stats.Armor: 177, stats.RangedAttackPower: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.AttackPower: 4, stats.RangedAttackPower: 4}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Vambraces of the Naaru", ID: 33520, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 25, stats.SpellPower: 19, stats.HealingPower: 74, stats.MP5: 7, stats.Armor: 703}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MP5: 1}},
 	{Name: "Vanir's Left Fist of Brutality", ID: 34951, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 180.0, WeaponDamageMax: 335.0, SwingSpeed: 2.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.AttackPower: 44, stats.MeleeHit: 21, stats.MeleeHaste: 23, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
 	{Name: "Vanir's Left Fist of Savagery", ID: 34950, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 108.0, WeaponDamageMax: 201.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.AttackPower: 44, stats.MeleeHit: 21, stats.MeleeHaste: 23, stats.RangedAttackPower: 44}, SocketBonus: stats.Stats{}},
@@ -4066,9 +4066,9 @@ var Items = []Item{
 	{Name: "Vanquished Tentacle of C'Thun", ID: 21579, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 88, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Vanquisher's Legplates", ID: 30533, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Strength: 36, stats.Stamina: 36, stats.MeleeCrit: 23, stats.Armor: 1216}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeHit: 4}},
 	{Name: "Veil of Eclipse", ID: 23017, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 10, stats.SpellPower: 28, stats.HealingPower: 28, stats.SpellPenetration: 10, stats.Armor: 72}, SocketBonus: stats.Stats{}},
-	{Name: "Veil of Turning Leaves", ID: 32518, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 29, stats.Spirit: 38, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 333}, SocketBonus: stats.Stats{}},
+	{Name: "Veil of Turning Leaves", ID: 32518, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 29, stats.Intellect: 29, stats.Spirit: 38, stats.SpellPower: 28, stats.HealingPower: 112, stats.Armor: 333}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Vek'lor's Gloves of Devastation", ID: 21599, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Agility: 22, stats.Stamina: 21, stats.Intellect: 17, stats.MeleeCrit: 14, stats.Armor: 365}, SocketBonus: stats.Stats{}},
-	{Name: "Velvet Boots of the Guardian", ID: 30067, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 21, stats.Spirit: 15, stats.SpellPower: 49, stats.HealingPower: 49, stats.SpellCrit: 24, stats.Armor: 148}, SocketBonus: stats.Stats{}},
+	{Name: "Velvet Boots of the Guardian", ID: 30067, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 21, stats.Spirit: 15, stats.SpellPower: 49, stats.HealingPower: 49, stats.SpellCrit: 24, stats.Armor: 148}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "The Lurker Below"},
 	{Name: "Vengeance Wrap", ID: 24259, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.AttackPower: 52, stats.MeleeCrit: 23, stats.Armor: 89, stats.RangedAttackPower: 52}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.MeleeHit: 2}},
 	{Name: "Vengeful Gladiator's Barrier", ID: 33661, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 21, stats.SpellPower: 35, stats.HealingPower: 35, stats.Armor: 6132, stats.BlockValue: 167, stats.Resilience: 27}, SocketBonus: stats.Stats{}},
 	{Name: "Vengeful Gladiator's Baton of Light", ID: 34059, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 252.0, WeaponDamageMax: 468.0, SwingSpeed: 1.90, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 14, stats.SpellPower: 11, stats.HealingPower: 44, stats.Resilience: 14}, SocketBonus: stats.Stats{}},
@@ -4204,7 +4204,7 @@ var Items = []Item{
 	{Name: "Venomous Totem", ID: 19342, ClassAllowlist: []proto.Class{proto.Class_ClassRogue}, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 76, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Verdant Gloves", ID: 30943, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Strength: 26, stats.Agility: 14, stats.Stamina: 27, stats.Armor: 393}, SocketBonus: stats.Stats{}},
 	{Name: "Vermillion Robes of the Dominant", ID: 27799, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 33, stats.SpellPower: 42, stats.HealingPower: 42, stats.SpellHit: 12, stats.Armor: 156}, SocketBonus: stats.Stats{}},
-	{Name: "Vest of Mounting Assault", ID: 32334, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 58, stats.Stamina: 27, stats.Intellect: 18, stats.AttackPower: 116, stats.Armor: 988, stats.RangedAttackPower: 116}, SocketBonus: stats.Stats{}},
+	{Name: "Vest of Mounting Assault", ID: 32334, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 58, stats.Stamina: 27, stats.Intellect: 18, stats.AttackPower: 116, stats.Armor: 988, stats.RangedAttackPower: 116}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Vest of Swift Execution", ID: 21680, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Strength: 21, stats.Agility: 41, stats.Stamina: 20, stats.Armor: 262}, SocketBonus: stats.Stats{}},
 	{Name: "Vestia's Pauldrons of Inner Grace", ID: 28250, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 25, stats.SpellPower: 16, stats.HealingPower: 64, stats.MP5: 8, stats.Armor: 117}, SocketBonus: stats.Stats{}},
 	{Name: "Vestments of Absolution", ID: 31066, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 146, Stats: stats.Stats{stats.Stamina: 48, stats.Intellect: 32, stats.Spirit: 36, stats.SpellPower: 39, stats.HealingPower: 156, stats.MP5: 8, stats.Armor: 244}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SetName: "Vestments of Absolution"},
@@ -4212,7 +4212,7 @@ var Items = []Item{
 	{Name: "Vestments of the Aldor", ID: 29077, ClassAllowlist: []proto.Class{proto.Class_ClassMage}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 32, stats.Spirit: 14, stats.SpellPower: 49, stats.HealingPower: 49, stats.SpellPenetration: 20, stats.Armor: 202}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SetName: "Aldor Regalia"},
 	{Name: "Vestments of the Avatar", ID: 30150, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 25, stats.Spirit: 39, stats.SpellPower: 35, stats.HealingPower: 138, stats.Armor: 223}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}, SetName: "Avatar Raiment"},
 	{Name: "Vestments of the Oracle", ID: 21351, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 23, stats.Intellect: 26, stats.Spirit: 15, stats.SpellPower: 36, stats.HealingPower: 36, stats.SpellCrit: 14, stats.SpellPenetration: 10, stats.Armor: 201}, SocketBonus: stats.Stats{}, SetName: "Garments of the Oracle"},
-	{Name: "Vestments of the Sea-Witch", ID: 30107, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 57, stats.HealingPower: 57, stats.SpellHit: 27, stats.SpellCrit: 31, stats.Armor: 231}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}},
+	{Name: "Vestments of the Sea-Witch", ID: 30107, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 138, Stats: stats.Stats{stats.Stamina: 28, stats.Intellect: 28, stats.SpellPower: 57, stats.HealingPower: 57, stats.SpellHit: 27, stats.SpellCrit: 31, stats.Armor: 231}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 5, stats.HealingPower: 5}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Lady Vashj"},
 	{Name: "Veteran's Band of Dominance", ID: 33056, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 27, stats.Intellect: 12, stats.SpellPower: 29, stats.HealingPower: 29, stats.SpellPenetration: 10, stats.Resilience: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Veteran's Band of Salvation", ID: 33064, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 30, stats.Intellect: 17, stats.SpellPower: 15, stats.HealingPower: 59, stats.MP5: 5, stats.Resilience: 22}, SocketBonus: stats.Stats{}},
 	{Name: "Veteran's Band of Triumph", ID: 33057, Type: proto.ItemType_ItemTypeFinger, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 30, stats.AttackPower: 42, stats.MeleeCrit: 22, stats.RangedAttackPower: 42, stats.Resilience: 22}, SocketBonus: stats.Stats{}},
@@ -4349,7 +4349,7 @@ var Items = []Item{
 	{Name: "Violet Signet of the Great Protector", ID: 29279, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 37, stats.Armor: 392, stats.Defense: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Violet Signet of the Master Assassin", ID: 29283, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 28, stats.AttackPower: 56, stats.MeleeHit: 25, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
 	{Name: "Virtue Bearer's Vambraces", ID: 27489, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Intellect: 16, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 6, stats.SpellCrit: 15, stats.Armor: 509}, SocketBonus: stats.Stats{}},
-	{Name: "Void Reaver Greaves", ID: 29985, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 37, stats.Stamina: 33, stats.Intellect: 24, stats.AttackPower: 88, stats.Armor: 787, stats.RangedAttackPower: 88}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}},
+	{Name: "Void Reaver Greaves", ID: 29985, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 37, stats.Stamina: 33, stats.Intellect: 24, stats.AttackPower: 88, stats.Armor: 787, stats.RangedAttackPower: 88}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.Agility: 4}, SourceZone: "Tempest Keep", SourceDrop: "Void Reaver"},
 	{Name: "Void Slayer's Tunic", ID: 30298, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 21, stats.SpellPower: 30, stats.HealingPower: 118, stats.MP5: 8, stats.Armor: 619}, SocketBonus: stats.Stats{}},
 	{Name: "Void Star Talisman", ID: 30449, ClassAllowlist: []proto.Class{proto.Class_ClassWarlock}, Type: proto.ItemType_ItemTypeTrinket, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 128, Stats: stats.Stats{}, SocketBonus: stats.Stats{}},
 	{Name: "Void-Talon", ID: 29372, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist, HandType: proto.HandType_HandTypeOffHand, WeaponDamageMin: 64.0, WeaponDamageMax: 120.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Agility: 14, stats.AttackPower: 28, stats.RangedAttackPower: 28}, SocketBonus: stats.Stats{}},
@@ -4366,19 +4366,19 @@ var Items = []Item{
 	{Name: "Waistguard of Reparation", ID: 34923, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 36, stats.Intellect: 34, stats.SpellPower: 25, stats.HealingPower: 98, stats.SpellCrit: 28, stats.Armor: 993}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellCrit: 2}},
 	{Name: "Waistguard of Shackled Souls", ID: 32397, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 130, Stats: stats.Stats{stats.Stamina: 40, stats.Armor: 514, stats.ShadowResistance: 54}, SocketBonus: stats.Stats{}},
 	{Name: "Waistguard of the Great Beast", ID: 33583, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 29, stats.Agility: 28, stats.Stamina: 30, stats.MeleeHit: 18, stats.Armor: 367}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Strength: 3}},
-	{Name: "Waistwrap of Infinity", ID: 32256, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.SpellPower: 56, stats.HealingPower: 56, stats.SpellHaste: 32, stats.Armor: 133}, SocketBonus: stats.Stats{}},
+	{Name: "Waistwrap of Infinity", ID: 32256, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 31, stats.Intellect: 22, stats.SpellPower: 56, stats.HealingPower: 56, stats.SpellHaste: 32, stats.Armor: 133}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Wand of Cleansing Light", ID: 34348, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 208.0, WeaponDamageMax: 387.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Intellect: 8, stats.Spirit: 18, stats.SpellPower: 14, stats.HealingPower: 56, stats.SpellHaste: 8}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Spirit: 2}},
 	{Name: "Wand of Fates", ID: 22820, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 119.0, WeaponDamageMax: 222.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Stamina: 7, stats.Intellect: 7, stats.SpellPower: 12, stats.HealingPower: 12, stats.SpellHit: 8}, SocketBonus: stats.Stats{}},
-	{Name: "Wand of Prismatic Focus", ID: 32343, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 193.0, WeaponDamageMax: 360.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 21, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellHit: 13}, SocketBonus: stats.Stats{}},
+	{Name: "Wand of Prismatic Focus", ID: 32343, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 193.0, WeaponDamageMax: 360.0, SwingSpeed: 1.50, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Stamina: 21, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellHit: 13}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple"},
 	{Name: "Wand of Qiraji Nobility", ID: 21603, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 114.0, WeaponDamageMax: 213.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 78, Stats: stats.Stats{stats.Stamina: 5, stats.SpellPower: 19, stats.HealingPower: 19}, SocketBonus: stats.Stats{}},
 	{Name: "Wand of the Ancestors", ID: 31474, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 110.0, WeaponDamageMax: 206.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Intellect: 5, stats.SpellPower: 15, stats.HealingPower: 15}, SocketBonus: stats.Stats{}},
 	{Name: "Wand of the Demonsoul", ID: 34347, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 208.0, WeaponDamageMax: 387.0, SwingSpeed: 1.50, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 154, Stats: stats.Stats{stats.Stamina: 9, stats.Intellect: 10, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellHaste: 18}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
-	{Name: "Wand of the Forgotten Star", ID: 29982, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 186.0, WeaponDamageMax: 346.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellHit: 11, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
+	{Name: "Wand of the Forgotten Star", ID: 29982, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 186.0, WeaponDamageMax: 346.0, SwingSpeed: 1.50, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.SpellPower: 23, stats.HealingPower: 23, stats.SpellHit: 11, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Wand of the Netherwing", ID: 27890, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 158.0, WeaponDamageMax: 293.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.Stamina: 19, stats.SpellPower: 16, stats.HealingPower: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Wand of the Seer", ID: 30859, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 110.0, WeaponDamageMax: 205.0, SwingSpeed: 1.30, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 109, Stats: stats.Stats{stats.Intellect: 8, stats.Spirit: 5, stats.SpellPower: 18, stats.HealingPower: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Wand of the Whispering Dead", ID: 23009, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, WeaponDamageMin: 119.0, WeaponDamageMax: 222.0, SwingSpeed: 1.50, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Intellect: 10, stats.Spirit: 9, stats.SpellPower: 8, stats.HealingPower: 30}, SocketBonus: stats.Stats{}},
 	{Name: "War-Feathered Loop", ID: 33280, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Agility: 29, stats.Stamina: 36, stats.Intellect: 28, stats.AttackPower: 56, stats.ArmorPenetration: 70, stats.Armor: 506, stats.RangedAttackPower: 56}, SocketBonus: stats.Stats{}},
-	{Name: "Warboots of Obliteration", ID: 30081, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 44, stats.Stamina: 29, stats.MeleeCrit: 31, stats.Armor: 1105}, SocketBonus: stats.Stats{}},
+	{Name: "Warboots of Obliteration", ID: 30081, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Strength: 44, stats.Stamina: 29, stats.MeleeCrit: 31, stats.Armor: 1105}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Morogrim Tidewalker"},
 	{Name: "Warbringer", ID: 29165, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 102.0, WeaponDamageMax: 190.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 12, stats.MeleeHit: 7, stats.Defense: 16}, SocketBonus: stats.Stats{}},
 	{Name: "Warbringer Battle-Helm", ID: 29021, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 45, stats.Stamina: 45, stats.MeleeHit: 14, stats.MeleeCrit: 24, stats.Armor: 1227}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.MeleeHit: 4}, SetName: "Warbringer Battlegear"},
 	{Name: "Warbringer Breastplate", ID: 29019, ClassAllowlist: []proto.Class{proto.Class_ClassWarrior}, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 120, Stats: stats.Stats{stats.Strength: 44, stats.Stamina: 39, stats.MeleeCrit: 26, stats.Armor: 1510}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.MeleeCrit: 4}, SetName: "Warbringer Battlegear"},
@@ -4431,7 +4431,7 @@ var Items = []Item{
 	{Name: "Weathered Band of the Swamplord", ID: 27762, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Strength: 20, stats.Intellect: 14, stats.MeleeCrit: 21}, SocketBonus: stats.Stats{}},
 	{Name: "Wheeler Family Heirloom", ID: 30276, Type: proto.ItemType_ItemTypeNeck, Phase: 0, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 13, stats.Intellect: 9, stats.SpellPower: 7, stats.HealingPower: 27, stats.MP5: 4}, SocketBonus: stats.Stats{}},
 	{Name: "Whelpscale Gauntlets", ID: 31515, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 15, stats.SpellPower: 18, stats.HealingPower: 18, stats.MP5: 6, stats.SpellCrit: 15, stats.Armor: 349}, SocketBonus: stats.Stats{}},
-	{Name: "Whirlwind Bracers", ID: 28503, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 20, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 7, stats.Armor: 355}, SocketBonus: stats.Stats{}},
+	{Name: "Whirlwind Bracers", ID: 28503, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 19, stats.Intellect: 20, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 7, stats.Armor: 355}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
 	{Name: "Whispering Blade of Slaying", ID: 27913, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 109.0, WeaponDamageMax: 164.0, SwingSpeed: 1.90, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 21, stats.AttackPower: 26, stats.Expertise: 15, stats.RangedAttackPower: 26}, SocketBonus: stats.Stats{}},
 	{Name: "Whistling Sword", ID: 31541, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 64.0, WeaponDamageMax: 120.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 11, stats.AttackPower: 22, stats.MeleeCrit: 11, stats.RangedAttackPower: 22}, SocketBonus: stats.Stats{}},
 	{Name: "White Remedy Cape", ID: 24254, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.SpellPower: 20, stats.HealingPower: 79, stats.MP5: 7, stats.Armor: 76}, SocketBonus: stats.Stats{}},
@@ -4439,7 +4439,7 @@ var Items = []Item{
 	{Name: "Whitemend Hood", ID: 24264, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 15, stats.SpellPower: 27, stats.HealingPower: 106, stats.MP5: 11, stats.Armor: 145}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 4}, SetName: "Whitemend Wisdom"},
 	{Name: "Whitemend Pants", ID: 24261, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 21, stats.SpellPower: 21, stats.HealingPower: 83, stats.MP5: 11, stats.Armor: 156}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow, proto.GemColor_GemColorRed}, SocketBonus: stats.Stats{stats.Intellect: 4}, SetName: "Whitemend Wisdom"},
 	{Name: "Wicked Edge of the Planes", ID: 28433, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeAxe, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 184.0, WeaponDamageMax: 343.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 136, Stats: stats.Stats{stats.AttackPower: 48, stats.MeleeCrit: 23, stats.RangedAttackPower: 48}, SocketBonus: stats.Stats{}},
-	{Name: "Wicked Witch's Hat", ID: 28586, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 38, stats.SpellPower: 43, stats.HealingPower: 43, stats.SpellCrit: 32, stats.Armor: 158}, SocketBonus: stats.Stats{}},
+	{Name: "Wicked Witch's Hat", ID: 28586, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 38, stats.SpellPower: 43, stats.HealingPower: 43, stats.SpellCrit: 32, stats.Armor: 158}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Crone"},
 	{Name: "Widow's Remorse", ID: 22806, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeOneHand, WeaponDamageMin: 70.0, WeaponDamageMax: 131.0, SwingSpeed: 1.60, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 17, stats.MeleeHit: 10, stats.Armor: 100}, SocketBonus: stats.Stats{}},
 	{Name: "Wild Draenish Vest", ID: 25676, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Strength: 19, stats.Agility: 19, stats.Stamina: 28, stats.Intellect: 19, stats.SpellPower: 22, stats.HealingPower: 22, stats.Armor: 243}, SocketBonus: stats.Stats{}, SetName: "Wild Draenish Armor"},
 	{Name: "Wild Stalker Boots", ID: 27450, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 17, stats.AttackPower: 64, stats.MeleeHit: 17, stats.Armor: 448, stats.RangedAttackPower: 64}, SocketBonus: stats.Stats{}},
@@ -4463,21 +4463,21 @@ var Items = []Item{
 	{Name: "Windroc Greaves", ID: 25593, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Strength: 19, stats.Stamina: 28, stats.Armor: 667, stats.Dodge: 18}, SocketBonus: stats.Stats{}},
 	{Name: "Windroc Shroud", ID: 25595, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 22, stats.Spirit: 14, stats.SpellPower: 26, stats.HealingPower: 26, stats.SpellCrit: 21, stats.Armor: 130}, SocketBonus: stats.Stats{}},
 	{Name: "Windscale Hood", ID: 29504, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Stamina: 16, stats.Intellect: 18, stats.SpellPower: 44, stats.HealingPower: 44, stats.MP5: 10, stats.SpellCrit: 37, stats.Armor: 271}, SocketBonus: stats.Stats{}},
-	{Name: "Windshear Boots", ID: 28810, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 32, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHit: 18, stats.Armor: 605}, SocketBonus: stats.Stats{}},
+	{Name: "Windshear Boots", ID: 28810, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 125, Stats: stats.Stats{stats.Stamina: 37, stats.Intellect: 32, stats.SpellPower: 39, stats.HealingPower: 39, stats.SpellHit: 18, stats.Armor: 605}, SocketBonus: stats.Stats{}, SourceZone: "Gruul's Lair", SourceDrop: "Gruul the Dragonkiller"},
 	{Name: "Windslayer Wraps", ID: 29507, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 36, stats.Agility: 18, stats.Stamina: 25, stats.MeleeHit: 17, stats.Armor: 208}, SocketBonus: stats.Stats{}},
 	{Name: "Windstrike Gloves", ID: 29509, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 105, Stats: stats.Stats{stats.Strength: 36, stats.Agility: 18, stats.Stamina: 25, stats.MeleeHit: 17, stats.Armor: 465}, SocketBonus: stats.Stats{}},
 	{Name: "Wings of the Avatar", ID: 30163, ClassAllowlist: []proto.Class{proto.Class_ClassPriest}, Type: proto.ItemType_ItemTypeShoulder, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 133, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 24, stats.Spirit: 24, stats.SpellPower: 41, stats.HealingPower: 41, stats.SpellCrit: 17, stats.Armor: 168}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 4, stats.HealingPower: 4}, SetName: "Avatar Regalia"},
 	{Name: "Witches Band", ID: 33957, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 6}, SocketBonus: stats.Stats{}},
 	{Name: "Witches Band", ID: 34074, Type: proto.ItemType_ItemTypeFinger, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 22, stats.Intellect: 21, stats.SpellPower: 16, stats.HealingPower: 62, stats.MP5: 6}, SocketBonus: stats.Stats{}},
 	{Name: "Wolfshead Helm", ID: 8345, ClassAllowlist: []proto.Class{proto.Class_ClassDruid}, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 45, Stats: stats.Stats{stats.Spirit: 10, stats.Armor: 109}, SocketBonus: stats.Stats{}},
-	{Name: "Wolfslayer Sniper Rifle", ID: 28581, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 149.0, WeaponDamageMax: 278.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 32, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}},
+	{Name: "Wolfslayer Sniper Rifle", ID: 28581, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 149.0, WeaponDamageMax: 278.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Agility: 15, stats.AttackPower: 32, stats.RangedAttackPower: 32}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "The Big Bad Wolf"},
 	{Name: "Wonderheal XT40 Shades", ID: 32479, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 127, Stats: stats.Stats{stats.Stamina: 15, stats.Intellect: 38, stats.Spirit: 22, stats.SpellPower: 36, stats.HealingPower: 142, stats.Armor: 326}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
 	{Name: "Wonderheal XT68 Shades", ID: 35183, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 5, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 159, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 51, stats.Spirit: 35, stats.SpellPower: 45, stats.HealingPower: 179, stats.Armor: 405}, GemSockets: []proto.GemColor{proto.GemColor_GemColorMeta, proto.GemColor_GemColorBlue}, SocketBonus: stats.Stats{stats.SpellPower: 3, stats.HealingPower: 9}},
-	{Name: "Worgen Claw Necklace", ID: 28509, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 21, stats.AttackPower: 42, stats.MeleeHit: 17, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}},
-	{Name: "World Breaker", ID: 30090, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 371.0, WeaponDamageMax: 558.0, SwingSpeed: 3.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Strength: 50, stats.Stamina: 51}, SocketBonus: stats.Stats{}},
+	{Name: "Worgen Claw Necklace", ID: 28509, Type: proto.ItemType_ItemTypeNeck, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.Agility: 20, stats.Stamina: 21, stats.AttackPower: 42, stats.MeleeHit: 17, stats.RangedAttackPower: 42}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Attumen the Huntsman"},
+	{Name: "World Breaker", ID: 30090, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeMace, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 371.0, WeaponDamageMax: 558.0, SwingSpeed: 3.70, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 134, Stats: stats.Stats{stats.Strength: 50, stats.Stamina: 51}, SocketBonus: stats.Stats{}, SourceZone: "Serpentshrine Cavern", SourceDrop: "Fathom-Lord Karathress"},
 	{Name: "World's End Bracers", ID: 27522, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 18, stats.Intellect: 19, stats.SpellPower: 22, stats.HealingPower: 22, stats.SpellCrit: 17, stats.Armor: 285}, SocketBonus: stats.Stats{}},
 	{Name: "Worldfire Chestguard", ID: 28391, Type: proto.ItemType_ItemTypeChest, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 115, Stats: stats.Stats{stats.Stamina: 33, stats.Intellect: 32, stats.SpellPower: 40, stats.HealingPower: 40, stats.SpellCrit: 22, stats.Armor: 652}, SocketBonus: stats.Stats{}},
-	{Name: "Worldstorm Gauntlets", ID: 29976, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 22, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 9, stats.SpellCrit: 15, stats.Armor: 562}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}},
+	{Name: "Worldstorm Gauntlets", ID: 29976, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 21, stats.Intellect: 22, stats.SpellPower: 25, stats.HealingPower: 98, stats.MP5: 9, stats.SpellCrit: 15, stats.Armor: 562}, GemSockets: []proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 4}, SourceZone: "Tempest Keep", SourceDrop: "High Astromancer Solarian"},
 	{Name: "Wormhide Boots", ID: 21613, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Strength: 18, stats.Agility: 32, stats.Stamina: 13, stats.Armor: 186}, SocketBonus: stats.Stats{}},
 	{Name: "Wormhide Protector", ID: 21614, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Strength: 18, stats.Agility: 24, stats.Stamina: 26, stats.Armor: 219, stats.NatureResistance: 30}, SocketBonus: stats.Stats{}},
 	{Name: "Wormscale Blocker", ID: 21610, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeShield, HandType: proto.HandType_HandTypeOffHand, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 81, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 13, stats.SpellPower: 12, stats.HealingPower: 47, stats.MP5: 6, stats.Armor: 3488, stats.BlockValue: 75}, SocketBonus: stats.Stats{}},
@@ -4485,7 +4485,7 @@ var Items = []Item{
 	{Name: "Wraith Blade", ID: 22807, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeSword, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 39.0, WeaponDamageMax: 110.0, SwingSpeed: 1.80, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 83, QualityModifier: -23.900, Stats: stats.Stats{stats.Stamina: 10, stats.Intellect: 8, stats.SpellPower: 95, stats.HealingPower: 95, stats.SpellHit: 8, stats.SpellCrit: 14}, SocketBonus: stats.Stats{}},
 	{Name: "Wraithcloth Bindings", ID: 31531, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 8, stats.Spirit: 11, stats.SpellPower: 25, stats.HealingPower: 25, stats.Armor: 58}, SocketBonus: stats.Stats{}},
 	{Name: "Wrangler's Boots", ID: 29804, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Agility: 28, stats.AttackPower: 32, stats.Armor: 177, stats.RangedAttackPower: 32, stats.Dodge: 11}, SocketBonus: stats.Stats{}},
-	{Name: "Wraps of Precise Flight", ID: 32251, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 28, stats.Intellect: 20, stats.AttackPower: 58, stats.MeleeCrit: 19, stats.Armor: 432, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}},
+	{Name: "Wraps of Precise Flight", ID: 32251, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 141, Stats: stats.Stats{stats.Agility: 18, stats.Stamina: 28, stats.Intellect: 20, stats.AttackPower: 58, stats.MeleeCrit: 19, stats.Armor: 432, stats.RangedAttackPower: 58}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Supremus"},
 	{Name: "Wraps of Purification", ID: 32516, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 2, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 24, stats.Intellect: 25, stats.SpellPower: 18, stats.HealingPower: 71, stats.MP5: 7, stats.Armor: 94}, SocketBonus: stats.Stats{}},
 	{Name: "Wrath Infused Gauntlets", ID: 32077, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 110, Stats: stats.Stats{stats.Stamina: 34, stats.Intellect: 28, stats.SpellPower: 33, stats.HealingPower: 33, stats.MP5: 5, stats.Armor: 486}, SocketBonus: stats.Stats{}},
 	{Name: "Wrathfire Hand-Cannon", ID: 27898, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 90.0, WeaponDamageMax: 168.0, SwingSpeed: 2.00, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 112, Stats: stats.Stats{stats.AttackPower: 30, stats.MeleeCrit: 10, stats.RangedAttackPower: 30}, SocketBonus: stats.Stats{}},
@@ -4496,7 +4496,7 @@ var Items = []Item{
 	{Name: "Wristguards of Elemental Fury", ID: 21588, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeMail, Phase: 0, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 88, Stats: stats.Stats{stats.Stamina: 11, stats.Intellect: 13, stats.SpellPower: 25, stats.HealingPower: 25, stats.MP5: 6, stats.Armor: 276}, SocketBonus: stats.Stats{}},
 	{Name: "Wristguards of Tranquil Thought", ID: 33589, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 128, Stats: stats.Stats{stats.Stamina: 12, stats.Intellect: 17, stats.Spirit: 16, stats.SpellPower: 19, stats.HealingPower: 74, stats.SpellCrit: 17, stats.Armor: 94}, GemSockets: []proto.GemColor{proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.SpellPower: 2, stats.HealingPower: 2}},
 	{Name: "Wristguards of Vengeance", ID: 22936, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 83, Stats: stats.Stats{stats.Strength: 24, stats.Stamina: 10, stats.MeleeCrit: 14, stats.Armor: 465}, SocketBonus: stats.Stats{}},
-	{Name: "Wrynn Dynasty Greaves", ID: 28621, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 24, stats.Stamina: 48, stats.Armor: 1269, stats.Defense: 27, stats.Dodge: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}},
+	{Name: "Wrynn Dynasty Greaves", ID: 28621, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 24, stats.Stamina: 48, stats.Armor: 1269, stats.Defense: 27, stats.Dodge: 29}, GemSockets: []proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow, proto.GemColor_GemColorYellow}, SocketBonus: stats.Stats{stats.Stamina: 6}, SourceZone: "Karazhan", SourceDrop: "The Curator"},
 	{Name: "Wub's Cursed Hexblade", ID: 33354, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeDagger, HandType: proto.HandType_HandTypeMainHand, WeaponDamageMin: 22.0, WeaponDamageMax: 126.0, SwingSpeed: 1.80, Phase: 4, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 132, QualityModifier: -54.300, Stats: stats.Stats{stats.Intellect: 21, stats.SpellPower: 217, stats.HealingPower: 217, stats.MP5: 6, stats.SpellHit: 13, stats.SpellCrit: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Wyrmcultist's Cloak", ID: 31341, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Unique: true, Ilvl: 108, Stats: stats.Stats{stats.Stamina: 14, stats.Armor: 67, stats.FireResistance: 24}, SocketBonus: stats.Stats{}},
 	{Name: "Wyrmcultist's Hood", ID: 31509, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Intellect: 10, stats.Spirit: 10, stats.SpellPower: 25, stats.HealingPower: 25, stats.SpellCrit: 37, stats.Armor: 108}, SocketBonus: stats.Stats{}},
@@ -4511,8 +4511,8 @@ var Items = []Item{
 	{Name: "X-52 Pilot's Leggings", ID: 30014, Type: proto.ItemType_ItemTypeLegs, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Agility: 31, stats.Stamina: 46, stats.AttackPower: 62, stats.Armor: 236, stats.RangedAttackPower: 62}, SocketBonus: stats.Stats{}},
 	{Name: "X-52 Rocket Helmet", ID: 30847, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 108, Stats: stats.Stats{stats.Armor: 108}, SocketBonus: stats.Stats{}},
 	{Name: "X-52 Technician's Helm", ID: 30016, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Ilvl: 106, Stats: stats.Stats{stats.Strength: 38, stats.Stamina: 36, stats.Intellect: 16, stats.MP5: 6, stats.Armor: 875, stats.Defense: 16}, SocketBonus: stats.Stats{}},
-	{Name: "Xavian Stiletto", ID: 28659, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 88.0, WeaponDamageMax: 133.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.MeleeHit: 12, stats.MeleeCrit: 20}, SocketBonus: stats.Stats{}},
-	{Name: "Xi'ri's Gift", ID: 29179, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellCrit: 32}, SocketBonus: stats.Stats{}},
+	{Name: "Xavian Stiletto", ID: 28659, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeThrown, WeaponDamageMin: 88.0, WeaponDamageMax: 133.0, SwingSpeed: 1.40, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.MeleeHit: 12, stats.MeleeCrit: 20}, SocketBonus: stats.Stats{}, SourceZone: "Karazhan", SourceDrop: "Terestian Illhoof"},
+	{Name: "Xi'ri's Gift", ID: 29179, Type: proto.ItemType_ItemTypeTrinket, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Unique: true, Ilvl: 115, Stats: stats.Stats{stats.SpellCrit: 32}, SocketBonus: stats.Stats{}, HasEffect: true, SourceZone: "Shattrath City", SourceVendor: "Almaador", ReputationFaction: "The Sha'tar", ReputationLevel: proto.RepLevel_RepLevelRevered},
 	{Name: "Yor's Collapsing Band", ID: 31921, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Intellect: 20, stats.Spirit: 19, stats.SpellPower: 23, stats.HealingPower: 23}, SocketBonus: stats.Stats{}},
 	{Name: "Yor's Revenge", ID: 31924, Type: proto.ItemType_ItemTypeFinger, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Unique: true, Ilvl: 100, Stats: stats.Stats{stats.Stamina: 30, stats.Armor: 190, stats.Defense: 20}, SocketBonus: stats.Stats{}},
 	{Name: "Zaxxis Boots", ID: 30266, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 13, stats.Stamina: 19, stats.Armor: 177, stats.Dodge: 12}, SocketBonus: stats.Stats{}},
@@ -4520,6 +4520,6 @@ var Items = []Item{
 	{Name: "Zaxxis Gloves", ID: 30267, Type: proto.ItemType_ItemTypeHands, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 111, Stats: stats.Stats{stats.Strength: 13, stats.Agility: 12, stats.Stamina: 19, stats.MeleeHit: 13, stats.Armor: 639}, SocketBonus: stats.Stats{}},
 	{Name: "Zephyrion's Belt", ID: 30254, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypePlate, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 114, Stats: stats.Stats{stats.Intellect: 12, stats.SpellPower: 19, stats.HealingPower: 19, stats.MP5: 12, stats.Armor: 590}, SocketBonus: stats.Stats{}},
 	{Name: "Zerid's Vintage Musket", ID: 25544, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeGun, WeaponDamageMin: 102.0, WeaponDamageMax: 190.0, SwingSpeed: 2.70, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Ilvl: 105, Stats: stats.Stats{stats.Agility: 8, stats.Stamina: 12, stats.AttackPower: 14, stats.RangedAttackPower: 14}, SocketBonus: stats.Stats{}},
-	{Name: "Zhar'doom, Greatstaff of the Devourer", ID: 32374, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 146.0, WeaponDamageMax: 323.0, SwingSpeed: 3.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 70, stats.Intellect: 47, stats.SpellPower: 259, stats.HealingPower: 259, stats.SpellCrit: 36, stats.SpellHaste: 55}, SocketBonus: stats.Stats{}},
+	{Name: "Zhar'doom, Greatstaff of the Devourer", ID: 32374, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff, HandType: proto.HandType_HandTypeTwoHand, WeaponDamageMin: 146.0, WeaponDamageMax: 323.0, SwingSpeed: 3.20, Phase: 3, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 151, QualityModifier: -64.800, Stats: stats.Stats{stats.Stamina: 70, stats.Intellect: 47, stats.SpellPower: 259, stats.HealingPower: 259, stats.SpellCrit: 36, stats.SpellHaste: 55}, SocketBonus: stats.Stats{}, SourceZone: "Black Temple", SourceDrop: "Illidan Stormrage"},
 	{Name: "Zierhut's Lost Treads", ID: 30674, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Ilvl: 115, Stats: stats.Stats{stats.Strength: 34, stats.Agility: 28, stats.Stamina: 33, stats.Armor: 390}, SocketBonus: stats.Stats{}},
 }
@@ -2,6 +2,7 @@ package items
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
@@ -14,6 +15,7 @@ var GemsByName = map[string]Gem{}
 var GemsByID = map[int32]Gem{}
 var EnchantsByName = map[string]Enchant{}
 var EnchantsByID = map[int32]Enchant{}
+var RandomSuffixesByID = map[int32]RandomSuffix{}
 
 func init() {
 	for _, v := range Enchants {
@@ -25,32 +27,32 @@ func init() {
 		GemsByID[v.ID] = v
 	}
 
-	// Add hard-coded items. Wowhead doesn't seem to have tooltips for random enchant items.
-	// Use negative IDs to avoid collisions with real item IDs.
-	Items = append(Items, []Item{
-		{Name: "Glider's Boots of Nature's Wrath", WowheadID: 30681, ID: -1, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 250, stats.NatureSpellPower: 78}},
-		{Name: "Glider's Foot-Wraps of Arcane Wrath", WowheadID: 30680, ID: -2, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 134, stats.ArcaneSpellPower: 78}},
-		{Name: "Glider's Foot-Wraps of Fiery Wrath", WowheadID: 30680, ID: -3, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 134, stats.FireSpellPower: 78}},
-		{Name: "Glider's Foot-Wraps of Frozen Wrath", WowheadID: 30680, ID: -4, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 134, stats.FrostSpellPower: 78}},
-		{Name: "Glider's Foot-Wraps of Shadow Wrath", WowheadID: 30680, ID: -5, Type: proto.ItemType_ItemTypeFeet, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 134, stats.ShadowSpellPower: 78}},
-		{Name: "Lurker's Cord of Arcane Wrath", WowheadID: 30675, ID: -6, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 109, stats.ArcaneSpellPower: 78}},
-		{Name: "Lurker's Cord of Fiery Wrath", WowheadID: 30675, ID: -7, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 109, stats.FireSpellPower: 78}},
-		{Name: "Lurker's Cord of Frozen Wrath", WowheadID: 30675, ID: -8, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 109, stats.FrostSpellPower: 78}},
-		{Name: "Lurker's Cord of Shadow Wrath", WowheadID: 30675, ID: -9, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 109, stats.ShadowSpellPower: 78}},
-		{Name: "Lurker's Grasp of Nature's Wrath", WowheadID: 30676, ID: -10, Type: proto.ItemType_ItemTypeWaist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 205, stats.NatureSpellPower: 78}},
-		{Name: "Ravager's Cuffs of Arcane Wrath", WowheadID: 30684, ID: -11, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 85, stats.ArcaneSpellPower: 58}},
-		{Name: "Ravager's Cuffs of Fiery Wrath", WowheadID: 30684, ID: -12, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 85, stats.FireSpellPower: 58}},
-		{Name: "Ravager's Cuffs of Frozen Wrath", WowheadID: 30684, ID: -13, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 85, stats.FrostSpellPower: 58}},
-		{Name: "Ravager's Cuffs of Shadow Wrath", WowheadID: 30684, ID: -14, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeCloth, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 85, stats.ShadowSpellPower: 58}},
-		{Name: "Ravager's Wrist-Wraps of Nature's Wrath", WowheadID: 30685, ID: -15, Type: proto.ItemType_ItemTypeWrist, ArmorType: proto.ArmorType_ArmorTypeLeather, Phase: 1, Quality: proto.ItemQuality_ItemQualityEpic, Stats: stats.Stats{stats.Armor: 159, stats.NatureSpellPower: 58}},
-		{Name: "Flawless Wand of Shadow Wrath", WowheadID: 25295, ID: -16, Type: proto.ItemType_ItemTypeRanged, RangedWeaponType: proto.RangedWeaponType_RangedWeaponTypeWand, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Stats: stats.Stats{stats.ShadowSpellPower: 25}},
-		{Name: "Amber Cape of Shadow Wrath", WowheadID: 25043, ID: -17, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Stats: stats.Stats{stats.ShadowSpellPower: 45}},
-		{Name: "Illidari Cape of Shadow Wrath", WowheadID: 31201, ID: -18, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Stats: stats.Stats{stats.ShadowSpellPower: 47}},
-		{Name: "Elementalist Bracelets of Shadow Wrath", WowheadID: 24692, ID: -19, Type: proto.ItemType_ItemTypeWrist, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Stats: stats.Stats{stats.ShadowSpellPower: 45}},
-		{Name: "Amber Cape of Shadow Wrath", WowheadID: 25043, ID: -20, Type: proto.ItemType_ItemTypeBack, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Stats: stats.Stats{stats.ShadowSpellPower: 45}},
-		{Name: "Elementalist Gloves of Shadow Wrath", WowheadID: 24688, ID: -21, Type: proto.ItemType_ItemTypeHands, Phase: 1, Quality: proto.ItemQuality_ItemQualityUncommon, Stats: stats.Stats{stats.ShadowSpellPower: 60}},
-		{Name: "Nethersteel-Lined Handwraps of Shadow Wrath", WowheadID: 31166, ID: -22, Type: proto.ItemType_ItemTypeHands, Phase: 1, Quality: proto.ItemQuality_ItemQualityRare, Stats: stats.Stats{stats.ShadowSpellPower: 62}},
-	}...)
+	for _, v := range RandomSuffixes {
+		RandomSuffixesByID[v.ID] = v
+	}
+
+	Items = append(Items, RandomSuffixItems...)
+
+	// Items from before random suffixes were supported, which used negative IDs
+	// with the suffix baked in. Kept so that saved gear still loads.
+	randomSuffixBases := make(map[int32]Item, len(RandomSuffixItems))
+	for _, v := range RandomSuffixItems {
+		randomSuffixBases[v.ID] = v
+	}
+	for _, legacy := range legacyRandomSuffixItems {
+		base := randomSuffixBases[legacy.BaseID]
+		item, err := base.WithRandomSuffix(legacy.SuffixID)
+		if err != nil {
+			panic(err)
+		}
+		item.ID = legacy.ID
+		item.WowheadID = base.ID
+		item.Name = base.Name + " " + item.RandomSuffix.Name
+		item.RandomSuffix = RandomSuffix{}
+		item.RandomPropPoints = 0
+		item.RandomSuffixOptions = nil
+		Items = append(Items, item)
+	}
 
 	for _, v := range Items {
 		if _, ok := ByID[v.ID]; ok {
@@ -96,9 +98,27 @@ type Item struct {
 	GemSockets  []proto.GemColor
 	SocketBonus stats.Stats
 
+	// Whether the tooltip describes a proc or use effect, which only works if
+	// the sim registers an effect for this item.
+	HasEffect bool
+
+	// Vendor and cost, for bought items.
+	SourceVendor string
+	BadgeCost    int32
+
+	// Reputation needed to buy or receive the item, if any.
+	ReputationFaction string
+	ReputationLevel   proto.RepLevel
+
+	// For items with random suffixes, the points used to compute suffix stats
+	// and the IDs of the suffixes the item can have.
+	RandomPropPoints    int32
+	RandomSuffixOptions []int32
+
 	// Modified for each instance of the item.
-	Gems    []Gem
-	Enchant Enchant
+	Gems         []Gem
+	Enchant      Enchant
+	RandomSuffix RandomSuffix
 }
 
 func (item Item) ToProto() *proto.Item {
@@ -122,14 +142,49 @@ func (item Item) ToProto() *proto.Item {
 		Ilvl:             item.Ilvl,
 		GemSockets:       item.GemSockets,
 		SocketBonus:      item.SocketBonus[:],
+		HasEffect:        item.HasEffect,
+
+		SourceZone:        item.SourceZone,
+		SourceDrop:        item.SourceDrop,
+		SourceVendor:      item.SourceVendor,
+		BadgeCost:         item.BadgeCost,
+		ReputationFaction: item.ReputationFaction,
+		ReputationLevel:   item.ReputationLevel,
+
+		RandomPropPoints:    item.RandomPropPoints,
+		RandomSuffixOptions: item.RandomSuffixOptions,
+	}
+}
+
+// Returns a copy of this item with the given random suffix and its stats, or
+// an error if the item can't have that suffix.
+func (item Item) WithRandomSuffix(suffixID int32) (Item, error) {
+	suffix, ok := RandomSuffixesByID[suffixID]
+	if !ok {
+		return Item{}, fmt.Errorf("No random suffix with id: %d", suffixID)
+	}
+	allowed := false
+	for _, option := range item.RandomSuffixOptions {
+		if option == suffixID {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return Item{}, fmt.Errorf("Item %s can't have random suffix %s", item.Name, suffix.Name)
 	}
+
+	item.RandomSuffix = suffix
+	item.Stats = item.Stats.Add(suffix.StatsFor(item.RandomPropPoints))
+	return item, nil
 }
 
 func (item Item) ToItemSpecProto() *proto.ItemSpec {
 	itemSpec := &proto.ItemSpec{
-		Id:      item.ID,
-		Enchant: item.Enchant.ID,
-		Gems:    []int32{},
+		Id:           item.ID,
+		Enchant:      item.Enchant.ID,
+		Gems:         []int32{},
+		RandomSuffix: item.RandomSuffix.ID,
 	}
 	for _, gem := range item.Gems {
 		itemSpec.Gems = append(itemSpec.Gems, gem.ID)
@@ -190,10 +245,36 @@ func (gem Gem) ToProto() *proto.Gem {
 	}
 }
 
+type RandomSuffix struct {
+	ID   int32
+	Name string
+
+	// Stats per 10000 random property points.
+	Allocation stats.Stats
+}
+
+// Returns the stats this suffix gives an item with the given points.
+func (suffix RandomSuffix) StatsFor(randomPropPoints int32) stats.Stats {
+	suffixStats := stats.Stats{}
+	for stat, allocation := range suffix.Allocation {
+		suffixStats[stat] = math.Floor(float64(randomPropPoints) * allocation / 10000)
+	}
+	return suffixStats
+}
+
+func (suffix RandomSuffix) ToProto() *proto.RandomSuffix {
+	return &proto.RandomSuffix{
+		Id:    suffix.ID,
+		Name:  suffix.Name,
+		Stats: suffix.Allocation[:],
+	}
+}
+
 type ItemSpec struct {
-	ID      int32
-	Enchant int32
-	Gems    []int32
+	ID           int32
+	Enchant      int32
+	Gems         []int32
+	RandomSuffix int32
 }
 
 type Equipment [proto.ItemSlot_ItemSlotRanged + 1]Item
@@ -258,6 +339,7 @@ func ProtoToEquipmentSpec(es proto.EquipmentSpec) EquipmentSpec {
 		}
 		spec.Gems = item.Gems
 		spec.Enchant = item.Enchant
+		spec.RandomSuffix = item.RandomSuffix
 		coreEquip[i] = spec
 	}
 
@@ -272,6 +354,14 @@ func NewItem(itemSpec ItemSpec) Item {
 		panic(fmt.Sprintf("No item with id: %d", itemSpec.ID))
 	}
 
+	if itemSpec.RandomSuffix != 0 {
+		suffixedItem, err := item.WithRandomSuffix(itemSpec.RandomSuffix)
+		if err != nil {
+			panic(err)
+		}
+		item = suffixedItem
+	}
+
 	if itemSpec.Enchant != 0 {
 		if enchant, ok := EnchantsByID[itemSpec.Enchant]; ok {
 			item.Enchant = enchant
@@ -0,0 +1,103 @@
+package items
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Requirements for a meta gem to be active, based on the colors of the other
+// equipped gems. Gems count towards every color they match, e.g. orange gems
+// count as both red and yellow.
+type MetaGemRequirement struct {
+	MinRed    int
+	MinYellow int
+	MinBlue   int
+
+	// If set, requires more gems of the first color than of the second.
+	MoreThan [2]proto.GemColor
+}
+
+// Meta gems without an entry here are treated as always active.
+var MetaGemRequirements = map[int32]MetaGemRequirement{
+	25890: {MinRed: 2, MinYellow: 2, MinBlue: 2},                                                     // Destructive Skyfire Diamond
+	25893: {MoreThan: [2]proto.GemColor{proto.GemColor_GemColorBlue, proto.GemColor_GemColorYellow}}, // Mystical Skyfire Diamond
+	25894: {MinRed: 1, MinYellow: 2},                                                                 // Swift Skyfire Diamond
+	25895: {MoreThan: [2]proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorYellow}},  // Enigmatic Skyfire Diamond
+	25896: {MinBlue: 3},                                                                              // Powerful Earthstorm Diamond
+	25897: {MoreThan: [2]proto.GemColor{proto.GemColor_GemColorRed, proto.GemColor_GemColorBlue}},    // Bracing Earthstorm Diamond
+	25898: {MinBlue: 5},                                                                              // Tenacious Earthstorm Diamond
+	25901: {MinRed: 2, MinYellow: 2, MinBlue: 2},                                                     // Insightful Earthstorm Diamond
+	32409: {MinRed: 2, MinYellow: 2, MinBlue: 2},                                                     // Relentless Earthstorm Diamond
+	34220: {MinBlue: 2},                                                                              // Chaotic Skyfire Diamond
+	35503: {MinRed: 3},                                                                               // Ember Skyfire Diamond
+}
+
+// Number of equipped gems matching each primary color.
+type GemColorCounts struct {
+	Red    int
+	Yellow int
+	Blue   int
+}
+
+func (counts *GemColorCounts) AddGem(gem Gem) {
+	if gem.Color == proto.GemColor_GemColorMeta || gem.Color == proto.GemColor_GemColorUnknown {
+		return
+	}
+	if ColorIntersects(gem.Color, proto.GemColor_GemColorRed) {
+		counts.Red++
+	}
+	if ColorIntersects(gem.Color, proto.GemColor_GemColorYellow) {
+		counts.Yellow++
+	}
+	if ColorIntersects(gem.Color, proto.GemColor_GemColorBlue) {
+		counts.Blue++
+	}
+}
+
+func (counts GemColorCounts) Get(color proto.GemColor) int {
+	switch color {
+	case proto.GemColor_GemColorRed:
+		return counts.Red
+	case proto.GemColor_GemColorYellow:
+		return counts.Yellow
+	case proto.GemColor_GemColorBlue:
+		return counts.Blue
+	}
+	return 0
+}
+
+func (req MetaGemRequirement) IsMet(counts GemColorCounts) bool {
+	if counts.Red < req.MinRed || counts.Yellow < req.MinYellow || counts.Blue < req.MinBlue {
+		return false
+	}
+	if req.MoreThan[0] != proto.GemColor_GemColorUnknown && counts.Get(req.MoreThan[0]) <= counts.Get(req.MoreThan[1]) {
+		return false
+	}
+	return true
+}
+
+func (equipment Equipment) GemColorCounts() GemColorCounts {
+	counts := GemColorCounts{}
+	for _, item := range equipment {
+		for _, gem := range item.Gems {
+			counts.AddGem(gem)
+		}
+	}
+	return counts
+}
+
+// Returns true if the equipped meta gem's requirements are met, or if there is
+// no meta gem.
+func (equipment Equipment) MetaGemIsActive() bool {
+	counts := equipment.GemColorCounts()
+	for _, item := range equipment {
+		for _, gem := range item.Gems {
+			if gem.Color != proto.GemColor_GemColorMeta {
+				continue
+			}
+			if req, ok := MetaGemRequirements[gem.ID]; ok && !req.IsMet(counts) {
+				return false
+			}
+		}
+	}
+	return true
+}
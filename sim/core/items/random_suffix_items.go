@@ -0,0 +1,42 @@
+package items
+
+const (
+	suffixArcaneWrath = 36
+	suffixFieryWrath  = 37
+	suffixFrozenWrath = 38
+	suffixNatureWrath = 39
+	suffixShadowWrath = 40
+)
+
+// Maps the negative IDs used before random suffixes were supported to the base
+// item and suffix they stand for. The base items are in all_random_suffix_items.go.
+type legacyRandomSuffixItem struct {
+	ID       int32
+	BaseID   int32
+	SuffixID int32
+}
+
+var legacyRandomSuffixItems = []legacyRandomSuffixItem{
+	{ID: -1, BaseID: 30681, SuffixID: suffixNatureWrath},
+	{ID: -2, BaseID: 30680, SuffixID: suffixArcaneWrath},
+	{ID: -3, BaseID: 30680, SuffixID: suffixFieryWrath},
+	{ID: -4, BaseID: 30680, SuffixID: suffixFrozenWrath},
+	{ID: -5, BaseID: 30680, SuffixID: suffixShadowWrath},
+	{ID: -6, BaseID: 30675, SuffixID: suffixArcaneWrath},
+	{ID: -7, BaseID: 30675, SuffixID: suffixFieryWrath},
+	{ID: -8, BaseID: 30675, SuffixID: suffixFrozenWrath},
+	{ID: -9, BaseID: 30675, SuffixID: suffixShadowWrath},
+	{ID: -10, BaseID: 30676, SuffixID: suffixNatureWrath},
+	{ID: -11, BaseID: 30684, SuffixID: suffixArcaneWrath},
+	{ID: -12, BaseID: 30684, SuffixID: suffixFieryWrath},
+	{ID: -13, BaseID: 30684, SuffixID: suffixFrozenWrath},
+	{ID: -14, BaseID: 30684, SuffixID: suffixShadowWrath},
+	{ID: -15, BaseID: 30685, SuffixID: suffixNatureWrath},
+	{ID: -16, BaseID: 25295, SuffixID: suffixShadowWrath},
+	{ID: -17, BaseID: 25043, SuffixID: suffixShadowWrath},
+	{ID: -18, BaseID: 31201, SuffixID: suffixShadowWrath},
+	{ID: -19, BaseID: 24692, SuffixID: suffixShadowWrath},
+	{ID: -20, BaseID: 25043, SuffixID: suffixShadowWrath},
+	{ID: -21, BaseID: 24688, SuffixID: suffixShadowWrath},
+	{ID: -22, BaseID: 31166, SuffixID: suffixShadowWrath},
+}
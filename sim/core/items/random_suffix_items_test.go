@@ -0,0 +1,34 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestRandomSuffixItems(t *testing.T) {
+	// Elementalist Bracelets of Shadow Wrath.
+	item := NewItem(ItemSpec{ID: 24692, RandomSuffix: 40})
+	legacy := ByID[-19]
+	if item.Stats != legacy.Stats || item.Stats[stats.ShadowSpellPower] != 45 {
+		t.Fatalf("Expected the suffix stats to match the legacy item, but got %v", item.Stats)
+	}
+	if spec := item.ToItemSpecProto(); spec.Id != 24692 || spec.RandomSuffix != 40 {
+		t.Fatalf("Expected the random suffix in the item spec, but got %v", spec)
+	}
+
+	base := NewItem(ItemSpec{ID: 24692})
+	if base.Stats[stats.ShadowSpellPower] != 0 {
+		t.Fatalf("Expected no suffix stats without a suffix, but got %v", base.Stats)
+	}
+}
+
+func TestRandomSuffixNotAllowed(t *testing.T) {
+	// Elementalist Bracelets only come with Shadow Wrath.
+	if _, err := ByID[24692].WithRandomSuffix(suffixArcaneWrath); err == nil {
+		t.Fatalf("Expected an error for a suffix the item can't have")
+	}
+	if _, err := ByID[24692].WithRandomSuffix(1); err == nil {
+		t.Fatalf("Expected an error for an unknown suffix")
+	}
+}
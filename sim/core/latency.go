@@ -0,0 +1,63 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Delay between an action becoming available and the player reacting to it.
+//
+// Latency is only added when the player reacts to something they couldn't
+// queue their next action for: a hardcast or channel ending after the GCD, and
+// a pause until a known time ends, e.g. waiting for a CD or proc. Actions
+// following an instant's GCD or an energy tick are queued, so they don't add
+// latency.
+type Latency struct {
+	Base   time.Duration
+	Jitter time.Duration
+}
+
+func NewLatency(latencyProto *proto.Latency) Latency {
+	if latencyProto == nil {
+		return Latency{}
+	}
+	return Latency{
+		Base:   DurationFromSeconds(latencyProto.LatencyMs / 1000),
+		Jitter: DurationFromSeconds(latencyProto.JitterMs / 1000),
+	}
+}
+
+// Returns the latency for a single action.
+func (latency Latency) Sample(sim *Simulation) time.Duration {
+	if latency.Jitter == 0 {
+		return latency.Base
+	}
+
+	offset := time.Duration((sim.RandomFloat("Latency")*2 - 1) * float64(latency.Jitter))
+	return MaxDuration(0, latency.Base+offset)
+}
+
+// Overrides the sim-wide latency for this unit.
+func (unit *Unit) SetLatency(latency Latency) {
+	unit.latency = &latency
+}
+
+// Adds latency on top of this unit's player or sim-wide latency, for specs
+// with their own latency setting.
+func (unit *Unit) AddLatency(latency Latency) {
+	unit.extraLatency = latency
+}
+
+// Returns the latency to add to this unit's next action. Only players have
+// latency.
+func (unit *Unit) ReactionTime(sim *Simulation) time.Duration {
+	if unit.Type != PlayerUnit {
+		return 0
+	}
+	latency := sim.latency
+	if unit.latency != nil {
+		latency = *unit.latency
+	}
+	return latency.Sample(sim) + unit.extraLatency.Sample(sim)
+}
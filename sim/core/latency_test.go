@@ -0,0 +1,56 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func runLatencySim(basePlayer *proto.Player, simLatency *proto.Latency, playerLatency *proto.Latency) float64 {
+	player := googleProto.Clone(basePlayer).(*proto.Player)
+	player.Latency = playerLatency
+
+	return runTestSim(singlePlayerRaid(player), testEncounter, &proto.SimOptions{
+		Iterations: 1,
+		IsTest:     true,
+		Latency:    simLatency,
+	}).RaidMetrics.Dps.Avg
+}
+
+func TestPlayerLatency(t *testing.T) {
+	runSim := func(simLatency *proto.Latency, playerLatency *proto.Latency) float64 {
+		return runLatencySim(testElementalShaman, simLatency, playerLatency)
+	}
+
+	baseDps := runSim(nil, nil)
+	latencyDps := runSim(&proto.Latency{LatencyMs: 300, JitterMs: 100}, nil)
+	if latencyDps >= baseDps {
+		t.Fatalf("Expected latency to lower dps, but got %0.2f vs %0.2f", latencyDps, baseDps)
+	}
+
+	overrideDps := runSim(&proto.Latency{LatencyMs: 300, JitterMs: 100}, &proto.Latency{})
+	if overrideDps != baseDps {
+		t.Fatalf("Expected player latency to override sim latency, but got %0.2f vs %0.2f", overrideDps, baseDps)
+	}
+}
+
+func TestSpecLatencyCombinesWithPlayerLatency(t *testing.T) {
+	// These specs model some of their latency in the rotation, which must not
+	// hide the player's latency.
+	for _, player := range []*proto.Player{testHunter, testShadowPriest} {
+		baseDps := runLatencySim(player, nil, nil)
+		latencyDps := runLatencySim(player, nil, &proto.Latency{LatencyMs: 300})
+		if latencyDps >= baseDps {
+			t.Fatalf("Expected player latency to lower %s dps, but got %0.2f vs %0.2f", player.Name, latencyDps, baseDps)
+		}
+	}
+}
+
+func TestEnhancementLatency(t *testing.T) {
+	baseDps := runLatencySim(testEnhancementShaman, nil, nil)
+	latencyDps := runLatencySim(testEnhancementShaman, &proto.Latency{LatencyMs: 300, JitterMs: 100}, nil)
+	if latencyDps >= baseDps {
+		t.Fatalf("Expected latency to lower Enhancement dps, but got %0.2f vs %0.2f", latencyDps, baseDps)
+	}
+}
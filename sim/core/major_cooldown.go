@@ -129,16 +129,38 @@ func (mcd *MajorCooldown) tryActivateHelper(sim *Simulation, character *Characte
 	}
 
 	if shouldActivate {
-		mcd.activate(sim, character)
-		mcd.numUsages++
-		if sim.Log != nil {
-			character.Log(sim, "Major cooldown used: %s", mcd.Spell.ActionID)
-		}
+		mcd.doActivate(sim, character)
 	}
 
 	return shouldActivate
 }
 
+// Activates this MCD if its hard requirements are met, ignoring timings and
+// ShouldActivate. Used for cast sequences, see cast_sequence.go.
+func (mcd *MajorCooldown) forceActivate(sim *Simulation, character *Character) bool {
+	if mcd.Spell.DefaultCast.GCD > 0 && !character.GCD.IsReady(sim) {
+		return false
+	}
+
+	if !mcd.CanActivate(sim, character) {
+		return false
+	}
+
+	mcd.doActivate(sim, character)
+	return true
+}
+
+func (mcd *MajorCooldown) doActivate(sim *Simulation, character *Character) {
+	mcd.activate(sim, character)
+	mcd.numUsages++
+	if sim.Log != nil {
+		character.Log(sim, "Major cooldown used: %s", mcd.Spell.ActionID)
+	}
+	if sim.RecordingTimeline() {
+		sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventMajorCooldownUsed, &character.Unit, mcd.Spell.ActionID)
+	}
+}
+
 type majorCooldownManager struct {
 	// The Character whose cooldowns are being managed.
 	character *Character
@@ -342,6 +364,11 @@ func (mcdm *majorCooldownManager) EnableAllCooldowns(mcdsToEnable []*MajorCooldo
 }
 
 func (mcdm *majorCooldownManager) TryUseCooldowns(sim *Simulation) {
+	// Cast sequences only use cooldowns when they're listed.
+	if mcdm.character.castSequence != nil {
+		return
+	}
+
 	anyCooldownsUsed := false
 	for curIdx := 0; curIdx < len(mcdm.majorCooldowns) && mcdm.majorCooldowns[curIdx].IsReady(sim); curIdx++ {
 		mcd := mcdm.majorCooldowns[curIdx]
@@ -56,6 +56,9 @@ func (unit *Unit) AddMana(sim *Simulation, amount float64, actionID ActionID, is
 	if sim.Log != nil {
 		unit.Log(sim, "Gained %0.3f mana from %s (%0.3f --> %0.3f).", amount, actionID, oldMana, newMana)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(unit, actionID, proto.ResourceType_ResourceTypeMana, oldMana, newMana)
+	}
 
 	unit.stats[stats.Mana] = newMana
 	unit.Metrics.ManaGained += newMana - oldMana
@@ -75,6 +78,9 @@ func (unit *Unit) SpendMana(sim *Simulation, amount float64, actionID ActionID)
 	if sim.Log != nil {
 		unit.Log(sim, "Spent %0.3f mana from %s (%0.3f --> %0.3f).", amount, actionID, unit.CurrentMana(), newMana)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(unit, actionID, proto.ResourceType_ResourceTypeMana, unit.CurrentMana(), newMana)
+	}
 
 	unit.stats[stats.Mana] = newMana
 	unit.Metrics.ManaSpent += amount
@@ -192,6 +198,9 @@ func (sim *Simulation) initManaTickAction() {
 	}
 	pa.OnAction = func(sim *Simulation) {
 		for _, player := range playersWithManaBars {
+			if player.GetCharacter().IsDead() {
+				continue
+			}
 			player.GetCharacter().ManaTick(sim)
 			player.OnManaTick(sim)
 		}
@@ -50,6 +50,15 @@ func (distMetrics *DistributionMetrics) ToProto(numIterations int32) *proto.Dist
 	}
 }
 
+// Returns the half-width of the confidence interval for the average, where z
+// is the z-score for the desired confidence level.
+func (distMetrics *DistributionMetrics) confidenceHalfWidth(numIterations int32, z float64) float64 {
+	n := float64(numIterations)
+	avg := distMetrics.sum / n
+	variance := math.Max(distMetrics.sumSquared/n-avg*avg, 0)
+	return z * math.Sqrt(variance/n)
+}
+
 func NewDistributionMetrics() DistributionMetrics {
 	return DistributionMetrics{
 		hist: make(map[int32]int32),
@@ -60,13 +69,19 @@ type UnitMetrics struct {
 	dps    DistributionMetrics
 	threat DistributionMetrics
 	dtps   DistributionMetrics
+	hps    DistributionMetrics
+	ohps   DistributionMetrics
 
 	CharacterIterationMetrics
 
 	// Aggregate values. These are updated after each iteration.
-	oomTimeSum float64
-	actions    map[ActionID]*ActionMetrics
-	resources  map[ResourceKey]*ResourceMetrics
+	oomTimeSum      float64
+	movementTimeSum float64
+	deathCount      int32
+	timeOfDeathSum  float64
+	minHealthSum    float64
+	actions         map[ActionID]*ActionMetrics
+	resources       map[ResourceKey]*ResourceMetrics
 }
 
 // Metrics for the current iteration, for 1 agent. Keep this as a separate
@@ -79,6 +94,12 @@ type CharacterIterationMetrics struct {
 	BonusManaGained float64 // Only includes amount from mana pots / runes / innervates.
 
 	OOMTime time.Duration // time spent not casting and waiting for regen.
+
+	MovementTime time.Duration // time lost to cancelled casts and paused swings while moving.
+
+	Died        bool          // Whether the agent's health reached 0 in this iteration.
+	TimeOfDeath time.Duration // Time at which health first reached 0.
+	MinHealth   float64       // Lowest health reached in this iteration.
 }
 
 type ActionMetrics struct {
@@ -112,8 +133,10 @@ type TargetedActionMetrics struct {
 	Blocks  int32
 	Glances int32
 
-	Damage float64
-	Threat float64
+	Damage      float64
+	Threat      float64
+	Healing     float64
+	Overhealing float64
 }
 
 func (tam *TargetedActionMetrics) ToProto() *proto.TargetedActionMetrics {
@@ -129,6 +152,9 @@ func (tam *TargetedActionMetrics) ToProto() *proto.TargetedActionMetrics {
 		Glances: tam.Glances,
 		Damage:  tam.Damage,
 		Threat:  tam.Threat,
+
+		Healing:     tam.Healing,
+		Overhealing: tam.Overhealing,
 	}
 }
 
@@ -137,6 +163,8 @@ func NewUnitMetrics() UnitMetrics {
 		dps:       NewDistributionMetrics(),
 		threat:    NewDistributionMetrics(),
 		dtps:      NewDistributionMetrics(),
+		hps:       NewDistributionMetrics(),
+		ohps:      NewDistributionMetrics(),
 		actions:   make(map[ActionID]*ActionMetrics),
 		resources: make(map[ResourceKey]*ResourceMetrics),
 	}
@@ -202,11 +230,18 @@ func (unitMetrics *UnitMetrics) addSpell(spell *Spell) {
 		tam.Glances += spellTargetMetrics.Glances
 		tam.Damage += spellTargetMetrics.TotalDamage
 		tam.Threat += spellTargetMetrics.TotalThreat
+		tam.Healing += spellTargetMetrics.TotalHealing
+		tam.Overhealing += spellTargetMetrics.TotalOverhealing
 		unitMetrics.dps.Total += spellTargetMetrics.TotalDamage
 		unitMetrics.threat.Total += spellTargetMetrics.TotalThreat
+		unitMetrics.hps.Total += spellTargetMetrics.TotalHealing
+		unitMetrics.ohps.Total += spellTargetMetrics.TotalOverhealing
 
-		target := spell.Unit.AttackTables[i].Defender
-		target.Metrics.dtps.Total += spellTargetMetrics.TotalDamage
+		// Enemy attack tables have gaps where there are no raid units, e.g. between
+		// the last player and the first pet.
+		if attackTable := spell.Unit.AttackTables[i]; attackTable != nil {
+			attackTable.Defender.Metrics.dtps.Total += spellTargetMetrics.TotalDamage
+		}
 	}
 }
 
@@ -222,10 +257,21 @@ func (unitMetrics *UnitMetrics) MarkOOM(unit *Unit, dur time.Duration) {
 	unitMetrics.CharacterIterationMetrics.WentOOM = true
 }
 
+func (unitMetrics *UnitMetrics) MarkMovement(dur time.Duration) {
+	unitMetrics.CharacterIterationMetrics.MovementTime += dur
+}
+
+func (unitMetrics *UnitMetrics) MarkDeath(timeOfDeath time.Duration) {
+	unitMetrics.CharacterIterationMetrics.Died = true
+	unitMetrics.CharacterIterationMetrics.TimeOfDeath = timeOfDeath
+}
+
 func (unitMetrics *UnitMetrics) reset() {
 	unitMetrics.dps.reset()
 	unitMetrics.threat.reset()
 	unitMetrics.dtps.reset()
+	unitMetrics.hps.reset()
+	unitMetrics.ohps.reset()
 	unitMetrics.CharacterIterationMetrics = CharacterIterationMetrics{}
 }
 
@@ -234,7 +280,15 @@ func (unitMetrics *UnitMetrics) doneIteration(encounterDurationSeconds float64)
 	unitMetrics.dps.doneIteration(encounterDurationSeconds)
 	unitMetrics.threat.doneIteration(encounterDurationSeconds)
 	unitMetrics.dtps.doneIteration(encounterDurationSeconds)
+	unitMetrics.hps.doneIteration(encounterDurationSeconds)
+	unitMetrics.ohps.doneIteration(encounterDurationSeconds)
 	unitMetrics.oomTimeSum += float64(unitMetrics.OOMTime.Seconds())
+	unitMetrics.movementTimeSum += unitMetrics.MovementTime.Seconds()
+	unitMetrics.minHealthSum += unitMetrics.MinHealth
+	if unitMetrics.Died {
+		unitMetrics.deathCount++
+		unitMetrics.timeOfDeathSum += unitMetrics.TimeOfDeath.Seconds()
+	}
 }
 
 func (unitMetrics *UnitMetrics) ToProto(numIterations int32) *proto.UnitMetrics {
@@ -242,7 +296,17 @@ func (unitMetrics *UnitMetrics) ToProto(numIterations int32) *proto.UnitMetrics
 		Dps:           unitMetrics.dps.ToProto(numIterations),
 		Threat:        unitMetrics.threat.ToProto(numIterations),
 		Dtps:          unitMetrics.dtps.ToProto(numIterations),
+		Hps:           unitMetrics.hps.ToProto(numIterations),
+		Ohps:          unitMetrics.ohps.ToProto(numIterations),
 		SecondsOomAvg: unitMetrics.oomTimeSum / float64(numIterations),
+
+		SecondsLostToMovementAvg: unitMetrics.movementTimeSum / float64(numIterations),
+
+		ChanceOfDeath: float64(unitMetrics.deathCount) / float64(numIterations),
+		MinHealthAvg:  unitMetrics.minHealthSum / float64(numIterations),
+	}
+	if unitMetrics.deathCount > 0 {
+		protoMetrics.SecondsToDeathAvg = unitMetrics.timeOfDeathSum / float64(unitMetrics.deathCount)
 	}
 
 	for actionID, action := range unitMetrics.actions {
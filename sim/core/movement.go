@@ -0,0 +1,210 @@
+package core
+
+import (
+	"sort"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// A window during which the raid has to move, e.g. to dodge a boss ability.
+// Casts and channels in progress are cancelled and auto attacks stop. Casts
+// and channels can't be started while moving, but instant casts can be used.
+type MovementWindow struct {
+	Start    time.Duration
+	Duration time.Duration
+}
+
+func (window MovementWindow) End() time.Duration {
+	return window.Start + window.Duration
+}
+
+func newMovementWindows(configs []*proto.MovementWindow) []MovementWindow {
+	windows := make([]MovementWindow, 0, len(configs))
+	for _, config := range configs {
+		if config.Duration <= 0 {
+			continue
+		}
+		windows = append(windows, MovementWindow{
+			Start:    DurationFromSeconds(config.Start),
+			Duration: DurationFromSeconds(config.Duration),
+		})
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start < windows[j].Start
+	})
+	return windows
+}
+
+// Returns true while the raid is moving.
+func (sim *Simulation) IsMoving() bool {
+	return sim.CurrentTime < sim.movingUntil
+}
+
+// Returns the time at which the next movement window begins, or the current
+// time if the raid is already moving. Returns NeverExpires if there is no more
+// movement in this iteration.
+func (sim *Simulation) NextMovementAt() time.Duration {
+	if sim.IsMoving() {
+		return sim.CurrentTime
+	}
+	for _, window := range sim.Encounter.MovementWindows {
+		if window.Start >= sim.CurrentTime {
+			return window.Start
+		}
+	}
+	return NeverExpires
+}
+
+// Returns true if the raid is moving now or will start moving within the given
+// duration. Rotations can use this to pick instant casts instead of casts which
+// would be interrupted.
+func (sim *Simulation) WillBeMovingWithin(dur time.Duration) bool {
+	return sim.NextMovementAt() < sim.CurrentTime+dur
+}
+
+func (sim *Simulation) resetMovement() {
+	sim.movingUntil = 0
+	for _, unit := range sim.Raid.AllUnits {
+		unit.movementLossUntil = 0
+		unit.blockedByMovement = false
+		unit.channel = channel{}
+	}
+
+	for _, window := range sim.Encounter.MovementWindows {
+		// Copy for the closure.
+		window := window
+		sim.AddPendingAction(&PendingAction{
+			NextActionAt: window.Start,
+			Priority:     ActionPriorityAuto,
+			OnAction: func(sim *Simulation) {
+				sim.startMovement(window.End())
+			},
+		})
+	}
+}
+
+func (sim *Simulation) startMovement(endsAt time.Duration) {
+	if sim.Log != nil {
+		sim.Log("Movement for %s", endsAt-sim.CurrentTime)
+	}
+	sim.movingUntil = MaxDuration(sim.movingUntil, endsAt)
+
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			player.GetCharacter().startMovement(sim, sim.movingUntil)
+		}
+	}
+}
+
+func (unit *Unit) startMovement(sim *Simulation, endsAt time.Duration) {
+	if unit.Hardcast.Expires > sim.CurrentTime && unit.Hardcast.spell != nil {
+		if sim.Log != nil {
+			unit.Log(sim, "Cast cancelled by movement")
+		}
+		unit.addMovementLoss(unit.Hardcast.startedAt, sim.CurrentTime)
+		gcdReadyAt := MaxDuration(sim.CurrentTime, unit.Hardcast.gcdReadyAt)
+		unit.Hardcast = Hardcast{}
+		if unit.hardcastAction != nil {
+			unit.hardcastAction.Cancel(sim)
+			unit.hardcastAction = nil
+		}
+		// Auto Shot's windup is a hardcast, the shot is fired after moving.
+		unit.AutoAttacks.RangedSwingInProgress = false
+		unit.SetGCDTimer(sim, gcdReadyAt)
+	}
+
+	if unit.channel.endsAt > sim.CurrentTime {
+		if sim.Log != nil {
+			unit.Log(sim, "Channel cancelled by movement")
+		}
+		unit.addMovementLoss(sim.CurrentTime, unit.channel.endsAt)
+		if unit.channel.dot != nil {
+			unit.channel.dot.Cancel(sim)
+		}
+		if unit.channel.action != nil {
+			unit.channel.action.Cancel(sim)
+		}
+		gcdReadyAt := MaxDuration(sim.CurrentTime, unit.channel.gcdReadyAt)
+		unit.channel = channel{}
+		unit.SetGCDTimer(sim, gcdReadyAt)
+	}
+
+	if unit.AutoAttacks.IsEnabled() && (unit.AutoAttacks.AutoSwingMelee || unit.AutoAttacks.RangedAuto != nil) {
+		unit.addMovementLoss(sim.CurrentTime, endsAt)
+		unit.AutoAttacks.DelayAllUntil(sim, endsAt)
+	}
+}
+
+// Casts and channels can't be started while moving. Returns true if the spell
+// is blocked, in which case the cast fails without spending resources, so the
+// rotation can choose an instant cast instead.
+func (unit *Unit) castBlockedByMovement(sim *Simulation, spell *Spell) bool {
+	if unit.Type != PlayerUnit || !sim.IsMoving() || (spell.CurCast.CastTime == 0 && spell.CurCast.ChannelTime == 0) {
+		return false
+	}
+
+	if sim.Log != nil && !spell.SpellExtras.Matches(SpellExtrasNoLogs) {
+		unit.Log(sim, "Can't cast %s while moving", spell.ActionID)
+	}
+	unit.blockedByMovement = true
+	return true
+}
+
+// Called after the rotation had a cast blocked by movement. If it didn't cast
+// anything else, waits for movement to end instead of leaving the GCD idle.
+// Returns true if the unit is now waiting for movement.
+func (unit *Unit) waitIfBlockedByMovement(sim *Simulation) bool {
+	if !unit.blockedByMovement {
+		return false
+	}
+	unit.blockedByMovement = false
+
+	if !sim.IsMoving() || !unit.GCD.IsReady(sim) {
+		return false
+	}
+
+	if sim.Log != nil {
+		unit.Log(sim, "Waiting %s for movement to end", sim.movingUntil-sim.CurrentTime)
+	}
+	unit.addMovementLoss(sim.CurrentTime, sim.movingUntil)
+	unit.SetGCDTimer(sim, sim.movingUntil)
+	return true
+}
+
+// A channeled spell in progress.
+type channel struct {
+	// The channel's dot, if the spell landed.
+	dot *Dot
+	// The channel's ticks, for channels which aren't dots.
+	action *PendingAction
+
+	endsAt     time.Duration
+	gcdReadyAt time.Duration
+}
+
+func (unit *Unit) startChannel(endsAt time.Duration, gcdReadyAt time.Duration) {
+	unit.channel = channel{
+		endsAt:     endsAt,
+		gcdReadyAt: gcdReadyAt,
+	}
+}
+
+// Channels which aren't dots, e.g. Evocation, register their ticks here so
+// they can be cancelled by movement.
+func (unit *Unit) SetChannelAction(sim *Simulation, action *PendingAction) {
+	if unit.channel.endsAt > sim.CurrentTime {
+		unit.channel.action = action
+	}
+}
+
+// Adds the time between start and end to the time lost to movement, ignoring
+// any overlap with time which has already been counted.
+func (unit *Unit) addMovementLoss(start time.Duration, end time.Duration) {
+	start = MaxDuration(start, unit.movementLossUntil)
+	if end <= start {
+		return
+	}
+	unit.Metrics.MarkMovement(end - start)
+	unit.movementLossUntil = end
+}
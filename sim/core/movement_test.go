@@ -0,0 +1,106 @@
+package core_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestMovementWindows(t *testing.T) {
+	encounter := &proto.Encounter{
+		Duration: 300,
+		Targets: []*proto.Target{
+			testTarget,
+		},
+		MovementWindows: []*proto.MovementWindow{
+			{Start: 30, Duration: 5},
+			{Start: 120.5, Duration: 3},
+			{Start: 200, Duration: 10},
+		},
+	}
+
+	result := runTestSim(testRaid, encounter, nil)
+	for _, party := range result.RaidMetrics.Parties {
+		for _, player := range party.Players {
+			if player.SecondsLostToMovementAvg == 0 {
+				t.Fatalf("Expected %s to lose time to movement", player.Name)
+			}
+		}
+	}
+}
+
+// Returns the log lines of a single iteration of player from within a
+// movement window.
+func movementWindowLogs(player *proto.Player, window *proto.MovementWindow) []string {
+	encounter := &proto.Encounter{
+		Duration: 60,
+		Targets: []*proto.Target{
+			testTarget,
+		},
+		MovementWindows: []*proto.MovementWindow{window},
+	}
+	result := runTestSim(singlePlayerRaid(player), encounter, &proto.SimOptions{
+		Iterations: 1,
+		IsTest:     true,
+		Debug:      true,
+	})
+
+	var lines []string
+	for _, line := range strings.Split(result.Logs, "\n") {
+		end := strings.Index(line, "]")
+		if !strings.HasPrefix(line, "[") || end == -1 {
+			continue
+		}
+		at, err := strconv.ParseFloat(line[1:end], 64)
+		if err == nil && at >= window.Start && at < window.Start+window.Duration {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestMovementCancelsChannels(t *testing.T) {
+	lines := movementWindowLogs(testShadowPriest, &proto.MovementWindow{Start: 20, Duration: 6})
+
+	cancelled := false
+	for _, line := range lines {
+		if strings.Contains(line, "Channel cancelled by movement") {
+			cancelled = true
+		}
+		if strings.Contains(line, "Casting {SpellID: 25387") {
+			t.Fatalf("Expected Mind Flay not to be channeled while moving: %s", line)
+		}
+	}
+	if !cancelled {
+		t.Fatalf("Expected Mind Flay to be cancelled by movement")
+	}
+}
+
+func TestMovementBlocksCasts(t *testing.T) {
+	lines := movementWindowLogs(testElementalShaman, &proto.MovementWindow{Start: 20, Duration: 6})
+
+	blocked := false
+	for _, line := range lines {
+		if strings.Contains(line, "while moving") {
+			blocked = true
+		}
+		if strings.Contains(line, "Casting") && !strings.Contains(line, "Cast Time = 0s") {
+			t.Fatalf("Expected only instant casts while moving: %s", line)
+		}
+	}
+	if !blocked {
+		t.Fatalf("Expected a cast to be blocked by movement")
+	}
+}
+
+func TestMovementPausesRangedAutos(t *testing.T) {
+	lines := movementWindowLogs(testHunter, &proto.MovementWindow{Start: 8.5, Duration: 5})
+
+	for _, line := range lines {
+		if strings.Contains(line, "[P1 BM Hunter (#1)] Casting {OtherID: 4}") {
+			t.Fatalf("Expected no Auto Shots while moving: %s", line)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Random numbers for SimOptions.paired_random.
+//
+// Each RandomFloat() callsite gets its own stream, reseeded at the start of
+// every iteration from the sim seed, the iteration index and the callsite
+// label. So as long as two setups make similar calls, iteration i of one sim
+// sees the same random numbers as iteration i of the other, e.g. the same
+// fight duration and mostly the same crits and procs. This is the variance
+// reduction technique known as common random numbers.
+type pairedRandom struct {
+	seed uint64
+
+	// Added to iteration indices, so that concurrent workers seed iterations
+	// the same way as a single Simulation would.
+	iterationOffset int32
+
+	iteration     int32
+	iterationSeed uint64
+
+	streams map[string]*pairedStream
+}
+
+type pairedStream struct {
+	rand      SplitMix64
+	labelHash uint64
+
+	// Iteration in which this stream was last seeded.
+	iteration int32
+}
+
+func newPairedRandom(seed int64) *pairedRandom {
+	return &pairedRandom{
+		seed:      uint64(seed),
+		iteration: -1,
+		streams:   make(map[string]*pairedStream),
+	}
+}
+
+func (pr *pairedRandom) startIteration(iteration int32) {
+	pr.iteration = pr.iterationOffset + iteration
+	pr.iterationSeed = NewSplitMix(pr.seed ^ (uint64(pr.iteration) * 0x9e3779b97f4a7c15)).Next()
+}
+
+func (pr *pairedRandom) nextFloat64(label string) float64 {
+	stream, ok := pr.streams[label]
+	if !ok {
+		stream = &pairedStream{
+			labelHash: uint64(hash(label)),
+			iteration: -1,
+		}
+		pr.streams[label] = stream
+	}
+
+	if stream.iteration != pr.iteration {
+		stream.iteration = pr.iteration
+		stream.rand.state = NewSplitMix(pr.iterationSeed ^ (stream.labelHash << 32) ^ stream.labelHash).Next()
+	}
+	return stream.rand.NextFloat64()
+}
+
+// Returns the distribution of the per-iteration differences b[i] - a[i], over
+// the iterations which both sims ran, and the number of those iterations.
+//
+// Iterations are paired by index, because that's what they are seeded by.
+// Iterations which a sim skipped are NaN, see mergeIterationDps().
+func pairedDifference(a []float64, b []float64) (DistributionMetrics, int32) {
+	diffs := NewDistributionMetrics()
+	n := int32(0)
+	for i := 0; i < MinInt(len(a), len(b)); i++ {
+		if math.IsNaN(a[i]) || math.IsNaN(b[i]) {
+			continue
+		}
+		diffs.Total = b[i] - a[i]
+		diffs.doneIteration(1)
+		n++
+	}
+	return diffs, n
+}
+
+// Sims both requests with the same paired random numbers, and returns the
+// difference in raid DPS with its confidence interval.
+func runRaidSimCompare(ctx context.Context, request *proto.RaidSimCompareRequest) *proto.RaidSimCompareResult {
+	simOptions := *request.Baseline.SimOptions
+	simOptions.PairedRandom = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	baselineRequest := *request.Baseline
+	baselineRequest.SimOptions = &simOptions
+	comparisonRequest := *request.Comparison
+	comparisonRequest.SimOptions = &simOptions
+
+	baselineResult, baselineDps := runSim(ctx, baselineRequest, nil, true, nil)
+	comparisonResult, comparisonDps := runSim(ctx, comparisonRequest, nil, true, nil)
+
+	diffs, n := pairedDifference(baselineDps, comparisonDps)
+	return &proto.RaidSimCompareResult{
+		Baseline:          baselineResult,
+		Comparison:        comparisonResult,
+		DpsDelta:          diffs.ToProto(n),
+		DpsDeltaHalfWidth: diffs.confidenceHalfWidth(n, zScore(request.Confidence)),
+	}
+}
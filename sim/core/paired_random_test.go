@@ -0,0 +1,117 @@
+package core_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestRaidSimCompare(t *testing.T) {
+	baseline := &proto.RaidSimRequest{
+		Raid:      singlePlayerRaid(testElementalShaman),
+		Encounter: testEncounter,
+		// Not a test sim, which would ignore the paired random numbers.
+		SimOptions: &proto.SimOptions{
+			Iterations: 50,
+			RandomSeed: 1,
+		},
+	}
+
+	// Identical setups see identical random numbers, so there's no noise at all.
+	result := core.RunRaidSimCompare(&proto.RaidSimCompareRequest{
+		Baseline:   baseline,
+		Comparison: baseline,
+	})
+	if result.DpsDelta.Avg != 0 || result.DpsDeltaHalfWidth != 0 {
+		t.Fatalf("Expected no difference between identical setups, but got %0.3f +/- %0.3f", result.DpsDelta.Avg, result.DpsDeltaHalfWidth)
+	}
+
+	player := googleProto.Clone(testElementalShaman).(*proto.Player)
+	player.BonusStats = make([]float64, stats.Len)
+	player.BonusStats[stats.SpellPower] = 100
+	comparison := googleProto.Clone(baseline).(*proto.RaidSimRequest)
+	comparison.Raid = singlePlayerRaid(player)
+
+	result = core.RunRaidSimCompare(&proto.RaidSimCompareRequest{
+		Baseline:   baseline,
+		Comparison: comparison,
+	})
+	if result.DpsDelta.Avg-result.DpsDeltaHalfWidth <= 0 {
+		t.Fatalf("Expected spell power to increase dps, but got %0.3f +/- %0.3f", result.DpsDelta.Avg, result.DpsDeltaHalfWidth)
+	}
+
+	// The paired delta should be much less noisy than either sim on its own.
+	if result.DpsDelta.Stdev >= result.Baseline.RaidMetrics.Dps.Stdev/2 {
+		t.Fatalf("Expected paired stdev %0.3f to be much lower than %0.3f", result.DpsDelta.Stdev, result.Baseline.RaidMetrics.Dps.Stdev)
+	}
+}
+
+func TestPairedRandomConcurrent(t *testing.T) {
+	rsr := proto.RaidSimRequest{
+		Raid:      singlePlayerRaid(testElementalShaman),
+		Encounter: testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations:   1000,
+			RandomSeed:   1,
+			PairedRandom: true,
+		},
+	}
+
+	serial, _ := core.RunSimConcurrent(context.Background(), rsr, nil, 1, false, nil)
+	concurrent, _ := core.RunSimConcurrent(context.Background(), rsr, nil, 4, false, nil)
+	if math.Abs(serial.RaidMetrics.Dps.Avg-concurrent.RaidMetrics.Dps.Avg) > 0.001 {
+		t.Fatalf("Expected the same results from serial and concurrent sims, but got %0.3f and %0.3f", serial.RaidMetrics.Dps.Avg, concurrent.RaidMetrics.Dps.Avg)
+	}
+}
+
+func TestPairedRandomIgnoredInTests(t *testing.T) {
+	runSim := func(pairedRandom bool) *proto.RaidSimResult {
+		return runTestSim(singlePlayerRaid(testElementalShaman), testEncounter, &proto.SimOptions{
+			Iterations:   50,
+			RandomSeed:   1,
+			IsTest:       true,
+			PairedRandom: pairedRandom,
+		})
+	}
+
+	// Test results, e.g. stat weight goldens, shouldn't change when sims are paired.
+	if unpaired, paired := runSim(false), runSim(true); unpaired.RaidMetrics.Dps.Avg != paired.RaidMetrics.Dps.Avg {
+		t.Fatalf("Expected the same results with and without pairing, but got %0.3f and %0.3f", unpaired.RaidMetrics.Dps.Avg, paired.RaidMetrics.Dps.Avg)
+	}
+}
+
+func TestPairedRandomConcurrentPrecision(t *testing.T) {
+	rsr := proto.RaidSimRequest{
+		Raid:      singlePlayerRaid(testElementalShaman),
+		Encounter: testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations:   400,
+			RandomSeed:   1,
+			PairedRandom: true,
+			// Never reached, so every worker runs to its cap.
+			Precision: &proto.PrecisionTarget{DpsHalfWidth: 0.001, MaxIterations: 800},
+		},
+	}
+
+	_, serialDps := core.RunSimConcurrent(context.Background(), rsr, nil, 1, true, nil)
+	result, concurrentDps := core.RunSimConcurrent(context.Background(), rsr, nil, 4, true, nil)
+	if result.Iterations != 800 {
+		t.Fatalf("Expected 800 iterations, but got %d", result.Iterations)
+	}
+
+	// Workers run more iterations than they were assigned, but each iteration
+	// should still run exactly once and be recorded at its own index.
+	if len(concurrentDps) != len(serialDps) {
+		t.Fatalf("Expected %d recorded iterations, but got %d", len(serialDps), len(concurrentDps))
+	}
+	for i := range serialDps {
+		if concurrentDps[i] != serialDps[i] {
+			t.Fatalf("Expected iteration %d to have dps %0.3f, but got %0.3f", i, serialDps[i], concurrentDps[i])
+		}
+	}
+}
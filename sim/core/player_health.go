@@ -0,0 +1,106 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// How often the encounter's modeled healing is applied to players.
+const modeledHealingInterval = time.Second
+
+func (sim *Simulation) resetPlayerHealth() {
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			character.Metrics.MinHealth = character.GetStat(stats.Health)
+		}
+	}
+
+	if sim.Encounter.HealingPerSecond <= 0 {
+		return
+	}
+
+	healingPerTick := sim.Encounter.HealingPerSecond * modeledHealingInterval.Seconds()
+	pa := &PendingAction{
+		NextActionAt: modeledHealingInterval,
+		Priority:     ActionPriorityRegen,
+	}
+	pa.OnAction = func(sim *Simulation) {
+		for _, party := range sim.Raid.Parties {
+			for _, player := range party.Players {
+				if character := player.GetCharacter(); !character.IsDead() {
+					character.gainHealth(healingPerTick)
+				}
+			}
+		}
+		pa.NextActionAt = sim.CurrentTime + modeledHealingInterval
+		sim.AddPendingAction(pa)
+	}
+	sim.AddPendingAction(pa)
+}
+
+// Returns this unit's current health, which may be negative for dead units.
+func (unit *Unit) CurrentHealth() float64 {
+	return unit.GetStat(stats.Health) - unit.damageTaken
+}
+
+// Restores up to amount health, returning the amount actually restored.
+func (unit *Unit) gainHealth(amount float64) float64 {
+	if unit.IsDead() {
+		return 0
+	}
+	effectiveHealing := MinFloat(amount, unit.MissingHealth())
+	unit.damageTaken = MaxFloat(0, MinFloat(unit.damageTaken, unit.GetStat(stats.Health))-effectiveHealing)
+	return effectiveHealing
+}
+
+// Called whenever a player takes damage, to track survival metrics.
+func (unit *Unit) updateHealth(sim *Simulation) {
+	if unit.Type != PlayerUnit || unit.GetStat(stats.Health) <= 0 {
+		return
+	}
+
+	health := unit.CurrentHealth()
+	unit.Metrics.MinHealth = MinFloat(unit.Metrics.MinHealth, MaxFloat(0, health))
+	if health <= 0 && !unit.Metrics.Died {
+		unit.Metrics.MarkDeath(sim.CurrentTime)
+		if sim.Log != nil {
+			unit.Log(sim, "Died")
+		}
+		if !sim.Encounter.StopOnDeath {
+			return
+		}
+
+		unit.dead = true
+		for _, party := range sim.Raid.Parties {
+			for _, player := range party.Players {
+				if character := player.GetCharacter(); &character.Unit == unit {
+					character.stopActions(sim)
+				}
+			}
+		}
+	}
+}
+
+// Returns true once this unit has died in the current iteration of an
+// encounter with StopOnDeath. Dead players don't act and can't be healed for
+// the rest of the iteration.
+func (unit *Unit) IsDead() bool {
+	return unit.dead
+}
+
+// Stops everything a character and its pets are doing, once it dies.
+func (character *Character) stopActions(sim *Simulation) {
+	character.CancelGCDTimer(sim)
+	character.AutoAttacks.CancelAutoSwing(sim)
+	if character.hardcastAction != nil {
+		character.hardcastAction.Cancel(sim)
+		character.hardcastAction = nil
+	}
+	character.Hardcast = Hardcast{}
+
+	for _, petAgent := range character.Pets {
+		petAgent.GetPet().Disable(sim)
+	}
+}
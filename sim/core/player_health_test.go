@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestPlayerDeath(t *testing.T) {
+	runSim := func(healingPerSecond float64) *proto.UnitMetrics {
+		raid := singlePlayerRaid(testElementalShaman)
+		raid.Tanks = append(raid.Tanks, &proto.RaidTarget{TargetIndex: 0})
+
+		encounter := &proto.Encounter{
+			Duration:         60,
+			Targets:          []*proto.Target{core.NewDefaultTarget()},
+			HealingPerSecond: healingPerSecond,
+		}
+		return runTestSim(raid, encounter, nil).RaidMetrics.Parties[0].Players[0]
+	}
+
+	player := runSim(0)
+	if player.ChanceOfDeath != 1 || player.SecondsToDeathAvg <= 0 || player.MinHealthAvg != 0 {
+		t.Fatalf("Expected an unhealed player to die, but got chance %0.2f at %0.2fs", player.ChanceOfDeath, player.SecondsToDeathAvg)
+	}
+
+	player = runSim(100000)
+	if player.ChanceOfDeath != 0 || player.MinHealthAvg <= 0 {
+		t.Fatalf("Expected a healed player to survive, but got chance %0.2f", player.ChanceOfDeath)
+	}
+}
+
+func TestDeadPlayersStopActing(t *testing.T) {
+	// Returns the time of death and whether the player acted after it.
+	runSim := func(stopOnDeath bool) (float64, bool) {
+		raid := singlePlayerRaid(testElementalShaman)
+		raid.Tanks = append(raid.Tanks, &proto.RaidTarget{TargetIndex: 0})
+		encounter := &proto.Encounter{
+			Duration:    60,
+			Targets:     []*proto.Target{core.NewDefaultTarget()},
+			StopOnDeath: stopOnDeath,
+		}
+
+		result := runTestSim(raid, encounter, &proto.SimOptions{
+			Iterations: 1,
+			IsTest:     true,
+			Timeline:   &proto.TimelineOptions{},
+		})
+		timeOfDeath := result.RaidMetrics.Parties[0].Players[0].SecondsToDeathAvg
+		if timeOfDeath <= 0 {
+			t.Fatalf("Expected the player to die")
+		}
+
+		for _, event := range result.Timelines[0].Events {
+			if event.Unit.Type != proto.UnitReference_Player || event.Timestamp <= timeOfDeath {
+				continue
+			}
+			if event.Type == proto.TimelineEventType_TimelineEventCastStart || event.Type == proto.TimelineEventType_TimelineEventDamage {
+				return timeOfDeath, true
+			}
+		}
+		return timeOfDeath, false
+	}
+
+	if timeOfDeath, acted := runSim(true); acted {
+		t.Fatalf("Expected no actions after death at %0.2fs with stop_on_death", timeOfDeath)
+	}
+	if timeOfDeath, acted := runSim(false); !acted {
+		t.Fatalf("Expected actions after death at %0.2fs without stop_on_death", timeOfDeath)
+	}
+}
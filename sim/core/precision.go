@@ -0,0 +1,75 @@
+package core
+
+import (
+	"math"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// How often to check whether a precision target has been reached. Checking
+// is cheap, but this keeps results from depending on tiny fluctuations.
+const precisionCheckInterval = 100
+
+const defaultConfidence = 0.95
+const defaultPrecisionMaxIterations = 100000
+
+// Returns the maximum number of iterations a sim with these options can run.
+func maxSimIterations(options proto.SimOptions) int32 {
+	if options.Precision == nil {
+		return options.Iterations
+	}
+
+	maxIterations := options.Precision.MaxIterations
+	if maxIterations == 0 {
+		maxIterations = defaultPrecisionMaxIterations
+	}
+	return MaxInt32(maxIterations, options.Iterations)
+}
+
+// Returns true if the raid DPS after numIterations is precise enough to stop.
+//
+// With a precision baseline, it is the difference from the baseline which
+// needs to be precise instead, e.g. for stat weights. Paired random numbers
+// make that difference much less noisy than the raid DPS itself, so this
+// usually stops a lot sooner.
+func (sim *Simulation) reachedPrecision(numIterations int32) bool {
+	precision := sim.Options.Precision
+	z := zScore(precision.Confidence)
+
+	var halfWidth float64
+	if sim.precisionBaselineDps != nil {
+		diffs, n := pairedDifference(sim.precisionBaselineDps, sim.iterationDps)
+		halfWidth = diffs.confidenceHalfWidth(n, z)
+	} else {
+		halfWidth = sim.Raid.dpsMetrics.confidenceHalfWidth(numIterations, z)
+	}
+	return halfWidth <= precision.DpsHalfWidth
+}
+
+// Returns the z-score for a two-sided confidence interval at the given
+// confidence level, which defaults to 95%.
+func zScore(confidence float64) float64 {
+	if confidence == 0 {
+		confidence = defaultConfidence
+	}
+	return math.Sqrt2 * math.Erfinv(confidence)
+}
+
+// Returns a copy of the precision target for a sim which only runs a
+// 1/numSims share of the iterations, e.g. a concurrent worker. Results from
+// all the sims together then have roughly the original precision.
+func splitPrecisionTarget(precision *proto.PrecisionTarget, numSims int) *proto.PrecisionTarget {
+	if precision == nil {
+		return nil
+	}
+
+	maxIterations := precision.MaxIterations
+	if maxIterations == 0 {
+		maxIterations = defaultPrecisionMaxIterations
+	}
+	return &proto.PrecisionTarget{
+		DpsHalfWidth:  precision.DpsHalfWidth * math.Sqrt(float64(numSims)),
+		Confidence:    precision.Confidence,
+		MaxIterations: MaxInt32(maxIterations/int32(numSims), 1),
+	}
+}
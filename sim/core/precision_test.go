@@ -0,0 +1,66 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func TestPrecisionTarget(t *testing.T) {
+	runSim := func(precision *proto.PrecisionTarget) *proto.RaidSimResult {
+		return runTestSim(singlePlayerRaid(testElementalShaman), testEncounter, &proto.SimOptions{
+			Iterations: 100,
+			IsTest:     true,
+			Precision:  precision,
+		})
+	}
+
+	if result := runSim(nil); result.Iterations != 100 {
+		t.Fatalf("Expected 100 iterations without a precision target, but got %d", result.Iterations)
+	}
+
+	// Already precise enough after the minimum number of iterations.
+	if result := runSim(&proto.PrecisionTarget{DpsHalfWidth: 1000}); result.Iterations != 100 {
+		t.Fatalf("Expected to stop after 100 iterations, but got %d", result.Iterations)
+	}
+
+	result := runSim(&proto.PrecisionTarget{DpsHalfWidth: 0.001, MaxIterations: 300})
+	if result.Iterations != 300 {
+		t.Fatalf("Expected to stop at the 300 iteration cap, but got %d", result.Iterations)
+	}
+	if hist := result.RaidMetrics.Dps.Hist; sumHist(hist) != 300 {
+		t.Fatalf("Expected metrics from 300 iterations, but got %d", sumHist(hist))
+	}
+}
+
+func sumHist(hist map[int32]int32) int32 {
+	sum := int32(0)
+	for _, count := range hist {
+		sum += count
+	}
+	return sum
+}
+
+func TestStatWeightsPrecisionTarget(t *testing.T) {
+	result := core.CalcStatWeight(context.Background(), proto.StatWeightsRequest{
+		Player:     testElementalShaman,
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations: 200,
+			RandomSeed: 1,
+			Precision:  &proto.PrecisionTarget{DpsHalfWidth: 0.1, MaxIterations: 4000},
+		},
+	}, []stats.Stat{stats.SpellPower}, stats.SpellPower, nil)
+
+	// The raid DPS itself is nowhere near this precise, but the paired difference
+	// from the baseline is, so stat sims should stop well before the cap.
+	if iterations := result.Iterations[stats.SpellPower]; iterations <= 200 || iterations >= 4000 {
+		t.Fatalf("Expected stat sims to stop between the minimum and the cap, but used %d iterations", iterations)
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"time"
 
 	"github.com/wowsims/tbc/sim/core/proto"
@@ -31,7 +32,13 @@ type PresimOptions struct {
 	OnPresimResult func(presimResult proto.UnitMetrics, iterations int32, duration time.Duration) bool
 }
 
-func (sim *Simulation) runPresims(request proto.RaidSimRequest) {
+// Agents can't accept a partial result: they need presim results to finish
+// setting up, e.g. the adaptive balance druid rotation picks its rotation from
+// them. So presim rounds still happen after ctx is cancelled, but each one stops
+// after 1 iteration. All agents share each round, so a cancelled sim costs 1
+// iteration per round (at most a few rounds) no matter how many players use
+// presims.
+func (sim *Simulation) runPresims(ctx context.Context, request proto.RaidSimRequest) {
 	const numPresimIterations = 100
 
 	// Run presims if requested.
@@ -84,7 +91,7 @@ func (sim *Simulation) runPresims(request proto.RaidSimRequest) {
 		}
 
 		// Run the presim.
-		presimResult := RunSim(*presimRequest, nil)
+		presimResult := RunSim(ctx, *presimRequest, nil)
 
 		// Provide each Agent with their own results.
 		for partyIdx, party := range sim.Raid.Parties {
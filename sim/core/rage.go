@@ -99,6 +99,9 @@ func (rb *rageBar) AddRage(sim *Simulation, amount float64, actionID ActionID) {
 	if sim.Log != nil {
 		rb.unit.Log(sim, "Gained %0.3f rage from %s (%0.3f --> %0.3f).", amount, actionID, rb.currentRage, newRage)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(rb.unit, actionID, proto.ResourceType_ResourceTypeRage, rb.currentRage, newRage)
+	}
 
 	rb.currentRage = newRage
 	rb.onRageGain(sim)
@@ -115,6 +118,9 @@ func (rb *rageBar) SpendRage(sim *Simulation, amount float64, actionID ActionID)
 	if sim.Log != nil {
 		rb.unit.Log(sim, "Spent %0.3f rage from %s (%0.3f --> %0.3f).", amount, actionID, rb.currentRage, newRage)
 	}
+	if sim.RecordingTimeline() {
+		sim.addResourceTimelineEvent(rb.unit, actionID, proto.ResourceType_ResourceTypeRage, rb.currentRage, newRage)
+	}
 
 	rb.currentRage = newRage
 }
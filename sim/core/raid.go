@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"sort"
 	"time"
 
@@ -36,6 +37,29 @@ func NewParty(raid *Raid, index int, partyConfig proto.Party) *Party {
 	return party
 }
 
+// Returns an error if the raid can't be simmed, e.g. because a party has more
+// players than it has slots.
+func validateRaid(raidConfig proto.Raid) error {
+	for partyIndex, partyConfig := range raidConfig.Parties {
+		if partyConfig == nil {
+			continue
+		}
+		numPlayers := 0
+		for _, playerConfig := range partyConfig.Players {
+			if playerConfig != nil && playerConfig.Class != proto.Class_ClassUnknown {
+				numPlayers++
+				if playerConfig.CastSequence != nil && playerConfig.AplRotation != nil {
+					return fmt.Errorf("%s can't use both a cast sequence and an APL rotation", playerConfig.Name)
+				}
+			}
+		}
+		if numPlayers > 5 {
+			return fmt.Errorf("Party %d has more than 5 players", partyIndex+1)
+		}
+	}
+	return nil
+}
+
 func (party *Party) Size() int {
 	return len(party.Players)
 }
@@ -225,6 +249,27 @@ func (raid *Raid) updatePlayersAndPets() {
 	})
 }
 
+// Adds the individual buffs which players in the raid give to the recipient.
+func (raid *Raid) addIndividualBuffs(recipient Agent, individualBuffs *proto.IndividualBuffs) {
+	for _, party := range raid.Parties {
+		for _, player := range party.Players {
+			if provider, ok := player.(IndividualBuffsProvider); ok {
+				provider.AddIndividualBuffs(recipient, individualBuffs)
+			}
+		}
+	}
+}
+
+// Clears the individual buffs which players in this party provide themselves,
+// e.g. VT mana from a real Shadow Priest.
+func (party *Party) clearPartyBuffApproximations(individualBuffs *proto.IndividualBuffs) {
+	for _, player := range party.Players {
+		if provider, ok := player.(PartyBuffApproximationProvider); ok {
+			provider.ClearPartyBuffApproximations(individualBuffs)
+		}
+	}
+}
+
 func (raid *Raid) applyCharacterEffects(raidConfig proto.Raid) {
 	raidBuffs := raid.GetRaidBuffs(raidConfig.Buffs)
 
@@ -239,6 +284,8 @@ func (raid *Raid) applyCharacterEffects(raidConfig proto.Raid) {
 			if playerConfig.Buffs != nil {
 				individualBuffs = *playerConfig.Buffs
 			}
+			raid.addIndividualBuffs(player, &individualBuffs)
+			party.clearPartyBuffApproximations(&individualBuffs)
 
 			player.GetCharacter().applyAllEffects(player, raidBuffs, partyBuffs, individualBuffs)
 		}
@@ -323,7 +370,7 @@ func RaidPlayersWithSpec(raid proto.Raid, spec proto.Spec) []*proto.Player {
 	var specPlayers []*proto.Player
 	for _, party := range raid.Parties {
 		for _, player := range party.Players {
-			if player != nil && player.GetSpec() != nil && PlayerProtoToSpec(*player) == spec {
+			if player != nil && player.GetSpec() != nil && PlayerProtoIsSpec(*player, spec) {
 				specPlayers = append(specPlayers, player)
 			}
 		}
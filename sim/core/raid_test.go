@@ -0,0 +1,137 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestBuffBot(t *testing.T) {
+	restoShaman := &proto.Player{
+		Name:  "Resto Shaman",
+		Class: proto.Class_ClassShaman,
+		Spec: &proto.Player_BuffBot{
+			BuffBot: &proto.BuffBotPlayer{
+				PartyBuffs: &proto.PartyBuffs{
+					Bloodlust:       1,
+					WrathOfAirTotem: proto.TristateEffect_TristateEffectRegular,
+					ManaSpringTotem: proto.TristateEffect_TristateEffectRegular,
+				},
+			},
+		},
+	}
+
+	sameParty := runTestSim(&proto.Raid{Parties: []*proto.Party{
+		{Players: []*proto.Player{testElementalShaman, restoShaman}},
+	}}, testEncounter, nil)
+	otherParty := runTestSim(&proto.Raid{Parties: []*proto.Party{
+		{Players: []*proto.Player{testElementalShaman}},
+		{Players: []*proto.Player{restoShaman}},
+	}}, testEncounter, nil)
+
+	eleDps := sameParty.RaidMetrics.Parties[0].Players[0].Dps.Avg
+	if eleDps <= otherParty.RaidMetrics.Parties[0].Players[0].Dps.Avg {
+		t.Fatalf("Expected buff bot to only buff its own party")
+	}
+	if botDps := sameParty.RaidMetrics.Parties[0].Players[1].Dps.Avg; botDps != 0 {
+		t.Fatalf("Expected buff bot to deal no damage, but got %0.2f dps", botDps)
+	}
+}
+
+func TestBuffBotIndividualBuffs(t *testing.T) {
+	holyPaladin := &proto.Player{
+		Name:  "Holy Paladin",
+		Class: proto.Class_ClassPaladin,
+		Spec: &proto.Player_BuffBot{
+			BuffBot: &proto.BuffBotPlayer{
+				IndividualBuffs: &proto.IndividualBuffs{
+					BlessingOfKings:  true,
+					BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+				},
+			},
+		},
+	}
+
+	eleShaman := googleProto.Clone(testElementalShaman).(*proto.Player)
+	eleShaman.Buffs = nil
+
+	withBot := runTestSim(&proto.Raid{Parties: []*proto.Party{
+		{Players: []*proto.Player{eleShaman}},
+		{Players: []*proto.Player{holyPaladin}},
+	}}, testEncounter, nil)
+	withoutBot := runTestSim(&proto.Raid{Parties: []*proto.Party{
+		{Players: []*proto.Player{eleShaman}},
+	}}, testEncounter, nil)
+
+	if withBot.RaidMetrics.Dps.Avg <= withoutBot.RaidMetrics.Dps.Avg {
+		t.Fatalf("Expected blessings from a buff bot in another party to increase dps")
+	}
+}
+
+func TestBuffBotTargetedBuffs(t *testing.T) {
+	const powerInfusionSpellID = 10060
+
+	shadowPriest := &proto.Player{
+		Name:  "Shadow Priest",
+		Class: proto.Class_ClassPriest,
+		Spec: &proto.Player_BuffBot{
+			BuffBot: &proto.BuffBotPlayer{
+				IndividualBuffs: &proto.IndividualBuffs{
+					ShadowPriestDps: 1000,
+					PowerInfusions:  1,
+				},
+				// The Elemental Shaman in the first party.
+				PowerInfusionTarget: &proto.RaidTarget{TargetIndex: 0},
+			},
+		},
+	}
+
+	eleShaman := googleProto.Clone(testElementalShaman).(*proto.Player)
+	eleShaman.Buffs = nil
+
+	sameParty := runTestSim(&proto.Raid{Parties: []*proto.Party{
+		{Players: []*proto.Player{eleShaman, eleShaman, shadowPriest}},
+	}}, testEncounter, nil)
+	otherParty := runTestSim(&proto.Raid{Parties: []*proto.Party{
+		{Players: []*proto.Player{eleShaman, eleShaman}},
+		{Players: []*proto.Player{shadowPriest}},
+	}}, testEncounter, nil)
+
+	piUptime := func(metrics *proto.UnitMetrics) float64 {
+		for _, aura := range metrics.Auras {
+			if aura.Id.GetSpellId() == powerInfusionSpellID {
+				return aura.UptimeSecondsAvg
+			}
+		}
+		return 0
+	}
+	for i, result := range []*proto.RaidSimResult{sameParty, otherParty} {
+		players := result.RaidMetrics.Parties[0].Players
+		if piUptime(players[0]) == 0 {
+			t.Fatalf("Expected the Power Infusion target to receive Power Infusion (raid %d)", i)
+		}
+		if piUptime(players[1]) != 0 {
+			t.Fatalf("Expected only the Power Infusion target to receive Power Infusion (raid %d)", i)
+		}
+	}
+
+	sameOom := sameParty.RaidMetrics.Parties[0].Players[0].SecondsOomAvg
+	otherOom := otherParty.RaidMetrics.Parties[0].Players[0].SecondsOomAvg
+	if sameOom >= otherOom {
+		t.Fatalf("Expected VT mana to only reach the buff bot's party, but got %0.2fs vs %0.2fs oom", sameOom, otherOom)
+	}
+}
+
+func TestOverfullParty(t *testing.T) {
+	result := runTestSim(&proto.Raid{
+		Parties: []*proto.Party{{Players: []*proto.Player{
+			testElementalShaman, testElementalShaman, testElementalShaman,
+			testElementalShaman, testElementalShaman, testElementalShaman,
+		}}},
+	}, testEncounter, nil)
+
+	if result.ErrorResult == "" {
+		t.Fatalf("Expected a party with more than 5 players to be rejected")
+	}
+}
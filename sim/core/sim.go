@@ -1,8 +1,8 @@
 package core
 
 import (
+	"context"
 	"fmt"
-	"runtime"
 	"strings"
 	"time"
 
@@ -16,6 +16,23 @@ type Simulation struct {
 
 	rand Rand
 
+	// Default latency for players, see latency.go.
+	latency Latency
+
+	// Only set if a timeline was requested, see timeline.go.
+	timeline *timeline
+
+	// Only set if paired random numbers were requested, see paired_random.go.
+	pairedRandom *pairedRandom
+
+	// If set, the raid DPS from each iteration is recorded in iterationDps.
+	recordIterationDps bool
+	iterationDps       []float64
+
+	// If set, the precision target applies to the difference in raid DPS from
+	// these baseline iterations, see reachedPrecision().
+	precisionBaselineDps []float64
+
 	// Used for testing only, see RandomFloat().
 	isTest    bool
 	testRands map[string]Rand
@@ -32,17 +49,77 @@ type Simulation struct {
 
 	executePhase          bool
 	executePhaseCallbacks []func(*Simulation)
+
+	// Encounter phase state, see encounter_phase.go.
+	phaseIndex           int32
+	phaseHealthTarget    *Unit
+	nextPhaseAction      *PendingAction
+	downtimeAction       *PendingAction
+	downtimeEndsAt       time.Duration
+	phaseChangeCallbacks []func(*Simulation, int32)
+
+	// End of the current movement window, see movement.go.
+	movingUntil time.Duration
+
+	// Set once all targets are dead in health-based encounters, see encounter_health.go.
+	targetsDead bool
+
+	durationMetrics DistributionMetrics
+}
+
+// Runs a raid sim. If ctx is cancelled before all iterations are complete, the
+// sim stops early and the result only includes the iterations which finished.
+func RunSim(ctx context.Context, rsr proto.RaidSimRequest, progress chan *proto.ProgressMetrics) *proto.RaidSimResult {
+	if err := validateRaidSimRequest(rsr); err != nil {
+		result := &proto.RaidSimResult{ErrorResult: err.Error()}
+		if progress != nil {
+			progress <- &proto.ProgressMetrics{FinalRaidResult: result}
+		}
+		return result
+	}
+
+	result, _ := runSim(ctx, rsr, progress, false, nil)
+	return result
 }
 
-func RunSim(rsr proto.RaidSimRequest, progress chan *proto.ProgressMetrics) *proto.RaidSimResult {
+func validateRaidSimRequest(rsr proto.RaidSimRequest) error {
+	if rsr.Raid == nil {
+		return fmt.Errorf("Missing raid")
+	}
+	if rsr.Encounter == nil {
+		return fmt.Errorf("Missing encounter")
+	}
+	if rsr.SimOptions == nil {
+		return fmt.Errorf("Missing sim options")
+	}
+	if err := validateRaid(*rsr.Raid); err != nil {
+		return err
+	}
+	return validateAPLRotations(rsr)
+}
+
+// Like RunSim, but if recordIterationDps is set also returns the raid DPS from
+// each iteration, in order. Used for paired comparisons between sims.
+//
+// If precisionBaselineDps is set, the precision target applies to the paired
+// difference from those baseline iterations instead of the raid DPS, and the
+// sim never runs more iterations than can be paired.
+func runSim(ctx context.Context, rsr proto.RaidSimRequest, progress chan *proto.ProgressMetrics, recordIterationDps bool, precisionBaselineDps []float64) (*proto.RaidSimResult, []float64) {
+	if numWorkers := numSimWorkers(*rsr.SimOptions); numWorkers > 1 {
+		return runSimConcurrent(ctx, rsr, progress, numWorkers, recordIterationDps, precisionBaselineDps)
+	}
+
 	sim := NewSim(rsr)
-	sim.runPresims(rsr)
+	sim.recordIterationDps = recordIterationDps || precisionBaselineDps != nil
+	sim.precisionBaselineDps = precisionBaselineDps
+	sim.runPresims(ctx, rsr)
 	if progress != nil {
 		sim.ProgressReport = func(progMetric *proto.ProgressMetrics) {
 			progress <- progMetric
 		}
 	}
-	return sim.run()
+	result := sim.run(ctx)
+	return result, sim.iterationDps
 }
 
 func NewSim(rsr proto.RaidSimRequest) *Simulation {
@@ -52,15 +129,23 @@ func NewSim(rsr proto.RaidSimRequest) *Simulation {
 		rseed = time.Now().UnixNano()
 	}
 
-	return &Simulation{
+	sim := &Simulation{
 		Environment: NewEnvironment(*rsr.Raid, *rsr.Encounter),
 		Options:     simOptions,
 
-		rand: NewSplitMix(uint64(rseed)),
+		rand:     NewSplitMix(uint64(rseed)),
+		latency:  NewLatency(simOptions.Latency),
+		timeline: newTimeline(simOptions.Timeline),
 
 		isTest:    simOptions.IsTest,
 		testRands: make(map[string]Rand),
+
+		durationMetrics: NewDistributionMetrics(),
+	}
+	if simOptions.PairedRandom {
+		sim.pairedRandom = newPairedRandom(rseed)
 	}
+	return sim
 }
 
 // Returns a random float.
@@ -68,9 +153,13 @@ func NewSim(rsr proto.RaidSimRequest) *Simulation {
 // In tests, although we can set the initial seed, test results are still very
 // sensitive to the exact order of RandomFloat() calls. To mitigate this, when
 // testing we use a separate rand object for each RandomFloat callsite,
-// distinguished by the label string.
+// distinguished by the label string. These take precedence over paired random
+// numbers, so that test results don't depend on whether a sim is paired.
 func (sim *Simulation) RandomFloat(label string) float64 {
 	if !sim.isTest {
+		if sim.pairedRandom != nil {
+			return sim.pairedRandom.nextFloat64(label)
+		}
 		return sim.rand.NextFloat64()
 	}
 
@@ -106,6 +195,11 @@ func (sim *Simulation) reset() {
 
 	sim.executePhase = false
 	sim.executePhaseCallbacks = []func(*Simulation){}
+	sim.phaseChangeCallbacks = []func(*Simulation, int32){}
+
+	// Sets the initial duration estimate for health-based encounters, so this
+	// needs to happen before units are reset.
+	sim.resetHealth()
 
 	// Targets need to be reset before the raid, so that players can check for
 	// the presence of permanent target auras in their Reset handlers.
@@ -114,13 +208,17 @@ func (sim *Simulation) reset() {
 	}
 
 	sim.Raid.reset(sim)
+	sim.resetPlayerHealth()
+
+	sim.resetPhases()
+	sim.resetMovement()
 
 	sim.initManaTickAction()
 }
 
 // Run runs the simulation for the configured number of iterations, and
 // collects all the metrics together.
-func (sim *Simulation) run() *proto.RaidSimResult {
+func (sim *Simulation) run(ctx context.Context) *proto.RaidSimResult {
 	logsBuffer := &strings.Builder{}
 	if sim.Options.Debug || sim.Options.DebugFirstIteration {
 		sim.Log = func(message string, vals ...interface{}) {
@@ -149,30 +247,61 @@ func (sim *Simulation) run() *proto.RaidSimResult {
 		}
 	}
 
-	sim.runOnce()
+	if sim.timeline != nil {
+		sim.timeline.init(sim)
+	}
+
+	sim.runIteration(0)
 	firstIterationDuration := sim.Duration
 
 	if !sim.Options.Debug {
 		sim.Log = nil
 	}
 
+	// With a precision target, the number of iterations isn't known in advance,
+	// so progress is reported against the maximum.
+	maxIterations := maxSimIterations(sim.Options)
+	if sim.precisionBaselineDps != nil {
+		maxIterations = MaxInt32(sim.Options.Iterations, MinInt32(maxIterations, int32(len(sim.precisionBaselineDps))))
+	}
+
 	st := time.Now()
-	for i := int32(1); i < sim.Options.Iterations; i++ {
+	i := int32(1)
+	for ; i < maxIterations; i++ {
+		if ctx.Err() != nil {
+			// Only report the iterations which actually ran.
+			if sim.Log != nil {
+				sim.Log("Sim cancelled after %d iterations", i)
+			}
+			break
+		}
+
+		if sim.Options.Precision != nil && i >= sim.Options.Iterations && i%precisionCheckInterval == 0 && sim.reachedPrecision(i) {
+			break
+		}
+
 		// fmt.Printf("Iteration: %d\n", i)
 		if sim.ProgressReport != nil && time.Since(st) > time.Millisecond*100 {
 			metrics := sim.Raid.GetMetrics(i + 1)
-			sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: sim.Options.Iterations, CompletedIterations: i + 1, Dps: metrics.Dps.Avg})
-			runtime.Gosched() // ensure that reporting threads are given time to report, mostly only important in wasm (only 1 thread)
+			sim.ProgressReport(&proto.ProgressMetrics{TotalIterations: maxIterations, CompletedIterations: i + 1, Dps: metrics.Dps.Avg})
+			yieldToHost() // ensure that reporting threads are given time to report, mostly only important in wasm (only 1 thread)
 			st = time.Now()
 		}
-		sim.runOnce()
+		sim.runIteration(i)
 	}
+	sim.Options.Iterations = i
+
 	result := &proto.RaidSimResult{
 		RaidMetrics:      sim.Raid.GetMetrics(sim.Options.Iterations),
 		EncounterMetrics: sim.Encounter.GetMetricsProto(sim.Options.Iterations),
 
 		Logs:                   logsBuffer.String(),
 		FirstIterationDuration: firstIterationDuration.Seconds(),
+		DurationMetrics:        sim.durationMetrics.ToProto(sim.Options.Iterations),
+		Iterations:             sim.Options.Iterations,
+	}
+	if sim.timeline != nil {
+		result.Timelines = sim.timeline.finished
 	}
 
 	// Final progress report
@@ -183,6 +312,23 @@ func (sim *Simulation) run() *proto.RaidSimResult {
 	return result
 }
 
+// Runs a single iteration.
+func (sim *Simulation) runIteration(iteration int32) {
+	if sim.pairedRandom != nil {
+		sim.pairedRandom.startIteration(iteration)
+	}
+
+	if sim.timeline != nil {
+		sim.timeline.runIteration(sim, iteration)
+	} else {
+		sim.runOnce()
+	}
+
+	if sim.recordIterationDps {
+		sim.iterationDps = append(sim.iterationDps, sim.Raid.dpsMetrics.Total/sim.Duration.Seconds())
+	}
+}
+
 // RunOnce is the main event loop. It will run the simulation for number of seconds.
 func (sim *Simulation) runOnce() {
 	sim.reset()
@@ -195,7 +341,7 @@ func (sim *Simulation) runOnce() {
 			continue
 		}
 
-		if pa.NextActionAt > sim.Duration {
+		if sim.isDone(pa) {
 			break
 		}
 
@@ -206,6 +352,10 @@ func (sim *Simulation) runOnce() {
 		pa.OnAction(sim)
 	}
 
+	if sim.Encounter.UseHealth {
+		sim.Duration = sim.CurrentTime
+	}
+
 	for _, pa := range sim.pendingActions {
 		if pa.CleanUp != nil {
 			pa.CleanUp(sim)
@@ -221,6 +371,9 @@ func (sim *Simulation) runOnce() {
 	for _, target := range sim.Encounter.Targets {
 		target.Metrics.doneIteration(sim.Duration.Seconds())
 	}
+
+	sim.durationMetrics.Total = sim.Duration.Seconds()
+	sim.durationMetrics.doneIteration(1)
 }
 
 func (sim *Simulation) AddPendingAction(pa *PendingAction) {
@@ -240,10 +393,7 @@ func (sim *Simulation) advance(elapsedTime time.Duration) {
 	sim.CurrentTime += elapsedTime
 
 	if !sim.executePhase && sim.CurrentTime >= sim.Encounter.executePhaseBegins {
-		sim.executePhase = true
-		for _, callback := range sim.executePhaseCallbacks {
-			callback(sim)
-		}
+		sim.startExecutePhase()
 	}
 
 	for _, party := range sim.Raid.Parties {
@@ -257,6 +407,13 @@ func (sim *Simulation) advance(elapsedTime time.Duration) {
 	}
 }
 
+func (sim *Simulation) startExecutePhase() {
+	sim.executePhase = true
+	for _, callback := range sim.executePhaseCallbacks {
+		callback(sim)
+	}
+}
+
 func (sim *Simulation) RegisterExecutePhaseCallback(callback func(*Simulation)) {
 	sim.executePhaseCallbacks = append(sim.executePhaseCallbacks, callback)
 }
@@ -264,6 +421,15 @@ func (sim *Simulation) IsExecutePhase() bool {
 	return sim.executePhase
 }
 
+// Returns the longest the current iteration can last. In health-based
+// encounters sim.Duration is only an estimate, so this is the hard limit instead.
+func (sim *Simulation) GetMaxIterationDuration() time.Duration {
+	if sim.Encounter.UseHealth {
+		return maxHealthEncounterDuration
+	}
+	return sim.Duration
+}
+
 func (sim *Simulation) GetRemainingDuration() time.Duration {
 	return sim.Duration - sim.CurrentTime
 }
@@ -0,0 +1,526 @@
+package core
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Minimum number of iterations each worker should run. Below this, the cost of
+// constructing a new Environment (and running presims) outweighs the benefit
+// of splitting the work.
+const minIterationsPerWorker = 500
+
+// Returns the number of goroutines which should be used to run a sim with the
+// given options.
+func numSimWorkers(options proto.SimOptions) int {
+	// Tests rely on per-callsite random streams which are not seeded, and
+	// full debug logs need to be in order, so both always run serially.
+	if options.IsTest || options.Debug {
+		return 1
+	}
+
+	numWorkers := MinInt(runtime.NumCPU(), int(options.Iterations/minIterationsPerWorker))
+	return MaxInt(numWorkers, 1)
+}
+
+// Splits the iterations of a sim request across multiple Simulations, each
+// running on its own goroutine, and merges the results. See runSim() for
+// recordIterationDps and precisionBaselineDps.
+func runSimConcurrent(ctx context.Context, rsr proto.RaidSimRequest, progress chan *proto.ProgressMetrics, numWorkers int, recordIterationDps bool, precisionBaselineDps []float64) (*proto.RaidSimResult, []float64) {
+	totalIterations := rsr.SimOptions.Iterations
+
+	rseed := rsr.SimOptions.RandomSeed
+	if rseed == 0 {
+		rseed = time.Now().UnixNano()
+	}
+	seedRand := NewSplitMix(uint64(rseed))
+
+	tracker := &concurrentProgressTracker{
+		progress:        progress,
+		totalIterations: maxSimIterations(*rsr.SimOptions),
+		completed:       make([]int32, numWorkers),
+		dps:             make([]float64, numWorkers),
+	}
+
+	results := make([]*proto.RaidSimResult, numWorkers)
+	iterations := make([]int32, numWorkers)
+	iterationDps := make([][]float64, numWorkers)
+	firstIterations := make([]int32, numWorkers)
+
+	var waitGroup sync.WaitGroup
+	firstIteration := int32(0)
+	for i := 0; i < numWorkers; i++ {
+		workerRequest := googleProto.Clone(&rsr).(*proto.RaidSimRequest)
+
+		// Split evenly, giving any remainder to the first workers.
+		iterations[i] = totalIterations / int32(numWorkers)
+		if int32(i) < totalIterations%int32(numWorkers) {
+			iterations[i]++
+		}
+		workerRequest.SimOptions.Iterations = iterations[i]
+		workerRequest.SimOptions.Precision = splitPrecisionTarget(rsr.SimOptions.Precision, numWorkers)
+
+		// Each worker runs a contiguous range of iterations, big enough for the
+		// most iterations it could run, so workers never run the same iteration.
+		// This matters with paired random numbers, where iterations are seeded by
+		// index.
+		workerFirstIteration := firstIteration
+		workerMaxIterations := maxSimIterations(*workerRequest.SimOptions)
+		if rsr.SimOptions.Timeline != nil {
+			timelineIterations := workerTimelineIterations(rsr.SimOptions.Timeline.Iterations, workerFirstIteration, workerMaxIterations)
+			if len(timelineIterations) == 0 {
+				workerRequest.SimOptions.Timeline = nil
+			} else {
+				workerRequest.SimOptions.Timeline.Iterations = timelineIterations
+			}
+		}
+		firstIterations[i] = workerFirstIteration
+		firstIteration += workerMaxIterations
+
+		// The first worker uses the requested seed, so its first iteration (and
+		// debug log) is the same as it would be in a serial sim. With paired
+		// random numbers, all workers use it and iterations are seeded by index.
+		if i == 0 || rsr.SimOptions.PairedRandom {
+			workerRequest.SimOptions.RandomSeed = rseed
+		} else {
+			workerRequest.SimOptions.RandomSeed = int64(seedRand.Next())
+		}
+		if i != 0 {
+			workerRequest.SimOptions.DebugFirstIteration = false
+		}
+
+		waitGroup.Add(1)
+		go func(workerIdx int) {
+			defer waitGroup.Done()
+
+			// Workers which haven't started when the sim is cancelled don't run
+			// at all. The first worker always runs, so there is a result to report.
+			if workerIdx != 0 && ctx.Err() != nil {
+				iterations[workerIdx] = 0
+				return
+			}
+
+			sim := NewSim(*workerRequest)
+			sim.recordIterationDps = recordIterationDps || precisionBaselineDps != nil
+			if sim.pairedRandom != nil {
+				sim.pairedRandom.iterationOffset = workerFirstIteration
+			}
+			if precisionBaselineDps != nil {
+				// Pair with the baseline iterations in this worker's range.
+				sim.precisionBaselineDps = precisionBaselineDps[MinInt(int(workerFirstIteration), len(precisionBaselineDps)):]
+			}
+			sim.runPresims(ctx, *workerRequest)
+			if progress != nil {
+				sim.ProgressReport = func(progMetric *proto.ProgressMetrics) {
+					tracker.update(workerIdx, progMetric)
+				}
+			}
+			results[workerIdx] = sim.run(ctx)
+			for _, timeline := range results[workerIdx].Timelines {
+				timeline.Iteration += workerFirstIteration
+			}
+
+			// Fewer iterations than requested are run if the sim is cancelled, and
+			// more if it has a precision target.
+			iterations[workerIdx] = sim.Options.Iterations
+			iterationDps[workerIdx] = sim.iterationDps
+		}(i)
+	}
+	waitGroup.Wait()
+	totalIterations = sumIterations(iterations)
+
+	result := MergeRaidSimResults(results, iterations)
+
+	// Final progress report
+	if progress != nil {
+		progress <- &proto.ProgressMetrics{TotalIterations: totalIterations, CompletedIterations: totalIterations, Dps: result.RaidMetrics.Dps.Avg, FinalRaidResult: result}
+	}
+
+	return result, mergeIterationDps(iterationDps, firstIterations)
+}
+
+// Combines the raid DPS recorded by each worker so that it is indexed by
+// iteration, with NaN for iterations which no worker ran.
+func mergeIterationDps(iterationDps [][]float64, firstIterations []int32) []float64 {
+	var merged []float64
+	for i, workerDps := range iterationDps {
+		if len(workerDps) == 0 {
+			continue
+		}
+		for int32(len(merged)) < firstIterations[i] {
+			merged = append(merged, math.NaN())
+		}
+		merged = append(merged, workerDps...)
+	}
+	return merged
+}
+
+// Returns the timeline iterations within the range of iterations run by a
+// worker, relative to the start of that range.
+func workerTimelineIterations(selected []int32, firstIteration int32, numIterations int32) []int32 {
+	if len(selected) == 0 {
+		selected = []int32{0}
+	}
+
+	var workerIterations []int32
+	for _, iteration := range selected {
+		if iteration >= firstIteration && iteration < firstIteration+numIterations {
+			workerIterations = append(workerIterations, iteration-firstIteration)
+		}
+	}
+	return workerIterations
+}
+
+// Combines progress reports from concurrent workers into a single stream.
+type concurrentProgressTracker struct {
+	mut sync.Mutex
+
+	progress        chan *proto.ProgressMetrics
+	totalIterations int32
+
+	// Latest values reported by each worker.
+	completed []int32
+	dps       []float64
+}
+
+func (tracker *concurrentProgressTracker) update(workerIdx int, progMetric *proto.ProgressMetrics) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	tracker.completed[workerIdx] = progMetric.CompletedIterations
+	tracker.dps[workerIdx] = progMetric.Dps
+
+	// Workers send their own final results, but only the merged result should
+	// be reported as final.
+	completedIterations := int32(0)
+	dpsSum := 0.0
+	for i, completed := range tracker.completed {
+		completedIterations += completed
+		dpsSum += tracker.dps[i] * float64(completed)
+	}
+	if completedIterations == 0 || completedIterations == tracker.totalIterations {
+		return
+	}
+
+	tracker.progress <- &proto.ProgressMetrics{
+		TotalIterations:     tracker.totalIterations,
+		CompletedIterations: completedIterations,
+		Dps:                 dpsSum / float64(completedIterations),
+	}
+}
+
+// Merges the results of multiple sims of the same raid/encounter into a single
+// result, as if all iterations had been run by a single Simulation.
+//
+// iterations[i] is the number of iterations used to produce results[i].
+// Results with 0 iterations (e.g. from workers which were cancelled before
+// starting) are skipped. Logs and first iteration info are taken from the first
+// result, and timelines from all results are combined in order.
+func MergeRaidSimResults(results []*proto.RaidSimResult, iterations []int32) *proto.RaidSimResult {
+	var nonEmptyResults []*proto.RaidSimResult
+	var nonEmptyIterations []int32
+	for i, result := range results {
+		if iterations[i] > 0 {
+			nonEmptyResults = append(nonEmptyResults, result)
+			nonEmptyIterations = append(nonEmptyIterations, iterations[i])
+		}
+	}
+	results, iterations = nonEmptyResults, nonEmptyIterations
+
+	if len(results) == 1 {
+		return results[0]
+	}
+
+	raidMetrics := make([]*proto.RaidMetrics, len(results))
+	encounterMetrics := make([]*proto.EncounterMetrics, len(results))
+	durationMetrics := make([]*proto.DistributionMetrics, len(results))
+	var timelines []*proto.IterationTimeline
+	for i, result := range results {
+		raidMetrics[i] = result.RaidMetrics
+		encounterMetrics[i] = result.EncounterMetrics
+		durationMetrics[i] = result.DurationMetrics
+		timelines = append(timelines, result.Timelines...)
+	}
+
+	return &proto.RaidSimResult{
+		RaidMetrics:      mergeRaidMetrics(raidMetrics, iterations),
+		EncounterMetrics: mergeEncounterMetrics(encounterMetrics, iterations),
+
+		Logs:                   results[0].Logs,
+		FirstIterationDuration: results[0].FirstIterationDuration,
+		DurationMetrics:        mergeDistributionMetrics(durationMetrics, iterations),
+		Timelines:              timelines,
+		Iterations:             sumIterations(iterations),
+	}
+}
+
+func mergeRaidMetrics(allMetrics []*proto.RaidMetrics, iterations []int32) *proto.RaidMetrics {
+	dps := make([]*proto.DistributionMetrics, len(allMetrics))
+	for i, metrics := range allMetrics {
+		dps[i] = metrics.Dps
+	}
+
+	merged := &proto.RaidMetrics{
+		Dps: mergeDistributionMetrics(dps, iterations),
+	}
+
+	for partyIdx := range allMetrics[0].Parties {
+		parties := make([]*proto.PartyMetrics, len(allMetrics))
+		for i, metrics := range allMetrics {
+			parties[i] = metrics.Parties[partyIdx]
+		}
+		merged.Parties = append(merged.Parties, mergePartyMetrics(parties, iterations))
+	}
+
+	return merged
+}
+
+func mergePartyMetrics(allMetrics []*proto.PartyMetrics, iterations []int32) *proto.PartyMetrics {
+	dps := make([]*proto.DistributionMetrics, len(allMetrics))
+	for i, metrics := range allMetrics {
+		dps[i] = metrics.Dps
+	}
+
+	merged := &proto.PartyMetrics{
+		Dps: mergeDistributionMetrics(dps, iterations),
+	}
+
+	for playerIdx := range allMetrics[0].Players {
+		players := make([]*proto.UnitMetrics, len(allMetrics))
+		for i, metrics := range allMetrics {
+			players[i] = metrics.Players[playerIdx]
+		}
+		merged.Players = append(merged.Players, mergeUnitMetrics(players, iterations))
+	}
+
+	return merged
+}
+
+func mergeEncounterMetrics(allMetrics []*proto.EncounterMetrics, iterations []int32) *proto.EncounterMetrics {
+	merged := &proto.EncounterMetrics{}
+
+	for targetIdx := range allMetrics[0].Targets {
+		targets := make([]*proto.UnitMetrics, len(allMetrics))
+		for i, metrics := range allMetrics {
+			targets[i] = metrics.Targets[targetIdx]
+		}
+		merged.Targets = append(merged.Targets, mergeUnitMetrics(targets, iterations))
+	}
+
+	return merged
+}
+
+func mergeUnitMetrics(allMetrics []*proto.UnitMetrics, iterations []int32) *proto.UnitMetrics {
+	// Empty raid slots have no metrics at all.
+	if allMetrics[0].Dps == nil {
+		return allMetrics[0]
+	}
+
+	numIterations := sumIterations(iterations)
+	merged := &proto.UnitMetrics{
+		Name: allMetrics[0].Name,
+	}
+
+	dps := make([]*proto.DistributionMetrics, len(allMetrics))
+	threat := make([]*proto.DistributionMetrics, len(allMetrics))
+	dtps := make([]*proto.DistributionMetrics, len(allMetrics))
+	hps := make([]*proto.DistributionMetrics, len(allMetrics))
+	ohps := make([]*proto.DistributionMetrics, len(allMetrics))
+
+	actions := []*proto.ActionMetrics{}
+	actionIndices := make(map[ActionID]int)
+
+	auras := [][]*proto.AuraMetrics{}
+	auraIterations := [][]int32{}
+	auraIndices := make(map[ActionID]int)
+
+	resources := []*proto.ResourceMetrics{}
+	resourceIndices := make(map[ResourceKey]int)
+
+	var castSequenceBlocks []*proto.CastSequenceBlockMetrics
+	castSequenceBlockIndices := make(map[castSequenceBlockKey]int)
+
+	totalDeaths := 0.0
+	timeOfDeathSum := 0.0
+
+	for i, metrics := range allMetrics {
+		dps[i] = metrics.Dps
+		threat[i] = metrics.Threat
+		dtps[i] = metrics.Dtps
+		hps[i] = metrics.Hps
+		ohps[i] = metrics.Ohps
+		merged.SecondsOomAvg += metrics.SecondsOomAvg * float64(iterations[i]) / float64(numIterations)
+		merged.SecondsLostToMovementAvg += metrics.SecondsLostToMovementAvg * float64(iterations[i]) / float64(numIterations)
+		merged.ChanceOfDeath += metrics.ChanceOfDeath * float64(iterations[i]) / float64(numIterations)
+		merged.MinHealthAvg += metrics.MinHealthAvg * float64(iterations[i]) / float64(numIterations)
+		deaths := metrics.ChanceOfDeath * float64(iterations[i])
+		totalDeaths += deaths
+		timeOfDeathSum += metrics.SecondsToDeathAvg * deaths
+
+		for _, action := range metrics.Actions {
+			actionID := ProtoToActionID(*action.Id)
+			idx, ok := actionIndices[actionID]
+			if !ok {
+				actionIndices[actionID] = len(actions)
+				actions = append(actions, googleProto.Clone(action).(*proto.ActionMetrics))
+				continue
+			}
+			mergeActionMetrics(actions[idx], action)
+		}
+
+		for _, aura := range metrics.Auras {
+			actionID := ProtoToActionID(*aura.Id)
+			idx, ok := auraIndices[actionID]
+			if !ok {
+				idx = len(auras)
+				auraIndices[actionID] = idx
+				auras = append(auras, nil)
+				auraIterations = append(auraIterations, nil)
+			}
+			auras[idx] = append(auras[idx], aura)
+			auraIterations[idx] = append(auraIterations[idx], iterations[i])
+		}
+
+		for _, resource := range metrics.Resources {
+			rk := ResourceKey{
+				ActionID: ProtoToActionID(*resource.Id),
+				Type:     resource.Type,
+			}
+			idx, ok := resourceIndices[rk]
+			if !ok {
+				resourceIndices[rk] = len(resources)
+				resources = append(resources, googleProto.Clone(resource).(*proto.ResourceMetrics))
+				continue
+			}
+			resources[idx].Events += resource.Events
+			resources[idx].Gain += resource.Gain
+			resources[idx].ActualGain += resource.ActualGain
+		}
+
+		for _, block := range metrics.CastSequenceBlocks {
+			key := castSequenceBlockKey{index: int(block.Index), reason: block.Reason}
+			idx, ok := castSequenceBlockIndices[key]
+			if !ok {
+				castSequenceBlockIndices[key] = len(castSequenceBlocks)
+				castSequenceBlocks = append(castSequenceBlocks, googleProto.Clone(block).(*proto.CastSequenceBlockMetrics))
+				continue
+			}
+			merged := castSequenceBlocks[idx]
+			totalIterations := merged.Iterations + block.Iterations
+			merged.BlockedSecondsAvg = (merged.BlockedSecondsAvg*float64(merged.Iterations) + block.BlockedSecondsAvg*float64(block.Iterations)) / float64(totalIterations)
+			merged.Iterations = totalIterations
+		}
+	}
+
+	merged.Dps = mergeDistributionMetrics(dps, iterations)
+	merged.Threat = mergeDistributionMetrics(threat, iterations)
+	merged.Dtps = mergeDistributionMetrics(dtps, iterations)
+	merged.Hps = mergeDistributionMetrics(hps, iterations)
+	merged.Ohps = mergeDistributionMetrics(ohps, iterations)
+	if totalDeaths > 0 {
+		merged.SecondsToDeathAvg = timeOfDeathSum / totalDeaths
+	}
+	merged.Actions = actions
+	merged.Resources = resources
+	sort.Slice(castSequenceBlocks, func(i, j int) bool {
+		a, b := castSequenceBlocks[i], castSequenceBlocks[j]
+		return a.Index < b.Index || (a.Index == b.Index && a.Reason < b.Reason)
+	})
+	merged.CastSequenceBlocks = castSequenceBlocks
+
+	for i, aura := range auras {
+		merged.Auras = append(merged.Auras, mergeAuraMetrics(aura, auraIterations[i], numIterations))
+	}
+
+	for petIdx := range allMetrics[0].Pets {
+		pets := make([]*proto.UnitMetrics, len(allMetrics))
+		for i, metrics := range allMetrics {
+			pets[i] = metrics.Pets[petIdx]
+		}
+		merged.Pets = append(merged.Pets, mergeUnitMetrics(pets, iterations))
+	}
+
+	return merged
+}
+
+// Adds the totals from other into merged.
+func mergeActionMetrics(merged *proto.ActionMetrics, other *proto.ActionMetrics) {
+	for len(merged.Targets) < len(other.Targets) {
+		merged.Targets = append(merged.Targets, &proto.TargetedActionMetrics{})
+	}
+
+	for i, tam := range other.Targets {
+		mergedTam := merged.Targets[i]
+		mergedTam.Casts += tam.Casts
+		mergedTam.Hits += tam.Hits
+		mergedTam.Crits += tam.Crits
+		mergedTam.Crushes += tam.Crushes
+		mergedTam.Misses += tam.Misses
+		mergedTam.Dodges += tam.Dodges
+		mergedTam.Parries += tam.Parries
+		mergedTam.Blocks += tam.Blocks
+		mergedTam.Glances += tam.Glances
+		mergedTam.Damage += tam.Damage
+		mergedTam.Threat += tam.Threat
+		mergedTam.Healing += tam.Healing
+		mergedTam.Overhealing += tam.Overhealing
+	}
+}
+
+// Auras which never activated in some sims are missing from those results, so
+// those sims are counted as having 0 uptime.
+func mergeAuraMetrics(allMetrics []*proto.AuraMetrics, iterations []int32, numIterations int32) *proto.AuraMetrics {
+	sum := 0.0
+	sumSquared := 0.0
+	for i, metrics := range allMetrics {
+		n := float64(iterations[i])
+		sum += metrics.UptimeSecondsAvg * n
+		sumSquared += (metrics.UptimeSecondsStdev*metrics.UptimeSecondsStdev + metrics.UptimeSecondsAvg*metrics.UptimeSecondsAvg) * n
+	}
+
+	uptimeAvg := sum / float64(numIterations)
+	return &proto.AuraMetrics{
+		Id:                 allMetrics[0].Id,
+		UptimeSecondsAvg:   uptimeAvg,
+		UptimeSecondsStdev: math.Sqrt(MaxFloat(0, sumSquared/float64(numIterations)-uptimeAvg*uptimeAvg)),
+	}
+}
+
+func mergeDistributionMetrics(allMetrics []*proto.DistributionMetrics, iterations []int32) *proto.DistributionMetrics {
+	numIterations := float64(sumIterations(iterations))
+
+	merged := &proto.DistributionMetrics{
+		Hist: make(map[int32]int32),
+	}
+
+	sum := 0.0
+	sumSquared := 0.0
+	for i, metrics := range allMetrics {
+		n := float64(iterations[i])
+		sum += metrics.Avg * n
+		sumSquared += (metrics.Stdev*metrics.Stdev + metrics.Avg*metrics.Avg) * n
+		merged.Max = MaxFloat(merged.Max, metrics.Max)
+		for bucket, count := range metrics.Hist {
+			merged.Hist[bucket] += count
+		}
+	}
+
+	merged.Avg = sum / numIterations
+	merged.Stdev = math.Sqrt(MaxFloat(0, sumSquared/numIterations-merged.Avg*merged.Avg))
+	return merged
+}
+
+func sumIterations(iterations []int32) int32 {
+	total := int32(0)
+	for _, n := range iterations {
+		total += n
+	}
+	return total
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestMergeDistributionMetrics(t *testing.T) {
+	samples := [][]float64{
+		{1000, 1100, 1200},
+		{900, 1300},
+		{1050},
+	}
+
+	allMetrics := make([]*proto.DistributionMetrics, len(samples))
+	iterations := make([]int32, len(samples))
+	all := NewDistributionMetrics()
+	for i, workerSamples := range samples {
+		metrics := NewDistributionMetrics()
+		for _, sample := range workerSamples {
+			metrics.Total = sample
+			metrics.doneIteration(1)
+			all.Total = sample
+			all.doneIteration(1)
+		}
+		allMetrics[i] = metrics.ToProto(int32(len(workerSamples)))
+		iterations[i] = int32(len(workerSamples))
+	}
+
+	expected := all.ToProto(6)
+	merged := mergeDistributionMetrics(allMetrics, iterations)
+
+	if math.Abs(merged.Avg-expected.Avg) > 0.0001 {
+		t.Fatalf("Expected avg %0.3f but was %0.3f", expected.Avg, merged.Avg)
+	}
+	if math.Abs(merged.Stdev-expected.Stdev) > 0.0001 {
+		t.Fatalf("Expected stdev %0.3f but was %0.3f", expected.Stdev, merged.Stdev)
+	}
+	if merged.Max != expected.Max {
+		t.Fatalf("Expected max %0.3f but was %0.3f", expected.Max, merged.Max)
+	}
+	for bucket, count := range expected.Hist {
+		if merged.Hist[bucket] != count {
+			t.Fatalf("Expected %d samples in bucket %d but was %d", count, bucket, merged.Hist[bucket])
+		}
+	}
+}
+
+func TestWorkerTimelineIterations(t *testing.T) {
+	selected := []int32{0, 5, 10, 11}
+
+	workerIterations := workerTimelineIterations(selected, 10, 5)
+	if len(workerIterations) != 2 || workerIterations[0] != 0 || workerIterations[1] != 1 {
+		t.Fatalf("Expected iterations [0 1] but got %v", workerIterations)
+	}
+
+	if workerIterations := workerTimelineIterations(selected, 6, 4); len(workerIterations) != 0 {
+		t.Fatalf("Expected no iterations but got %v", workerIterations)
+	}
+
+	// No selection means only the first iteration.
+	if workerIterations := workerTimelineIterations(nil, 0, 5); len(workerIterations) != 1 || workerIterations[0] != 0 {
+		t.Fatalf("Expected iterations [0] but got %v", workerIterations)
+	}
+}
@@ -0,0 +1,118 @@
+package core_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestCancelledRaidSim(t *testing.T) {
+	rsr := &proto.RaidSimRequest{
+		Raid:      testRaid,
+		Encounter: testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations: 1000,
+			IsTest:     true,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	progress := make(chan *proto.ProgressMetrics, 100)
+	core.RunRaidSimAsync(ctx, rsr, progress)
+	for progMetric := range progress {
+		if progMetric.FinalRaidResult == nil {
+			continue
+		}
+		if progMetric.CompletedIterations >= rsr.SimOptions.Iterations {
+			t.Fatalf("Expected cancelled sim to stop early, but ran %d iterations", progMetric.CompletedIterations)
+		}
+		if progMetric.FinalRaidResult.RaidMetrics.Dps.Avg <= 0 {
+			t.Fatalf("Expected cancelled sim to report the completed iterations")
+		}
+		return
+	}
+}
+
+// Paired random numbers seed each iteration by its index, so splitting the
+// iterations across workers shouldn't change the merged results.
+func TestConcurrentSimMatchesSerial(t *testing.T) {
+	rsr := proto.RaidSimRequest{
+		Raid:      testRaid,
+		Encounter: testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations:   200,
+			RandomSeed:   1,
+			PairedRandom: true,
+		},
+	}
+
+	serial := core.RunRaidSim(&rsr)
+	concurrent, _ := core.RunSimConcurrent(context.Background(), rsr, nil, 4, false, nil)
+
+	if concurrent.Iterations != serial.Iterations {
+		t.Fatalf("Expected %d iterations, but got %d", serial.Iterations, concurrent.Iterations)
+	}
+	expectSameDistribution(t, "raid dps", serial.RaidMetrics.Dps, concurrent.RaidMetrics.Dps)
+	expectSameDistribution(t, "duration", serial.DurationMetrics, concurrent.DurationMetrics)
+	expectSameDistribution(t, "target dtps", serial.EncounterMetrics.Targets[0].Dtps, concurrent.EncounterMetrics.Targets[0].Dtps)
+	for i, player := range serial.RaidMetrics.Parties[0].Players {
+		expectSameDistribution(t, player.Name+" dps", player.Dps, concurrent.RaidMetrics.Parties[0].Players[i].Dps)
+	}
+}
+
+// Workers which are cancelled before starting run no iterations, and
+// shouldn't affect the merged results.
+func TestCancelledConcurrentSim(t *testing.T) {
+	rsr := proto.RaidSimRequest{
+		Raid:      testRaid,
+		Encounter: testEncounter,
+		SimOptions: &proto.SimOptions{
+			Iterations:   200,
+			RandomSeed:   1,
+			PairedRandom: true,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cancelled, _ := core.RunSimConcurrent(ctx, rsr, nil, 4, false, nil)
+
+	rsr.SimOptions = &proto.SimOptions{
+		Iterations:   1,
+		RandomSeed:   1,
+		PairedRandom: true,
+	}
+	single := core.RunRaidSim(&rsr)
+
+	if cancelled.Iterations != 1 {
+		t.Fatalf("Expected only the first worker to run, but got %d iterations", cancelled.Iterations)
+	}
+	expectSameDistribution(t, "raid dps", single.RaidMetrics.Dps, cancelled.RaidMetrics.Dps)
+	expectSameDistribution(t, "duration", single.DurationMetrics, cancelled.DurationMetrics)
+}
+
+func expectSameDistribution(t *testing.T, label string, expected *proto.DistributionMetrics, actual *proto.DistributionMetrics) {
+	const tolerance = 0.001
+	if math.Abs(actual.Avg-expected.Avg) > tolerance || math.IsNaN(actual.Avg) {
+		t.Fatalf("Expected %s avg %0.3f but was %0.3f", label, expected.Avg, actual.Avg)
+	}
+	if math.Abs(actual.Stdev-expected.Stdev) > tolerance || math.IsNaN(actual.Stdev) {
+		t.Fatalf("Expected %s stdev %0.3f but was %0.3f", label, expected.Stdev, actual.Stdev)
+	}
+	if actual.Max != expected.Max {
+		t.Fatalf("Expected %s max %0.3f but was %0.3f", label, expected.Max, actual.Max)
+	}
+	if len(actual.Hist) != len(expected.Hist) {
+		t.Fatalf("Expected %d %s histogram buckets but got %d", len(expected.Hist), label, len(actual.Hist))
+	}
+	for bucket, count := range expected.Hist {
+		if actual.Hist[bucket] != count {
+			t.Fatalf("Expected %d %s samples in bucket %d but was %d", count, label, bucket, actual.Hist[bucket])
+		}
+	}
+}
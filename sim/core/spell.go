@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
 )
 
@@ -38,6 +39,8 @@ type SpellMetrics struct {
 	PartialResists_3_4 int32   // 3/4 of the spell was resisted
 	TotalDamage        float64 // Damage done by all casts of this spell.
 	TotalThreat        float64 // Threat generated by all casts of this spell.
+	TotalHealing       float64 // Healing done by all casts of this spell, excluding overhealing.
+	TotalOverhealing   float64 // Overhealing done by all casts of this spell.
 }
 
 type Spell struct {
@@ -177,6 +180,10 @@ func (spell *Spell) SkipCastAndApplyEffects(sim *Simulation, target *Unit) {
 			spell.ActionID, spell.DefaultCast.Cost, time.Duration(0))
 		spell.Unit.Log(sim, "Completed cast %s", spell.ActionID)
 	}
+	if sim.RecordingTimeline() && !spell.SpellExtras.Matches(SpellExtrasNoLogs) {
+		sim.addCastTimelineEvent(proto.TimelineEventType_TimelineEventCastStart, spell, target)
+		sim.addCastTimelineEvent(proto.TimelineEventType_TimelineEventCastComplete, spell, target)
+	}
 	spell.applyEffects(sim, target)
 }
 
@@ -187,10 +194,20 @@ func (spell *Spell) applyEffects(sim *Simulation, target *Unit) {
 	if target == nil {
 		target = spell.Unit.CurrentTarget
 	}
-	spell.SpellMetrics[target.Index].Casts++
+	spell.SpellMetrics[spell.metricsIndex(target)].Casts++
 	spell.ApplyEffects(sim, target, spell)
 }
 
+// Returns the index into SpellMetrics for the given target. Metrics are
+// tracked per opposing unit, so all casts on friendly units (e.g. heals) are
+// recorded under the first index.
+func (spell *Spell) metricsIndex(target *Unit) int32 {
+	if (target.Type == EnemyUnit) == (spell.Unit.Type == EnemyUnit) {
+		return 0
+	}
+	return target.Index
+}
+
 func ApplyEffectFuncDirectDamage(baseEffect SpellEffect) ApplySpellEffects {
 	baseEffect.Validate()
 	if baseEffect.BaseDamage.Calculator == nil {
@@ -284,13 +301,14 @@ func ApplyEffectFuncDamageMultipleTargeted(baseEffects []SpellEffect) ApplySpell
 }
 func ApplyEffectFuncAOEDamage(env *Environment, baseEffect SpellEffect) ApplySpellEffects {
 	baseEffect.Validate()
-	numHits := env.GetNumTargets()
-	effects := make([]SpellEffect, numHits)
-	for i := int32(0); i < numHits; i++ {
-		effects[i] = baseEffect
-		effects[i].Target = &env.GetTarget(i).Unit
-	}
-	return ApplyEffectFuncDamageMultiple(effects)
+	return ApplyEffectFuncActiveTargets(env, env.GetNumTargets(), func(targets []*Unit) ApplySpellEffects {
+		effects := make([]SpellEffect, len(targets))
+		for i, target := range targets {
+			effects[i] = baseEffect
+			effects[i].Target = target
+		}
+		return ApplyEffectFuncDamageMultiple(effects)
+	})
 }
 
 func ApplyEffectFuncDot(dot *Dot) ApplySpellEffects {
@@ -326,22 +344,25 @@ func applyAOECap(effects []SpellEffect, outcomeMultipliers []float64, aoeCap flo
 }
 func ApplyEffectFuncAOEDamageCapped(env *Environment, aoeCap float64, baseEffect SpellEffect) ApplySpellEffects {
 	baseEffect.Validate()
-	numHits := env.GetNumTargets()
-	if numHits == 0 {
+	if env.GetNumTargets() == 0 {
 		return nil
-	} else if numHits == 1 {
-		return ApplyEffectFuncDirectDamage(baseEffect)
-	} else if numHits < 4 {
-		// Just assume its impossible to hit AOE cap with <4 targets.
-		return ApplyEffectFuncAOEDamage(env, baseEffect)
 	}
 
-	baseEffects := make([]SpellEffect, numHits)
-	for i := int32(0); i < numHits; i++ {
-		baseEffects[i] = baseEffect
-		baseEffects[i].Target = &env.GetTarget(i).Unit
-	}
-	return ApplyEffectFuncMultipleDamageCapped(baseEffects, aoeCap)
+	return ApplyEffectFuncActiveTargets(env, env.GetNumTargets(), func(targets []*Unit) ApplySpellEffects {
+		baseEffects := make([]SpellEffect, len(targets))
+		for i, target := range targets {
+			baseEffects[i] = baseEffect
+			baseEffects[i].Target = target
+		}
+
+		if len(targets) == 1 {
+			return ApplyEffectFuncDirectDamage(baseEffect)
+		} else if len(targets) < 4 {
+			// Just assume its impossible to hit AOE cap with <4 targets.
+			return ApplyEffectFuncDamageMultiple(baseEffects)
+		}
+		return ApplyEffectFuncMultipleDamageCapped(baseEffects, aoeCap)
+	})
 }
 
 func ApplyEffectFuncMultipleDamageCapped(baseEffects []SpellEffect, aoeCap float64) ApplySpellEffects {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
 )
 
@@ -19,6 +20,7 @@ type SpellEffect struct {
 	// Target of the spell.
 	Target *Unit
 
+	// Also used as the base healing of healing effects.
 	BaseDamage     BaseDamageConfig
 	OutcomeApplier OutcomeApplier
 
@@ -30,6 +32,8 @@ type SpellEffect struct {
 	BonusAttackPower float64
 	BonusCritRating  float64
 
+	BonusHealingPower float64
+
 	// Additional multiplier that is always applied.
 	DamageMultiplier float64
 
@@ -49,6 +53,7 @@ type SpellEffect struct {
 	OnInit                func(sim *Simulation, spell *Spell, spellEffect *SpellEffect)
 	OnSpellHitDealt       func(sim *Simulation, spell *Spell, spellEffect *SpellEffect)
 	OnPeriodicDamageDealt func(sim *Simulation, spell *Spell, spellEffect *SpellEffect)
+	OnHealDealt           func(sim *Simulation, spell *Spell, spellEffect *SpellEffect)
 
 	// Results
 	Outcome HitOutcome
@@ -56,6 +61,9 @@ type SpellEffect struct {
 	Threat  float64
 
 	PreoutcomeDamage float64 // Damage done by this cast.
+
+	Healing     float64 // Healing done by this cast, including overhealing.
+	Overhealing float64 // Portion of Healing which exceeded the target's missing health.
 }
 
 func (spellEffect *SpellEffect) Validate() {
@@ -192,6 +200,14 @@ func (spellEffect *SpellEffect) calcDamageTargetOnly(sim *Simulation, spell *Spe
 func (spellEffect *SpellEffect) finalize(sim *Simulation, spell *Spell) {
 	spell.SpellMetrics[spellEffect.Target.Index].TotalDamage += spellEffect.Damage
 	spell.SpellMetrics[spellEffect.Target.Index].TotalThreat += spellEffect.calcThreat(spell)
+	spellEffect.Target.damageTaken += spellEffect.Damage
+	if spellEffect.Target == sim.phaseHealthTarget {
+		sim.checkPhaseHealthThreshold()
+	}
+	if sim.Encounter.UseHealth && spellEffect.Target.Type == EnemyUnit {
+		sim.checkTargetHealth(spellEffect.Target)
+	}
+	spellEffect.Target.updateHealth(sim)
 
 	if sim.Log != nil {
 		if spellEffect.IsPeriodic {
@@ -200,6 +216,14 @@ func (spellEffect *SpellEffect) finalize(sim *Simulation, spell *Spell) {
 			spell.Unit.Log(sim, "%s %s. (Threat: %0.3f)", spell.ActionID, spellEffect, spellEffect.calcThreat(spell))
 		}
 	}
+	if sim.RecordingTimeline() {
+		event := sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventDamage, spell.Unit, spell.ActionID)
+		event.Target = sim.timeline.units[spellEffect.Target]
+		event.Outcome = spellEffect.Outcome.ToProto()
+		event.PartialResistQuarters = spellEffect.Outcome.PartialResistQuarters()
+		event.Periodic = spellEffect.IsPeriodic
+		event.Amount = spellEffect.Damage
+	}
 
 	if !spellEffect.IsPeriodic {
 		if spellEffect.OnSpellHitDealt != nil {
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Proportion of effective healing which is converted into threat, split
+// evenly between all enemies which can currently be attacked.
+const healingThreatMultiplier = 0.5
+
+func (spellEffect *SpellEffect) HealingPower(unit *Unit) float64 {
+	return unit.GetStat(stats.HealingPower) + spellEffect.BonusHealingPower
+}
+
+func (spellEffect *SpellEffect) HealingCritChance(unit *Unit, spell *Spell) float64 {
+	critRating := unit.GetStat(stats.SpellCrit) + spellEffect.BonusSpellCritRating
+	return critRating / (SpellCritRatingPerCritChance * 100)
+}
+
+// Creates a BaseDamageCalculator for a heal. Healing uses the same calculator
+// type as damage, with +healing in place of +spell damage.
+func BaseHealingFuncHealing(minFlatHealing float64, maxFlatHealing float64, spellCoefficient float64) BaseDamageCalculator {
+	deltaHealing := maxFlatHealing - minFlatHealing
+	return func(sim *Simulation, hitEffect *SpellEffect, spell *Spell) float64 {
+		healing := hitEffect.HealingPower(spell.Unit) * spellCoefficient
+		if deltaHealing == 0 {
+			return healing + minFlatHealing
+		}
+		return healing + damageRollOptimized(sim, minFlatHealing, deltaHealing)
+	}
+}
+func BaseHealingConfigHealing(minFlatHealing float64, maxFlatHealing float64, spellCoefficient float64) BaseDamageConfig {
+	return BuildBaseDamageConfig(BaseHealingFuncHealing(minFlatHealing, maxFlatHealing, spellCoefficient), 0)
+}
+
+// Heals can't miss, so the only outcomes are hits and crits.
+func (unit *Unit) OutcomeFuncHealingCrit(critMultiplier float64) OutcomeApplier {
+	return func(sim *Simulation, spell *Spell, spellEffect *SpellEffect, _ *AttackTable) {
+		metrics := &spell.SpellMetrics[spell.metricsIndex(spellEffect.Target)]
+		if sim.RandomFloat("Healing Crit Roll") < spellEffect.HealingCritChance(spell.Unit, spell) {
+			spellEffect.Outcome = OutcomeCrit
+			metrics.Crits++
+			spellEffect.Healing *= critMultiplier
+		} else {
+			spellEffect.Outcome = OutcomeHit
+			metrics.Hits++
+		}
+	}
+}
+
+func ApplyEffectFuncHealing(baseEffect SpellEffect) ApplySpellEffects {
+	baseEffect.Validate()
+	return func(sim *Simulation, target *Unit, spell *Spell) {
+		effect := &baseEffect
+		effect.Target = target
+		effect.init(sim, spell)
+
+		effect.Healing = effect.calculateBaseDamage(sim, spell) * effect.DamageMultiplier
+		effect.Healing *= spell.Unit.PseudoStats.HealingDealtMultiplier * target.PseudoStats.HealingTakenMultiplier
+		effect.OutcomeApplier(sim, spell, effect, nil)
+		effect.finalizeHealing(sim, spell)
+	}
+}
+
+func (spellEffect *SpellEffect) finalizeHealing(sim *Simulation, spell *Spell) {
+	target := spellEffect.Target
+	effectiveHealing := target.gainHealth(spellEffect.Healing)
+	spellEffect.Overhealing = spellEffect.Healing - effectiveHealing
+
+	metrics := &spell.SpellMetrics[spell.metricsIndex(target)]
+	metrics.TotalHealing += effectiveHealing
+	metrics.TotalOverhealing += spellEffect.Overhealing
+	threat := effectiveHealing * healingThreatMultiplier * spell.TotalThreatMultiplier()
+	activeTargets := sim.GetActiveTargets()
+	for _, enemy := range activeTargets {
+		spell.SpellMetrics[enemy.Index].TotalThreat += threat / float64(len(activeTargets))
+	}
+
+	if sim.Log != nil {
+		spell.Unit.Log(sim, "%s %s for %0.3f healing (%0.3f overhealing) on %s. (Threat: %0.3f)",
+			spell.ActionID, spellEffect.Outcome, spellEffect.Healing, spellEffect.Overhealing, target.Label, threat)
+	}
+
+	if spellEffect.OnHealDealt != nil {
+		spellEffect.OnHealDealt(sim, spell, spellEffect)
+	}
+}
+
+// Returns the amount of health this unit is missing. Always 0 for units
+// without a health value.
+func (unit *Unit) MissingHealth() float64 {
+	maxHealth := unit.GetStat(stats.Health)
+	if maxHealth <= 0 {
+		return 0
+	}
+	return MinFloat(unit.damageTaken, maxHealth)
+}
+
+// Deals damage to this unit from a source outside the sim, e.g. unmodelled
+// raid damage on a healing target.
+func (unit *Unit) RemoveHealth(sim *Simulation, amount float64) {
+	unit.damageTaken += amount
+	if sim.Log != nil {
+		unit.Log(sim, "Took %0.3f damage, %0.3f health remaining.", amount, unit.GetStat(stats.Health)-unit.MissingHealth())
+	}
+	unit.updateHealth(sim)
+}
@@ -0,0 +1,50 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+
+	holyPaladin "github.com/wowsims/tbc/sim/paladin/holy"
+)
+
+func TestHealingThreatSplitBetweenTargets(t *testing.T) {
+	paladin := &proto.Player{
+		Name:      "P1 Holy Paladin",
+		Race:      proto.Race_RaceBloodElf,
+		Class:     proto.Class_ClassPaladin,
+		Equipment: holyPaladin.P1Gear,
+		Consumes:  holyPaladin.FullConsumes,
+		Spec:      holyPaladin.DefaultOptions,
+		Buffs:     holyPaladin.FullIndividualBuffs,
+	}
+	encounter := &proto.Encounter{
+		Duration: 300,
+		Targets: []*proto.Target{
+			testTarget,
+			testTarget,
+		},
+	}
+
+	result := runTestSim(singlePlayerRaid(paladin), encounter, nil)
+	playerMetrics := result.RaidMetrics.Parties[0].Players[0]
+
+	totalHealing := 0.0
+	threatByTarget := make([]float64, len(encounter.Targets))
+	for _, action := range playerMetrics.Actions {
+		for i, target := range action.Targets {
+			totalHealing += target.Healing
+			threatByTarget[i] += target.Threat
+		}
+	}
+
+	if totalHealing == 0 {
+		t.Fatalf("Expected some healing")
+	}
+	if threatByTarget[0] != threatByTarget[1] {
+		t.Fatalf("Expected healing threat to be split evenly, but got %0.3f and %0.3f", threatByTarget[0], threatByTarget[1])
+	}
+	if totalThreat := threatByTarget[0] + threatByTarget[1]; totalThreat > totalHealing {
+		t.Fatalf("Expected total healing threat to be at most the healing done, but got %0.3f threat for %0.3f healing", totalThreat, totalHealing)
+	}
+}
@@ -454,6 +454,8 @@ type PseudoStats struct {
 	NatureDamageDealtMultiplier   float64
 	ShadowDamageDealtMultiplier   float64
 
+	HealingDealtMultiplier float64 // All healing
+
 	// Modifiers for spells with the SpellExtrasAgentReserved1 flag set.
 	BonusCritRatingAgentReserved1       float64
 	AgentReserved1DamageDealtMultiplier float64
@@ -491,6 +493,8 @@ type PseudoStats struct {
 	ShadowDamageTakenMultiplier   float64
 
 	PeriodicPhysicalDamageTakenMultiplier float64
+
+	HealingTakenMultiplier float64 // All healing
 }
 
 func NewPseudoStats() PseudoStats {
@@ -518,6 +522,8 @@ func NewPseudoStats() PseudoStats {
 
 		AgentReserved1DamageDealtMultiplier: 1,
 
+		HealingDealtMultiplier: 1,
+
 		// Target effects.
 		DamageTakenMultiplier: 1,
 
@@ -530,5 +536,7 @@ func NewPseudoStats() PseudoStats {
 		ShadowDamageTakenMultiplier:   1,
 
 		PeriodicPhysicalDamageTakenMultiplier: 1,
+
+		HealingTakenMultiplier: 1,
 	}
 }
@@ -1,10 +1,12 @@
 package core
 
 import (
+	"context"
 	"math"
 	"math/rand"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
@@ -33,6 +35,9 @@ type StatWeightsResult struct {
 	Dps  StatWeightValues
 	Tps  StatWeightValues
 	Dtps StatWeightValues
+
+	// Iterations used for each stat, across both directions.
+	Iterations [stats.Len]int32
 }
 
 func (swr StatWeightsResult) ToProto() *proto.StatWeightsResult {
@@ -40,10 +45,12 @@ func (swr StatWeightsResult) ToProto() *proto.StatWeightsResult {
 		Dps:  swr.Dps.ToProto(),
 		Tps:  swr.Tps.ToProto(),
 		Dtps: swr.Dtps.ToProto(),
+
+		Iterations: swr.Iterations[:],
 	}
 }
 
-func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, referenceStat stats.Stat, progress chan *proto.ProgressMetrics) StatWeightsResult {
+func CalcStatWeight(ctx context.Context, swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, referenceStat stats.Stat, progress chan *proto.ProgressMetrics) StatWeightsResult {
 	if swr.Player.BonusStats == nil {
 		swr.Player.BonusStats = make([]float64, stats.Len)
 	}
@@ -51,7 +58,13 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 	raidProto := SinglePlayerRaidProto(swr.Player, swr.PartyBuffs, swr.RaidBuffs, swr.Debuffs)
 	raidProto.Tanks = swr.Tanks
 
-	simOptions := swr.SimOptions
+	// All sims share paired random numbers, so the differences between them are
+	// mostly due to the stat changes rather than noise.
+	simOptions := googleProto.Clone(swr.SimOptions).(*proto.SimOptions)
+	simOptions.PairedRandom = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
 
 	baseStatsResult := ComputeStats(&proto.ComputeStatsRequest{
 		Raid: raidProto,
@@ -63,8 +76,10 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 		Encounter:  swr.Encounter,
 		SimOptions: simOptions,
 	}
-	baselineResult := RunRaidSim(baseSimRequest)
-	baselineDpsMetrics := baselineResult.RaidMetrics.Parties[0].Players[0].Dps
+	// Stat sims can't pair more iterations than the baseline ran. The baseline
+	// runs to its own precision target though, which needs far more iterations
+	// than the paired differences do, so this rarely limits them.
+	baselineResult, baselineIterationDps := runSim(ctx, *baseSimRequest, nil, true, nil)
 	baselineTpsMetrics := baselineResult.RaidMetrics.Parties[0].Players[0].Threat
 	baselineDtpsMetrics := baselineResult.RaidMetrics.Parties[0].Players[0].Dtps
 
@@ -80,6 +95,7 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 	dtpsHistsLow := [stats.Len]map[int32]int32{}
 	dtpsHistsHigh := [stats.Len]map[int32]int32{}
 
+	var iterationsUsed [stats.Len]int32
 	var iterationsTotal int32
 	var iterationsDone int32
 	var simsTotal int32
@@ -91,9 +107,17 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 		simRequest := googleProto.Clone(baseSimRequest).(*proto.RaidSimRequest)
 		simRequest.Raid.Parties[0].Players[0].BonusStats[stat] += value
 		simRequest.SimOptions.Iterations /= 2 // Cut in half since we're doing above and below separately.
+		// With a precision target, each stat stops independently once its DPS
+		// difference from the baseline is precise enough, so noisy stats get more
+		// iterations.
+		simRequest.SimOptions.Precision = splitPrecisionTarget(simRequest.SimOptions.Precision, 2)
 
 		reporter := make(chan *proto.ProgressMetrics, 10)
-		go RunSim(*simRequest, reporter) // RunRaidSim(simRequest)
+		iterationDpsChan := make(chan []float64, 1)
+		go func() {
+			_, iterationDps := runSim(ctx, *simRequest, reporter, true, baselineIterationDps)
+			iterationDpsChan <- iterationDps
+		}()
 
 		var localIterations int32
 		var simResult *proto.RaidSimResult
@@ -123,10 +147,16 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 				}
 			}
 		}
-		dpsMetrics := simResult.RaidMetrics.Parties[0].Players[0].Dps
+		atomic.AddInt32(&iterationsUsed[stat], simResult.Iterations)
+
+		// DPS differences are paired by iteration. This is the raid DPS, which is
+		// the same as the player's in these single player sims.
+		dpsDiffs, numPaired := pairedDifference(baselineIterationDps, <-iterationDpsChan)
+		dpsMetrics := dpsDiffs.ToProto(numPaired)
+
 		tpsMetrics := simResult.RaidMetrics.Parties[0].Players[0].Dps
 		dtpsMetrics := simResult.RaidMetrics.Parties[0].Players[0].Dtps
-		dpsDiff := (dpsMetrics.Avg - baselineDpsMetrics.Avg) / value
+		dpsDiff := dpsMetrics.Avg / value
 		tpsDiff := (tpsMetrics.Avg - baselineTpsMetrics.Avg) / value
 		dtpsDiff := (dtpsMetrics.Avg - baselineDtpsMetrics.Avg) / value
 
@@ -175,7 +205,7 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 			continue
 		}
 		waitGroup.Add(2)
-		atomic.AddInt32(&iterationsTotal, swr.SimOptions.Iterations)
+		atomic.AddInt32(&iterationsTotal, maxSimIterations(*swr.SimOptions))
 		atomic.AddInt32(&simsTotal, 2)
 
 		go doStat(stats.Stat(stat), statModsLow[stat], true)
@@ -230,7 +260,9 @@ func CalcStatWeight(swr proto.StatWeightsRequest, statsToWeigh []stats.Stat, ref
 		}
 	}
 
-	result := StatWeightsResult{}
+	result := StatWeightsResult{
+		Iterations: iterationsUsed,
+	}
 	for statIdx, _ := range statModsLow {
 		stat := stats.Stat(statIdx)
 		if statModsLow[stat] == 0 || statModsHigh[stat] == 0 {
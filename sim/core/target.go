@@ -13,6 +13,17 @@ type Encounter struct {
 	DurationVariation  time.Duration
 	executePhaseBegins time.Duration
 	Targets            []*Target
+	Phases             []EncounterPhase
+	MovementWindows    []MovementWindow
+
+	// Whether each iteration lasts until all targets die, see encounter_health.go.
+	UseHealth bool
+
+	// Healing applied to each player every second, see player_health.go.
+	HealingPerSecond float64
+
+	// Whether players stop acting when they die, see player_health.go.
+	StopOnDeath bool
 }
 
 func NewEncounter(options proto.Encounter) Encounter {
@@ -21,6 +32,8 @@ func NewEncounter(options proto.Encounter) Encounter {
 		DurationVariation:  DurationFromSeconds(options.DurationVariation),
 		executePhaseBegins: DurationFromSeconds(options.Duration * (1 - options.ExecuteProportion)),
 		Targets:            []*Target{},
+		HealingPerSecond:   options.HealingPerSecond,
+		StopOnDeath:        options.StopOnDeath,
 	}
 
 	for targetIndex, targetOptions := range options.Targets {
@@ -33,6 +46,16 @@ func NewEncounter(options proto.Encounter) Encounter {
 		encounter.Targets = append(encounter.Targets, NewTarget(proto.Target{}, 0))
 	}
 
+	for _, phaseOptions := range options.Phases {
+		encounter.Phases = append(encounter.Phases, newEncounterPhase(phaseOptions, encounter.Targets))
+	}
+	encounter.MovementWindows = newMovementWindows(options.MovementWindows)
+
+	if options.UseHealth && encounter.hasTargetHealth() {
+		encounter.UseHealth = true
+		encounter.executePhaseBegins = NeverExpires
+	}
+
 	return encounter
 }
 
@@ -60,6 +83,9 @@ func (encounter *Encounter) GetMetricsProto(numIterations int32) *proto.Encounte
 // Target is an enemy/boss that can be the target of player attacks/spells.
 type Target struct {
 	Unit
+
+	// Scripted abilities, in priority order.
+	abilities []*Spell
 }
 
 func NewTarget(options proto.Target, targetIndex int32) *Target {
@@ -142,7 +168,9 @@ func (target *Target) init(sim *Simulation) {
 
 func (target *Target) Reset(sim *Simulation) {
 	target.Unit.reset(sim, nil)
-	//target.SetGCDTimer(sim, 0)
+	if len(target.abilities) > 0 {
+		target.SetGCDTimer(sim, 0)
+	}
 }
 
 func (target *Target) Advance(sim *Simulation, elapsedTime time.Duration) {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/core/stats"
 )
 
 type TargetAI struct {
@@ -12,11 +13,12 @@ type TargetAI struct {
 }
 
 func (target *Target) initialize(config *proto.Target) {
-	if config == nil || target.CurrentTarget == nil {
+	if config == nil {
 		return
 	}
 
-	if config.SwingSpeed > 0 {
+	// Auto attacks are only used against a tank.
+	if config.SwingSpeed > 0 && target.CurrentTarget != nil {
 		aaOptions := AutoAttackOptions{
 			MainHand: Weapon{
 				BaseDamageMin:  config.MinBaseDamage,
@@ -36,11 +38,155 @@ func (target *Target) initialize(config *proto.Target) {
 		target.EnableAutoAttacks(target, aaOptions)
 	}
 
-	//target.gcdAction = &PendingAction{
-	//	Priority: ActionPriorityGCD,
-	//	OnAction: func(sim *Simulation) {
-	//	},
-	//}
+	for _, abilityConfig := range config.Abilities {
+		// Single target abilities are used on the tank, and AoE abilities on
+		// the whole raid, so each needs someone to hit.
+		if abilityConfig.Aoe {
+			if len(target.raidPlayerUnits()) == 0 {
+				continue
+			}
+		} else if target.CurrentTarget == nil {
+			continue
+		}
+		target.abilities = append(target.abilities, target.registerAbility(abilityConfig))
+	}
+
+	if len(target.abilities) > 0 {
+		target.gcdAction = &PendingAction{
+			Priority: ActionPriorityGCD,
+			OnAction: func(sim *Simulation) {
+				if target.GCD.IsReady(sim) {
+					target.OnGCDReady(sim)
+				}
+			},
+		}
+	}
+}
+
+func (target *Target) registerAbility(config *proto.TargetAbility) *Spell {
+	actionID := ActionID{SpellID: config.Id}
+	spellSchool := SpellSchoolFromProto(config.SpellSchool)
+
+	baseEffect := SpellEffect{
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+		BaseDamage:       BaseDamageConfigRoll(config.MinBaseDamage, MaxFloat(config.MinBaseDamage, config.MaxBaseDamage)),
+	}
+	if spellSchool == SpellSchoolPhysical {
+		baseEffect.ProcMask = ProcMaskMeleeMHSpecial
+		baseEffect.OutcomeApplier = target.OutcomeFuncEnemyMeleeWhite()
+	} else {
+		baseEffect.ProcMask = ProcMaskSpellDamage
+		baseEffect.OutcomeApplier = target.OutcomeFuncMagicHit()
+	}
+
+	if config.Debuff != nil {
+		debuffAuras := make(map[*Unit]*Aura)
+		for _, unit := range target.raidPlayerUnits() {
+			debuffAuras[unit] = target.abilityDebuffAura(unit, actionID, config.Debuff)
+		}
+		baseEffect.OnSpellHitDealt = func(sim *Simulation, spell *Spell, spellEffect *SpellEffect) {
+			if spellEffect.Landed() {
+				debuffAuras[spellEffect.Target].Activate(sim)
+			}
+		}
+	}
+
+	var applyEffects ApplySpellEffects
+	if config.Aoe {
+		var effects []SpellEffect
+		for _, unit := range target.raidPlayerUnits() {
+			effect := baseEffect
+			effect.Target = unit
+			effects = append(effects, effect)
+		}
+		applyEffects = ApplyEffectFuncDamageMultiple(effects)
+	} else {
+		applyEffects = ApplyEffectFuncDirectDamage(baseEffect)
+	}
+
+	castConfig := CastConfig{
+		DefaultCast: Cast{
+			GCD:      GCDDefault,
+			CastTime: DurationFromSeconds(config.CastTime),
+		},
+		IgnoreHaste: true,
+	}
+	if config.Cooldown > 0 {
+		castConfig.CD = Cooldown{
+			Timer:    target.NewTimer(),
+			Duration: DurationFromSeconds(config.Cooldown),
+		}
+	}
+
+	return target.RegisterSpell(SpellConfig{
+		ActionID:     actionID,
+		SpellSchool:  spellSchool,
+		Cast:         castConfig,
+		ApplyEffects: applyEffects,
+	})
+}
+
+func (target *Target) abilityDebuffAura(unit *Unit, actionID ActionID, config *proto.TargetAbilityDebuff) *Aura {
+	multiplier := config.DamageTakenMultiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	debuffStats := stats.Stats{}
+	copy(debuffStats[:], config.Stats)
+
+	return unit.RegisterAura(Aura{
+		Label:    target.Label + " " + actionID.String(),
+		ActionID: actionID,
+		Duration: DurationFromSeconds(config.Duration),
+		OnGain: func(aura *Aura, sim *Simulation) {
+			aura.Unit.PseudoStats.DamageTakenMultiplier *= multiplier
+			aura.Unit.AddStatsDynamic(sim, debuffStats)
+		},
+		OnExpire: func(aura *Aura, sim *Simulation) {
+			aura.Unit.PseudoStats.DamageTakenMultiplier /= multiplier
+			aura.Unit.AddStatsDynamic(sim, debuffStats.Multiply(-1))
+		},
+	})
+}
+
+// Uses the first ability which is off cooldown, in the order they were
+// configured. If none are ready, waits until the next one comes off cooldown.
+func (target *Target) OnGCDReady(sim *Simulation) {
+	nextReadyAt := NeverExpires
+	for _, ability := range target.abilities {
+		if ability.IsReady(sim) {
+			if ability.DefaultCast.CastTime > 0 && target.AutoAttacks.IsEnabled() {
+				// Bosses stop swinging while casting.
+				target.AutoAttacks.DelayAllUntil(sim, sim.CurrentTime+ability.DefaultCast.CastTime)
+			}
+			ability.Cast(sim, target.abilityCastTarget())
+			return
+		}
+		nextReadyAt = MinDuration(nextReadyAt, ability.ReadyAt())
+	}
+	target.SetGCDTimer(sim, nextReadyAt)
+}
+
+// Returns the units in the raid which target abilities can hit, i.e. all
+// players but not pets.
+func (target *Target) raidPlayerUnits() []*Unit {
+	var units []*Unit
+	for _, unit := range target.Env.Raid.AllUnits {
+		if unit.Type == PlayerUnit {
+			units = append(units, unit)
+		}
+	}
+	return units
+}
+
+// Abilities are cast on the tank. Without one only AoE abilities are used,
+// which hit the whole raid, so they are cast on the first player.
+func (target *Target) abilityCastTarget() *Unit {
+	if target.CurrentTarget != nil {
+		return target.CurrentTarget
+	}
+	return target.raidPlayerUnits()[0]
 }
 
 // Empty Agent interface functions.
@@ -50,7 +196,6 @@ func (target *Target) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {}
 func (target *Target) ApplyTalents()                              {}
 func (target *Target) GetCharacter() *Character                   { return nil }
 func (target *Target) Initialize()                                {}
-func (target *Target) OnGCDReady(*Simulation)                     {}
 func (target *Target) OnAutoAttack(sim *Simulation, spell *Spell) {}
 
 type PresetTarget struct {
@@ -96,6 +241,10 @@ func GetPresetTargetWithPath(path string) *PresetTarget {
 }
 
 func AddPresetEncounter(name string, targetPaths []string) {
+	AddPresetEncounterWithPhases(name, targetPaths, nil)
+}
+
+func AddPresetEncounterWithPhases(name string, targetPaths []string, phases []*proto.EncounterPhase) {
 	if len(targetPaths) == 0 {
 		log.Fatalf("Encounter must have targets!")
 	}
@@ -124,5 +273,6 @@ func AddPresetEncounter(name string, targetPaths []string) {
 	presetEncounters = append(presetEncounters, &proto.PresetEncounter{
 		Path:    path,
 		Targets: targetProtos,
+		Phases:  phases,
 	})
 }
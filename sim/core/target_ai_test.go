@@ -0,0 +1,106 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	protectionWarrior "github.com/wowsims/tbc/sim/warrior/protection"
+)
+
+func TestTargetAbilityDamageTaken(t *testing.T) {
+	runSim := func(target *proto.Target) *proto.RaidSimResult {
+		raid := core.SinglePlayerRaidProto(
+			&proto.Player{
+				Race:      proto.Race_RaceOrc,
+				Class:     proto.Class_ClassWarrior,
+				Equipment: protectionWarrior.P1Gear,
+				Consumes:  protectionWarrior.FullConsumes,
+				Spec:      protectionWarrior.PlayerOptionsBasic,
+				Buffs:     protectionWarrior.FullIndividualBuffs,
+
+				InFrontOfTarget: true,
+			},
+			protectionWarrior.FullPartyBuffs,
+			protectionWarrior.FullRaidBuffs,
+			protectionWarrior.FullDebuffs)
+		raid.Tanks = append(raid.Tanks, &proto.RaidTarget{TargetIndex: 0})
+
+		encounter := &proto.Encounter{
+			Duration: 60,
+			Targets:  []*proto.Target{target},
+		}
+		return runTestSim(raid, encounter, nil)
+	}
+
+	baseResult := runSim(core.NewDefaultTarget())
+
+	abilityTarget := core.NewDefaultTarget()
+	abilityTarget.Abilities = []*proto.TargetAbility{
+		{
+			Id:            40599,
+			SpellSchool:   proto.SpellSchool_SpellSchoolFire,
+			MinBaseDamage: 3000,
+			MaxBaseDamage: 4000,
+			CastTime:      1,
+			Cooldown:      10,
+			Debuff: &proto.TargetAbilityDebuff{
+				Duration:              5,
+				DamageTakenMultiplier: 1.5,
+			},
+		},
+	}
+	abilityResult := runSim(abilityTarget)
+
+	var abilityMetrics *proto.ActionMetrics
+	for _, action := range abilityResult.EncounterMetrics.Targets[0].Actions {
+		if action.Id.GetSpellId() == 40599 {
+			abilityMetrics = action
+		}
+	}
+	if abilityMetrics == nil || abilityMetrics.Targets[0].Casts == 0 {
+		t.Fatalf("Expected target ability to be cast")
+	}
+
+	baseDtps := baseResult.RaidMetrics.Parties[0].Players[0].Dtps.Avg
+	abilityDtps := abilityResult.RaidMetrics.Parties[0].Players[0].Dtps.Avg
+	if abilityDtps <= baseDtps {
+		t.Fatalf("Expected target ability to increase dtps, but was %0.3f with ability vs %0.3f without", abilityDtps, baseDtps)
+	}
+}
+
+// AoE abilities don't need a tank, but auto attacks and single target
+// abilities do.
+func TestTargetAbilitiesWithoutTank(t *testing.T) {
+	target := core.NewDefaultTarget()
+	target.Abilities = []*proto.TargetAbility{
+		{
+			Id:            40599,
+			SpellSchool:   proto.SpellSchool_SpellSchoolFire,
+			MinBaseDamage: 1000,
+			Cooldown:      10,
+			Aoe:           true,
+			Debuff:        &proto.TargetAbilityDebuff{Duration: 5, DamageTakenMultiplier: 1.5},
+		},
+		{
+			Id:            41001,
+			MinBaseDamage: 1000,
+			Cooldown:      10,
+		},
+	}
+	encounter := &proto.Encounter{
+		Duration: 60,
+		Targets:  []*proto.Target{target},
+	}
+
+	result := runTestSim(testRaid, encounter, nil)
+	casts := castsBySpell(result.EncounterMetrics.Targets[0])
+	if casts[40599] == 0 || casts[41001] != 0 || len(casts) != 1 {
+		t.Fatalf("Expected only the AoE ability to be cast, but got %v", casts)
+	}
+	for _, player := range result.RaidMetrics.Parties[0].Players {
+		if player.Dtps.Avg == 0 {
+			t.Fatalf("Expected %s to take damage from the AoE ability", player.Name)
+		}
+	}
+}
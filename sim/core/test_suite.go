@@ -59,6 +59,7 @@ func (testSuite *IndividualTestSuite) TestDPS(testName string, rsr *proto.RaidSi
 		Dps:  result.RaidMetrics.Dps.Avg,
 		Tps:  result.RaidMetrics.Parties[0].Players[0].Threat.Avg,
 		Dtps: result.RaidMetrics.Parties[0].Players[0].Dtps.Avg,
+		Hps:  result.RaidMetrics.Parties[0].Players[0].Hps.Avg,
 	}
 }
 
@@ -69,20 +70,28 @@ func (testSuite *IndividualTestSuite) Done(t *testing.T) {
 const tolerance = 0.00001
 
 func (testSuite *IndividualTestSuite) writeToFile() {
-	str := prototext.Format(&testSuite.testResults)
+	writeTestSuiteResults(testSuite.Name, &testSuite.testResults)
+}
+
+func (testSuite *IndividualTestSuite) readExpectedResults() proto.TestSuiteResult {
+	return readTestSuiteResults(testSuite.Name)
+}
+
+func writeTestSuiteResults(suiteName string, results *proto.TestSuiteResult) {
+	str := prototext.Format(results)
 	// For some reason the formatter sometimes outputs 2 spaces instead of one.
 	// Replace so we get consistent output.
 	str = strings.ReplaceAll(str, "  ", " ")
 	data := []byte(str)
 
-	err := os.WriteFile(testSuite.Name+".results.tmp", data, 0644)
+	err := os.WriteFile(suiteName+".results.tmp", data, 0644)
 	if err != nil {
 		panic(err)
 	}
 }
 
-func (testSuite *IndividualTestSuite) readExpectedResults() proto.TestSuiteResult {
-	data, err := os.ReadFile(testSuite.Name + ".results")
+func readTestSuiteResults(suiteName string) proto.TestSuiteResult {
+	data, err := os.ReadFile(suiteName + ".results")
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return newTestSuiteResult()
@@ -103,6 +112,7 @@ func newTestSuiteResult() proto.TestSuiteResult {
 		CharacterStatsResults: make(map[string]*proto.CharacterStatsTestResult),
 		StatWeightsResults:    make(map[string]*proto.StatWeightsTestResult),
 		DpsResults:            make(map[string]*proto.DpsTestResult),
+		RaidResults:           make(map[string]*proto.RaidTestResult),
 	}
 }
 
@@ -188,6 +198,10 @@ func RunTestSuite(t *testing.T, suiteName string, generator TestGenerator) {
 							t.Logf("DTPS expected %0.03f but was %0.03f!.", expectedDpsResult.Dtps, actualDpsResult.Dtps)
 							t.Fail()
 						}
+						if actualDpsResult.Hps < expectedDpsResult.Hps-tolerance || actualDpsResult.Hps > expectedDpsResult.Hps+tolerance {
+							t.Logf("HPS expected %0.03f but was %0.03f!.", expectedDpsResult.Hps, actualDpsResult.Hps)
+							t.Fail()
+						}
 					} else {
 						t.Logf("Unexpected test %s with %0.03f DPS!", fullTestName, actualDpsResult.Dps)
 						t.Fail()
@@ -208,3 +222,133 @@ func RunTestSuite(t *testing.T, suiteName string, generator TestGenerator) {
 		t.Log("One or more tests failed! If the changes are intentional, update the expected results with 'make test && make update-tests'. Otherwise go fix your bugs!")
 	}
 }
+
+// Snapshots the results of whole-raid sims, so that changes to interactions
+// between players (party buffs, totems, targeted cooldowns, etc) are caught.
+type RaidTestSuite struct {
+	Name string
+
+	expectedResults proto.TestSuiteResult
+	testResults     proto.TestSuiteResult
+}
+
+func NewRaidTestSuite(suiteName string) *RaidTestSuite {
+	return &RaidTestSuite{
+		Name:            suiteName,
+		expectedResults: readTestSuiteResults(suiteName),
+		testResults:     newTestSuiteResult(),
+	}
+}
+
+// Runs a raid sim and compares each player's and pet's results with the
+// expected results.
+func (testSuite *RaidTestSuite) TestRaid(t *testing.T, testName string, rsr *proto.RaidSimRequest) {
+	fullTestName := testSuite.Name + "-" + testName
+	t.Run(testName, func(t *testing.T) {
+		actual := getRaidTestResult(RunRaidSim(rsr))
+		testSuite.testResults.RaidResults[fullTestName] = actual
+
+		expected, ok := testSuite.expectedResults.RaidResults[fullTestName]
+		if !ok {
+			t.Logf("Unexpected test %s with %d units", fullTestName, len(actual.Units))
+			t.Fail()
+			return
+		}
+		compareRaidTestResults(t, expected, actual)
+	})
+}
+
+func (testSuite *RaidTestSuite) Done(t *testing.T) {
+	writeTestSuiteResults(testSuite.Name, &testSuite.testResults)
+
+	if t.Failed() {
+		t.Log("One or more tests failed! If the changes are intentional, update the expected results with 'make test && make update-tests'. Otherwise go fix your bugs!")
+	}
+}
+
+func getRaidTestResult(result *proto.RaidSimResult) *proto.RaidTestResult {
+	raidResult := &proto.RaidTestResult{
+		Units: make(map[string]*proto.RaidUnitTestResult),
+	}
+	for partyIdx, party := range result.RaidMetrics.Parties {
+		for playerIdx, player := range party.Players {
+			if player.Dps == nil {
+				continue
+			}
+			label := fmt.Sprintf("Party %d Player %d (%s)", partyIdx+1, playerIdx+1, player.Name)
+			raidResult.Units[label] = getRaidUnitTestResult(player)
+			for _, pet := range player.Pets {
+				raidResult.Units[label+" Pet ("+pet.Name+")"] = getRaidUnitTestResult(pet)
+			}
+		}
+	}
+	return raidResult
+}
+
+func getRaidUnitTestResult(unit *proto.UnitMetrics) *proto.RaidUnitTestResult {
+	unitResult := &proto.RaidUnitTestResult{
+		Dps:          unit.Dps.Avg,
+		Tps:          unit.Threat.Avg,
+		Dtps:         unit.Dtps.Avg,
+		AuraUptimes:  make(map[string]float64),
+		ActionDamage: make(map[string]float64),
+	}
+	for _, aura := range unit.Auras {
+		unitResult.AuraUptimes[ProtoToActionID(*aura.Id).String()] = aura.UptimeSecondsAvg
+	}
+	for _, action := range unit.Actions {
+		key := ProtoToActionID(*action.Id).String()
+		for _, target := range action.Targets {
+			unitResult.ActionDamage[key] += target.Damage
+		}
+	}
+	return unitResult
+}
+
+func compareRaidTestResults(t *testing.T, expected *proto.RaidTestResult, actual *proto.RaidTestResult) {
+	for label := range expected.Units {
+		if _, ok := actual.Units[label]; !ok {
+			t.Logf("Missing results for %s", label)
+			t.Fail()
+		}
+	}
+	for label, actualUnit := range actual.Units {
+		expectedUnit, ok := expected.Units[label]
+		if !ok {
+			t.Logf("Unexpected results for %s", label)
+			t.Fail()
+			continue
+		}
+
+		compareValue(t, label+" DPS", expectedUnit.Dps, actualUnit.Dps)
+		compareValue(t, label+" TPS", expectedUnit.Tps, actualUnit.Tps)
+		compareValue(t, label+" DTPS", expectedUnit.Dtps, actualUnit.Dtps)
+		compareValueMaps(t, label+" uptime of aura", expectedUnit.AuraUptimes, actualUnit.AuraUptimes)
+		compareValueMaps(t, label+" damage of action", expectedUnit.ActionDamage, actualUnit.ActionDamage)
+	}
+}
+
+func compareValue(t *testing.T, label string, expected float64, actual float64) {
+	if actual < expected-tolerance || actual > expected+tolerance {
+		t.Logf("%s expected %0.03f but was %0.03f!", label, expected, actual)
+		t.Fail()
+	}
+}
+
+func compareValueMaps(t *testing.T, label string, expected map[string]float64, actual map[string]float64) {
+	for key, expectedValue := range expected {
+		actualValue, ok := actual[key]
+		if !ok {
+			t.Logf("%s %s expected %0.03f but was missing!", label, key, expectedValue)
+			t.Fail()
+			continue
+		}
+		compareValue(t, label+" "+key, expectedValue, actualValue)
+	}
+	for key, actualValue := range actual {
+		if _, ok := expected[key]; !ok {
+			t.Logf("%s %s was unexpected, with %0.03f!", label, key, actualValue)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+// Records typed events for selected iterations, as a structured alternative
+// to the debug logs.
+type timeline struct {
+	// Iterations which should be recorded.
+	iterations map[int32]bool
+
+	units map[*Unit]*proto.UnitReference
+
+	// Timeline for the current iteration, or nil if it isn't being recorded.
+	current *proto.IterationTimeline
+
+	finished []*proto.IterationTimeline
+}
+
+func newTimeline(options *proto.TimelineOptions) *timeline {
+	if options == nil {
+		return nil
+	}
+
+	tl := &timeline{
+		iterations: make(map[int32]bool),
+		units:      make(map[*Unit]*proto.UnitReference),
+	}
+	if len(options.Iterations) == 0 {
+		tl.iterations[0] = true
+	}
+	for _, iteration := range options.Iterations {
+		tl.iterations[iteration] = true
+	}
+	return tl
+}
+
+func (tl *timeline) init(sim *Simulation) {
+	for _, party := range sim.Raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			tl.units[&character.Unit] = &proto.UnitReference{
+				Type:  proto.UnitReference_Player,
+				Index: character.Index,
+			}
+			for petIdx, petAgent := range character.Pets {
+				tl.units[&petAgent.GetCharacter().Unit] = &proto.UnitReference{
+					Type:     proto.UnitReference_Pet,
+					Index:    character.Index,
+					PetIndex: int32(petIdx),
+				}
+			}
+		}
+	}
+	for _, target := range sim.Encounter.Targets {
+		tl.units[&target.Unit] = &proto.UnitReference{
+			Type:  proto.UnitReference_Target,
+			Index: target.Index,
+		}
+	}
+}
+
+// Records the current iteration, if it was selected.
+func (tl *timeline) runIteration(sim *Simulation, iteration int32) {
+	if !tl.iterations[iteration] {
+		sim.runOnce()
+		return
+	}
+
+	tl.current = &proto.IterationTimeline{
+		Iteration: iteration,
+	}
+	sim.runOnce()
+	tl.current.Duration = sim.Duration.Seconds()
+	tl.finished = append(tl.finished, tl.current)
+	tl.current = nil
+}
+
+// Returns true if events for the current iteration should be recorded.
+func (sim *Simulation) RecordingTimeline() bool {
+	return sim.timeline != nil && sim.timeline.current != nil
+}
+
+// Adds an event to the current timeline and returns it, so callers can fill
+// in event-specific fields. Should only be called if RecordingTimeline() is true.
+func (sim *Simulation) AddTimelineEvent(eventType proto.TimelineEventType, unit *Unit, actionID ActionID) *proto.TimelineEvent {
+	event := &proto.TimelineEvent{
+		Timestamp: sim.CurrentTime.Seconds(),
+		Type:      eventType,
+		Unit:      sim.timeline.units[unit],
+		ActionId:  actionID.ToProto(),
+	}
+	sim.timeline.current.Events = append(sim.timeline.current.Events, event)
+	return event
+}
+
+func (sim *Simulation) addCastTimelineEvent(eventType proto.TimelineEventType, spell *Spell, target *Unit) *proto.TimelineEvent {
+	event := sim.AddTimelineEvent(eventType, spell.Unit, spell.ActionID)
+	event.Target = sim.timeline.units[target]
+	return event
+}
+
+func (sim *Simulation) addResourceTimelineEvent(unit *Unit, actionID ActionID, resourceType proto.ResourceType, oldValue float64, newValue float64) {
+	event := sim.AddTimelineEvent(proto.TimelineEventType_TimelineEventResourceChanged, unit, actionID)
+	event.ResourceType = resourceType
+	event.Amount = newValue - oldValue
+	event.ResourceValue = newValue
+}
@@ -0,0 +1,51 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func TestTimeline(t *testing.T) {
+	result := runTestSim(singlePlayerRaid(testElementalShaman), testEncounter, &proto.SimOptions{
+		Iterations: 3,
+		IsTest:     true,
+		Timeline: &proto.TimelineOptions{
+			Iterations: []int32{0, 2},
+		},
+	})
+
+	if len(result.Timelines) != 2 || result.Timelines[0].Iteration != 0 || result.Timelines[1].Iteration != 2 {
+		t.Fatalf("Expected timelines for iterations 0 and 2, but got %d timelines", len(result.Timelines))
+	}
+
+	eventCounts := make(map[proto.TimelineEventType]int)
+	lastTimestamp := 0.0
+	for _, event := range result.Timelines[0].Events {
+		eventCounts[event.Type]++
+		if event.Unit == nil {
+			t.Fatalf("Missing unit for %s event", event.Type)
+		}
+		if event.Timestamp < lastTimestamp {
+			t.Fatalf("Events out of order: %0.2f after %0.2f", event.Timestamp, lastTimestamp)
+		}
+		lastTimestamp = event.Timestamp
+
+		if event.Type == proto.TimelineEventType_TimelineEventDamage && event.Target.Type != proto.UnitReference_Target {
+			t.Fatalf("Expected damage to be dealt to a target")
+		}
+	}
+
+	for _, eventType := range []proto.TimelineEventType{
+		proto.TimelineEventType_TimelineEventCastStart,
+		proto.TimelineEventType_TimelineEventCastComplete,
+		proto.TimelineEventType_TimelineEventDamage,
+		proto.TimelineEventType_TimelineEventAuraGained,
+		proto.TimelineEventType_TimelineEventResourceChanged,
+		proto.TimelineEventType_TimelineEventMajorCooldownUsed,
+	} {
+		if eventCounts[eventType] == 0 {
+			t.Fatalf("Expected at least one %s event", eventType)
+		}
+	}
+}
@@ -89,6 +89,11 @@ type Unit struct {
 	gcdAction      *PendingAction
 	hardcastAction *PendingAction
 
+	// If set, overrides the sim-wide latency. See latency.go.
+	latency *Latency
+	// Added on top of the player or sim-wide latency.
+	extraLatency Latency
+
 	// Fields related to waiting for certain events to happen.
 	waitingForMana float64
 	waitStartTime  time.Duration
@@ -100,6 +105,19 @@ type Unit struct {
 	CastSpeed float64
 
 	CurrentTarget *Unit
+
+	// Total damage taken during the current iteration.
+	damageTaken float64
+
+	// Whether this unit has died and stopped acting, see player_health.go.
+	dead bool
+
+	// Time up to which losses to movement have been counted, see movement.go.
+	movementLossUntil time.Duration
+	// Set when a cast is blocked by movement, until the rotation has reacted.
+	blockedByMovement bool
+	// The channel in progress, so it can be cancelled by movement.
+	channel channel
 }
 
 func (unit *Unit) Log(sim *Simulation, message string, vals ...interface{}) {
@@ -333,6 +351,8 @@ func (unit *Unit) init(sim *Simulation) {
 
 func (unit *Unit) reset(sim *Simulation, agent Agent) {
 	unit.Metrics.reset()
+	unit.damageTaken = 0
+	unit.dead = false
 	unit.stats = unit.initialStats
 	unit.PseudoStats = unit.initialPseudoStats
 	unit.auraTracker.reset(sim)
@@ -358,6 +378,16 @@ func (unit *Unit) advance(sim *Simulation, elapsedTime time.Duration) {
 	}
 }
 
+// Returns the proportion of this unit's health remaining, between 0 and 1.
+// Units without a health value are always at full health.
+func (unit *Unit) CurrentHealthPercent() float64 {
+	maxHealth := unit.GetStat(stats.Health)
+	if maxHealth <= 0 {
+		return 1
+	}
+	return MaxFloat(0, 1-unit.damageTaken/maxHealth)
+}
+
 func (unit *Unit) doneIteration(sim *Simulation) {
 	unit.Hardcast = Hardcast{}
 	unit.doneIterationGCD(sim.Duration)
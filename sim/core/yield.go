@@ -0,0 +1,11 @@
+//go:build !wasm
+// +build !wasm
+
+package core
+
+import "runtime"
+
+// Gives other goroutines, e.g. progress reporters, a chance to run.
+func yieldToHost() {
+	runtime.Gosched()
+}
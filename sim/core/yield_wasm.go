@@ -0,0 +1,12 @@
+//go:build wasm
+// +build wasm
+
+package core
+
+import "time"
+
+// In wasm the JS event loop only runs once every goroutine is blocked, so sleep
+// briefly to let progress callbacks and cancellation messages through.
+func yieldToHost() {
+	time.Sleep(time.Millisecond)
+}
@@ -18,22 +18,11 @@ func (druid *Druid) registerDemoralizingRoarSpell() {
 	}
 
 	numHits := druid.Env.GetNumTargets()
-	effects := make([]core.SpellEffect, 0, numHits)
+	demoRoarAuras := make([]*core.Aura, numHits)
 	for i := int32(0); i < numHits; i++ {
-		effects = append(effects, baseEffect)
-		effects[i].Target = druid.Env.GetTargetUnit(i)
-
-		demoRoarAura := core.DemoralizingRoarAura(effects[i].Target, druid.Talents.FeralAggression)
-		if i == 0 {
-			druid.DemoralizingRoarAura = demoRoarAura
-		}
-
-		effects[i].OnSpellHitDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
-			if spellEffect.Landed() {
-				demoRoarAura.Activate(sim)
-			}
-		}
+		demoRoarAuras[i] = core.DemoralizingRoarAura(druid.Env.GetTargetUnit(i), druid.Talents.FeralAggression)
 	}
+	druid.DemoralizingRoarAura = demoRoarAuras[0]
 
 	druid.DemoralizingRoar = druid.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 26998},
@@ -50,7 +39,21 @@ func (druid *Druid) registerDemoralizingRoarSpell() {
 			IgnoreHaste: true,
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(druid.Env, numHits, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, len(targets))
+			for i, target := range targets {
+				effects[i] = baseEffect
+				effects[i].Target = target
+
+				demoRoarAura := demoRoarAuras[target.Index]
+				effects[i].OnSpellHitDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
+					if spellEffect.Landed() {
+						demoRoarAura.Activate(sim)
+					}
+				}
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
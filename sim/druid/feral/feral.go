@@ -41,6 +41,12 @@ func NewFeralDruid(character core.Character, options proto.Player) *FeralDruid {
 		Rotation: *feralOptions.Rotation,
 	}
 
+	if feralOptions.Options.LatencyMs > 0 {
+		cat.AddLatency(core.Latency{
+			Base: time.Millisecond * time.Duration(feralOptions.Options.LatencyMs),
+		})
+	}
+
 	// Passive Cat Form threat reduction
 	cat.PseudoStats.ThreatMultiplier *= 0.71
 
@@ -48,6 +54,9 @@ func NewFeralDruid(character core.Character, options proto.Player) *FeralDruid {
 	cat.HasMHWeaponImbue = true
 
 	cat.EnableEnergyBar(100.0, func(sim *core.Simulation) {
+		if cat.RotationOverridden() {
+			return
+		}
 		cat.TryUseCooldowns(sim)
 		if cat.GCD.IsReady(sim) {
 			cat.doRotation(sim)
@@ -29,14 +29,6 @@ func (druid *Druid) registerSwipeSpell() {
 		OutcomeApplier: druid.OutcomeFuncMeleeSpecialHitAndCrit(druid.MeleeCritMultiplier()),
 	}
 
-	numHits := core.MinInt32(3, druid.Env.GetNumTargets())
-	effects := make([]core.SpellEffect, 0, numHits)
-	for i := int32(0); i < numHits; i++ {
-		effect := baseEffect
-		effect.Target = druid.Env.GetTargetUnit(i)
-		effects = append(effects, effect)
-	}
-
 	druid.Swipe = druid.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 26997},
 		SpellSchool: core.SpellSchoolPhysical,
@@ -53,7 +45,14 @@ func (druid *Druid) registerSwipeSpell() {
 			IgnoreHaste: true,
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(druid.Env, 3, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, len(targets))
+			for i, target := range targets {
+				effects[i] = baseEffect
+				effects[i].Target = target
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
@@ -43,6 +43,9 @@ func NewFeralTankDruid(character core.Character, options proto.Player) *FeralTan
 	}
 
 	bear.EnableRageBar(bear.Options.StartingRage, 1, func(sim *core.Simulation) {
+		if bear.RotationOverridden() {
+			return
+		}
 		if bear.GCD.IsReady(sim) {
 			bear.TryUseCooldowns(sim)
 			if bear.GCD.IsReady(sim) {
@@ -216,10 +216,27 @@ func registerBlackTemple() {
 			DualWieldPenalty: false,
 		},
 	})
-	core.AddPresetEncounter("Reliquary of Souls", []string{
+	core.AddPresetEncounterWithPhases("Reliquary of Souls", []string{
 		bossPrefix + "/Essence of Suffering",
 		bossPrefix + "/Essence of Desire",
 		bossPrefix + "/Essence of Anger",
+	}, []*proto.EncounterPhase{
+		{
+			Name:          "Essence of Suffering",
+			ActiveTargets: []int32{0},
+		},
+		{
+			Name:             "Essence of Desire",
+			HealthThreshold:  0.01,
+			ActiveTargets:    []int32{1},
+			DowntimeDuration: 30, // Enslaved Souls.
+		},
+		{
+			Name:             "Essence of Anger",
+			HealthThreshold:  0.01,
+			ActiveTargets:    []int32{2},
+			DowntimeDuration: 30, // Enslaved Souls.
+		},
 	})
 
 	AddSingleTargetBossEncounter(core.PresetTarget{
@@ -360,7 +377,7 @@ func registerBlackTemple() {
 		bossPrefix + "/High Nethermancer Zerevor",
 	})
 
-	AddSingleTargetBossEncounter(core.PresetTarget{
+	core.AddPresetTarget(core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: proto.Target{
 			Id:        22917,
@@ -411,4 +428,32 @@ func registerBlackTemple() {
 			DualWieldPenalty: false,
 		},
 	})
+	core.AddPresetEncounterWithPhases("Illidan Stormrage", []string{
+		bossPrefix + "/Illidan Stormrage",
+		bossPrefix + "/Flame of Azzinoth",
+		bossPrefix + "/Flame of Azzinoth",
+	}, []*proto.EncounterPhase{
+		{
+			Name:          "Phase 1",
+			ActiveTargets: []int32{0},
+		},
+		{
+			Name:             "Flames of Azzinoth",
+			HealthThreshold:  0.65,
+			ActiveTargets:    []int32{1, 2},
+			DowntimeDuration: 10, // Illidan takes flight and throws his glaives.
+		},
+		{
+			Name:            "Phase 3",
+			StartTime:       90,
+			HealthThreshold: 0.01,
+			ActiveTargets:   []int32{0},
+		},
+		{
+			Name:             "Phase 4",
+			HealthThreshold:  0.3,
+			ActiveTargets:    []int32{0},
+			DowntimeDuration: 10, // Maiev's arrival.
+		},
+	})
 }
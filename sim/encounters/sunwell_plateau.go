@@ -174,7 +174,7 @@ func registerSunwellPlateau() {
 		},
 	})
 
-	AddSingleTargetBossEncounter(core.PresetTarget{
+	core.AddPresetTarget(core.PresetTarget{
 		PathPrefix: bossPrefix,
 		Config: proto.Target{
 			Id:        25315,
@@ -200,4 +200,23 @@ func registerSunwellPlateau() {
 			DualWieldPenalty: false,
 		},
 	})
+	core.AddPresetEncounterWithPhases("Kil'jaeden", []string{
+		bossPrefix + "/Kil'jaeden",
+	}, []*proto.EncounterPhase{
+		{
+			Name: "Phase 2",
+		},
+		{
+			Name:            "Phase 3",
+			HealthThreshold: 0.85,
+		},
+		{
+			Name:            "Phase 4",
+			HealthThreshold: 0.55,
+		},
+		{
+			Name:            "Phase 5",
+			HealthThreshold: 0.25,
+		},
+	})
 }
@@ -114,6 +114,13 @@ func (hunter *Hunter) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
 	}
 }
 
+func (hunter *Hunter) ClearDebuffApproximations(debuffs *proto.Debuffs) {
+	if hunter.Talents.ExposeWeakness > 0 {
+		debuffs.ExposeWeaknessUptime = 0
+		debuffs.ExposeWeaknessHunterAgility = 0
+	}
+}
+
 func (hunter *Hunter) Initialize() {
 	// Update auto crit multipliers now that we have the targets.
 	hunter.AutoAttacks.MHEffect.OutcomeApplier = hunter.OutcomeFuncMeleeWhite(hunter.critMultiplier(false, hunter.CurrentTarget))
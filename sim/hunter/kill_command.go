@@ -90,7 +90,7 @@ func (hp *HunterPet) registerKillCommandSpell() {
 }
 
 func (hunter *Hunter) TryKillCommand(sim *core.Simulation, target *core.Unit) {
-	if hunter.pet == nil || !hunter.pet.IsEnabled() {
+	if hunter.RotationOverridden() || hunter.pet == nil || !hunter.pet.IsEnabled() {
 		return
 	}
 
@@ -34,13 +34,6 @@ func (hunter *Hunter) registerMultiShotSpell() {
 		},
 	}
 
-	numHits := core.MinInt32(3, hunter.Env.GetNumTargets())
-	effects := make([]core.SpellEffect, 0, numHits)
-	for i := int32(0); i < numHits; i++ {
-		effects = append(effects, baseEffect)
-		effects[i].Target = hunter.Env.GetTargetUnit(i)
-	}
-
 	hunter.MultiShot = hunter.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 27021},
 		SpellSchool: core.SpellSchoolPhysical,
@@ -68,7 +61,14 @@ func (hunter *Hunter) registerMultiShotSpell() {
 			},
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(hunter.Env, 3, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, len(targets))
+			for i, target := range targets {
+				effects[i] = baseEffect
+				effects[i].Target = target
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
@@ -31,6 +31,10 @@ func (hunter *Hunter) OnManaTick(sim *core.Simulation) {
 		hunter.AddMana(sim, manaGain, hunter.AspectOfTheViper.ActionID, false)
 	}
 
+	if hunter.RotationOverridden() {
+		return
+	}
+
 	if hunter.IsWaitingForMana() && hunter.DoneWaitingForMana(sim) {
 		hunter.TryKillCommand(sim, hunter.CurrentTarget)
 		if hunter.nextAction == OptionNone && hunter.Hardcast.Expires <= sim.CurrentTime {
@@ -40,6 +44,9 @@ func (hunter *Hunter) OnManaTick(sim *core.Simulation) {
 }
 
 func (hunter *Hunter) OnAutoAttack(sim *core.Simulation, spell *core.Spell) {
+	if hunter.RotationOverridden() {
+		return
+	}
 	hunter.TryKillCommand(sim, hunter.CurrentTarget)
 	if spell == hunter.AutoAttacks.RangedAuto {
 		hunter.TryUseCooldowns(sim)
@@ -66,12 +73,20 @@ func (hunter *Hunter) OnGCDReady(sim *core.Simulation) {
 }
 
 func (hunter *Hunter) rotation(sim *core.Simulation, followsRangedAuto bool) {
+	if hunter.RotationOverridden() {
+		return
+	}
+
 	if hunter.nextAction == OptionNone {
 		if hunter.Rotation.LazyRotation {
 			hunter.lazyRotation(sim, followsRangedAuto)
 		} else {
 			hunter.adaptiveRotation(sim, followsRangedAuto)
 		}
+		if hunter.nextActionAt > sim.CurrentTime {
+			// The player reacts to the end of the wait for the next action.
+			hunter.nextActionAt += hunter.ReactionTime(sim)
+		}
 	}
 
 	if hunter.nextActionAt <= sim.CurrentTime {
@@ -290,7 +305,7 @@ func (hunter *Hunter) doOption(sim *core.Simulation, option int) {
 			if success {
 				// Can't use kill command while casting steady shot.
 				hunter.killCommandBlocked = true
-			} else {
+			} else if !hunter.tryArcaneWhileMoving(sim) {
 				hunter.WaitForMana(sim, hunter.SteadyShot.CurCast.Cost)
 			}
 		}
@@ -298,7 +313,7 @@ func (hunter *Hunter) doOption(sim *core.Simulation, option int) {
 		if !hunter.tryUsePrioGCD(sim) {
 			success := hunter.MultiShot.Cast(sim, target)
 			if success {
-			} else {
+			} else if !hunter.tryArcaneWhileMoving(sim) {
 				hunter.WaitForMana(sim, hunter.MultiShot.CurCast.Cost)
 			}
 		}
@@ -315,6 +330,15 @@ func (hunter *Hunter) doOption(sim *core.Simulation, option int) {
 	}
 }
 
+// Steady Shot and Multi-Shot can't be cast while moving, but Arcane Shot can.
+// Returns true if Arcane Shot was cast.
+func (hunter *Hunter) tryArcaneWhileMoving(sim *core.Simulation) bool {
+	if !sim.IsMoving() || !hunter.Rotation.UseArcaneShot || !hunter.ArcaneShot.IsReady(sim) {
+		return false
+	}
+	return hunter.ArcaneShot.Cast(sim, hunter.CurrentTarget)
+}
+
 // Decides whether to use an instant-cast GCD spell.
 // Returns true if any of these spells was selected.
 func (hunter *Hunter) tryUsePrioGCD(sim *core.Simulation) bool {
@@ -0,0 +1,48 @@
+package paladin
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+// Guarantees a crit on the next Holy Light or Flash of Light. The aura is
+// consumed by the heal itself, see newHealingSpellEffect.
+func (paladin *Paladin) RegisterDivineFavorCD() {
+	if !paladin.Talents.DivineFavor {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: 20216}
+
+	paladin.DivineFavorAura = paladin.RegisterAura(core.Aura{
+		Label:    "Divine Favor",
+		ActionID: actionID,
+		Duration: core.NeverExpires,
+	})
+
+	baseCost := paladin.BaseMana() * 0.03
+
+	paladin.DivineFavor = paladin.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolHoly,
+		SpellExtras: core.SpellExtrasNoOnCastComplete,
+
+		ResourceType: stats.Mana,
+		BaseCost:     baseCost,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				Cost: baseCost,
+			},
+			CD: core.Cooldown{
+				Timer:    paladin.NewTimer(),
+				Duration: time.Minute * 2,
+			},
+		},
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			paladin.DivineFavorAura.Activate(sim)
+		},
+	})
+}
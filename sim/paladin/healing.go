@@ -0,0 +1,85 @@
+package paladin
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/stats"
+)
+
+func (paladin *Paladin) newHealingSpellConfig(actionID core.ActionID, baseCost float64, castTime time.Duration) core.SpellConfig {
+	return core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolHoly,
+
+		ResourceType: stats.Mana,
+		BaseCost:     baseCost,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				Cost:     baseCost,
+				GCD:      core.GCDDefault,
+				CastTime: castTime,
+			},
+		},
+	}
+}
+
+func (paladin *Paladin) newHealingSpellEffect(baseCost float64, minHealing float64, maxHealing float64, coefficient float64) core.SpellEffect {
+	bonusCritRating := core.SpellCritRatingPerCritChance * float64(paladin.Talents.HolyPower)
+
+	effect := core.SpellEffect{
+		ProcMask:         core.ProcMaskSpellHealing,
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		BaseDamage:     core.BaseHealingConfigHealing(minHealing, maxHealing, coefficient),
+		OutcomeApplier: paladin.OutcomeFuncHealingCrit(paladin.DefaultHealingCritMultiplier()),
+
+		OnInit: func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
+			spellEffect.BonusSpellCritRating = bonusCritRating
+			if paladin.DivineFavorAura != nil && paladin.DivineFavorAura.IsActive() {
+				spellEffect.BonusSpellCritRating += 100 * core.SpellCritRatingPerCritChance
+				paladin.DivineFavorAura.Deactivate(sim)
+			}
+		},
+	}
+
+	if paladin.Talents.Illumination > 0 {
+		illuminationChance := 0.2 * float64(paladin.Talents.Illumination)
+		illuminationActionID := core.ActionID{SpellID: 20272}
+		effect.OnHealDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
+			if spellEffect.Outcome != core.OutcomeCrit {
+				return
+			}
+			if illuminationChance < 1 && sim.RandomFloat("Illumination") > illuminationChance {
+				return
+			}
+			paladin.AddMana(sim, baseCost*0.6, illuminationActionID, false)
+		}
+	}
+
+	return effect
+}
+
+// Base healing of the highest ranks, before +healing and crits.
+const (
+	HolyLightMinHealing    = 2196.0
+	FlashOfLightMinHealing = 458.0
+)
+
+func (paladin *Paladin) RegisterHolyLightSpell() {
+	baseCost := 840.0
+
+	config := paladin.newHealingSpellConfig(core.ActionID{SpellID: 27136}, baseCost, time.Millisecond*2500)
+	config.ApplyEffects = core.ApplyEffectFuncHealing(paladin.newHealingSpellEffect(baseCost, HolyLightMinHealing, 2446, 2.5/3.5))
+	paladin.HolyLight = paladin.RegisterSpell(config)
+}
+
+func (paladin *Paladin) RegisterFlashOfLightSpell() {
+	baseCost := 180.0
+
+	config := paladin.newHealingSpellConfig(core.ActionID{SpellID: 27137}, baseCost, time.Millisecond*1500)
+	config.ApplyEffects = core.ApplyEffectFuncHealing(paladin.newHealingSpellEffect(baseCost, FlashOfLightMinHealing, 513, 1.5/3.5))
+	paladin.FlashOfLight = paladin.RegisterSpell(config)
+}
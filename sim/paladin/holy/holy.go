@@ -0,0 +1,81 @@
+package holy
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/paladin"
+)
+
+func RegisterHolyPaladin() {
+	core.RegisterAgentFactory(
+		proto.Player_HolyPaladin{},
+		proto.Spec_SpecHolyPaladin,
+		func(character core.Character, options proto.Player) core.Agent {
+			return NewHolyPaladin(character, options)
+		},
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_HolyPaladin)
+			if !ok {
+				panic("Invalid spec value for Holy Paladin!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+func NewHolyPaladin(character core.Character, options proto.Player) *HolyPaladin {
+	holyOptions := options.GetHolyPaladin()
+
+	holy := &HolyPaladin{
+		Paladin:  paladin.NewPaladin(character, *holyOptions.Talents),
+		Rotation: *holyOptions.Rotation,
+		Options:  *holyOptions.Options,
+	}
+	holy.PaladinAura = holy.Options.Aura
+
+	return holy
+}
+
+type HolyPaladin struct {
+	*paladin.Paladin
+
+	Rotation proto.HolyPaladin_Rotation
+	Options  proto.HolyPaladin_Options
+
+	healTarget *core.Unit
+}
+
+func (holy *HolyPaladin) GetPaladin() *paladin.Paladin {
+	return holy.Paladin
+}
+
+func (holy *HolyPaladin) Initialize() {
+	holy.Paladin.Initialize()
+
+	holy.RegisterHolyLightSpell()
+	holy.RegisterFlashOfLightSpell()
+	holy.RegisterDivineFavorCD()
+
+	holy.healTarget = &holy.Unit
+	if holy.Options.HealTarget != nil {
+		if healTargetAgent := holy.Party.Raid.GetPlayerFromRaidTarget(*holy.Options.HealTarget); healTargetAgent != nil {
+			holy.healTarget = &healTargetAgent.GetCharacter().Unit
+		}
+	}
+}
+
+func (holy *HolyPaladin) Reset(sim *core.Simulation) {
+	holy.Paladin.Reset(sim)
+
+	if holy.Options.DamageTakenPerSecond > 0 {
+		damagePerTick := holy.Options.DamageTakenPerSecond
+		core.StartPeriodicAction(sim, core.PeriodicActionOptions{
+			Period: time.Second,
+			OnAction: func(sim *core.Simulation) {
+				holy.healTarget.RemoveHealth(sim, damagePerTick)
+			},
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package holy
+
+import (
+	"testing"
+
+	_ "github.com/wowsims/tbc/sim/common" // imported to get item effects included.
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+func init() {
+	RegisterHolyPaladin()
+}
+
+func TestHoly(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator(core.CharacterSuiteConfig{
+		Class: proto.Class_ClassPaladin,
+
+		Race:       proto.Race_RaceBloodElf,
+		OtherRaces: []proto.Race{proto.Race_RaceHuman},
+
+		GearSet: core.GearSetCombo{Label: "P1", GearSet: P1Gear},
+
+		SpecOptions: core.SpecOptionsCombo{Label: "Holy Light", SpecOptions: DefaultOptions},
+		OtherSpecOptions: []core.SpecOptionsCombo{
+			core.SpecOptionsCombo{Label: "Flash of Light", SpecOptions: FlashOfLightOptions},
+		},
+
+		RaidBuffs:   FullRaidBuffs,
+		PartyBuffs:  FullPartyBuffs,
+		PlayerBuffs: FullIndividualBuffs,
+		Consumes:    FullConsumes,
+		Debuffs:     FullDebuffs,
+
+		ItemFilter: core.ItemFilter{
+			WeaponTypes: []proto.WeaponType{
+				proto.WeaponType_WeaponTypeMace,
+				proto.WeaponType_WeaponTypeShield,
+			},
+			ArmorType: proto.ArmorType_ArmorTypePlate,
+			RangedWeaponTypes: []proto.RangedWeaponType{
+				proto.RangedWeaponType_RangedWeaponTypeLibram,
+			},
+		},
+	}))
+}
+
+func BenchmarkSimulate(b *testing.B) {
+	rsr := &proto.RaidSimRequest{
+		Raid: core.SinglePlayerRaidProto(
+			&proto.Player{
+				Race:      proto.Race_RaceBloodElf,
+				Class:     proto.Class_ClassPaladin,
+				Equipment: P1Gear,
+				Consumes:  FullConsumes,
+				Spec:      DefaultOptions,
+				Buffs:     FullIndividualBuffs,
+			},
+			FullPartyBuffs,
+			FullRaidBuffs,
+			FullDebuffs),
+		Encounter: &proto.Encounter{
+			Duration: 300,
+			Targets: []*proto.Target{
+				core.NewDefaultTarget(),
+			},
+		},
+		SimOptions: core.AverageDefaultSimTestOptions,
+	}
+
+	core.RaidBenchmark(b, rsr)
+}
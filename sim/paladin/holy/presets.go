@@ -0,0 +1,113 @@
+package holy
+
+import (
+	"github.com/wowsims/tbc/sim/core/items"
+	"github.com/wowsims/tbc/sim/core/proto"
+)
+
+var defaultHolyTalents = &proto.PaladinTalents{
+	DivineIntellect:          5,
+	Illumination:             5,
+	ImprovedBlessingOfWisdom: 2,
+	DivineFavor:              true,
+	HolyPower:                5,
+	HolyShock:                true,
+	BlessedLife:              3,
+	HolyGuidance:             5,
+	DivineIllumination:       true,
+
+	ImprovedDevotionAura: 5,
+	Redoubt:              5,
+	Precision:            3,
+	Toughness:            5,
+	BlessingOfKings:      true,
+}
+
+var defaultHolyRotation = &proto.HolyPaladin_Rotation{
+	PrimarySpell:   proto.HolyPaladin_Rotation_HolyLight,
+	UseDivineFavor: true,
+}
+
+var defaultHolyOptions = &proto.HolyPaladin_Options{
+	Aura:                 proto.PaladinAura_DevotionAura,
+	DamageTakenPerSecond: 400,
+}
+
+var DefaultOptions = &proto.Player_HolyPaladin{
+	HolyPaladin: &proto.HolyPaladin{
+		Talents:  defaultHolyTalents,
+		Options:  defaultHolyOptions,
+		Rotation: defaultHolyRotation,
+	},
+}
+
+var FlashOfLightOptions = &proto.Player_HolyPaladin{
+	HolyPaladin: &proto.HolyPaladin{
+		Talents: defaultHolyTalents,
+		Options: defaultHolyOptions,
+		Rotation: &proto.HolyPaladin_Rotation{
+			PrimarySpell:   proto.HolyPaladin_Rotation_FlashOfLight,
+			UseDivineFavor: true,
+		},
+	},
+}
+
+var FullRaidBuffs = &proto.RaidBuffs{
+	ArcaneBrilliance:   true,
+	GiftOfTheWild:      proto.TristateEffect_TristateEffectImproved,
+	PowerWordFortitude: proto.TristateEffect_TristateEffectImproved,
+}
+var FullPartyBuffs = &proto.PartyBuffs{
+	MoonkinAura:     proto.TristateEffect_TristateEffectRegular,
+	WrathOfAirTotem: proto.TristateEffect_TristateEffectImproved,
+	ManaSpringTotem: proto.TristateEffect_TristateEffectRegular,
+}
+var FullIndividualBuffs = &proto.IndividualBuffs{
+	BlessingOfKings:  true,
+	BlessingOfWisdom: proto.TristateEffect_TristateEffectImproved,
+}
+
+var FullConsumes = &proto.Consumes{
+	Flask:              proto.Flask_FlaskOfMightyRestoration,
+	Food:               proto.Food_FoodBlackenedBasilisk,
+	DefaultPotion:      proto.Potions_SuperManaPotion,
+	NumStartingPotions: 1,
+	DefaultConjured:    proto.Conjured_ConjuredDarkRune,
+}
+
+var FullDebuffs = &proto.Debuffs{}
+
+var P1Gear = items.EquipmentSpecFromJsonString(`{"items": [
+	{
+		"id": 29061
+	},
+	{},
+	{
+		"id": 29064
+	},
+	{},
+	{
+		"id": 29062
+	},
+	{},
+	{
+		"id": 29065
+	},
+	{},
+	{
+		"id": 29063
+	},
+	{},
+	{
+		"id": 28790
+	},
+	{},
+	{},
+	{},
+	{
+		"id": 28771
+	},
+	{
+		"id": 29458
+	}
+]}`)
@@ -0,0 +1,39 @@
+package holy
+
+import (
+	"time"
+
+	"github.com/wowsims/tbc/sim/core"
+	"github.com/wowsims/tbc/sim/core/proto"
+	"github.com/wowsims/tbc/sim/paladin"
+)
+
+// How long to wait before checking the heal target again, when it doesn't
+// need a heal.
+const healthCheckInterval = time.Millisecond * 500
+
+func (holy *HolyPaladin) OnGCDReady(sim *core.Simulation) {
+	spell := holy.HolyLight
+	if holy.Rotation.PrimarySpell == proto.HolyPaladin_Rotation_FlashOfLight {
+		spell = holy.FlashOfLight
+	}
+
+	// Don't spend mana on heals which would mostly overheal. Wait until the
+	// target is missing at least the spell's base healing.
+	minHealing := paladin.HolyLightMinHealing
+	if spell == holy.FlashOfLight {
+		minHealing = paladin.FlashOfLightMinHealing
+	}
+	if holy.healTarget.MissingHealth() < minHealing {
+		holy.WaitUntil(sim, sim.CurrentTime+healthCheckInterval)
+		return
+	}
+
+	if holy.Rotation.UseDivineFavor && holy.DivineFavor != nil && holy.DivineFavor.IsReady(sim) {
+		holy.DivineFavor.Cast(sim, nil)
+	}
+
+	if success := spell.Cast(sim, holy.healTarget); !success {
+		holy.WaitForMana(sim, spell.CurCast.Cost)
+	}
+}
@@ -23,7 +23,10 @@ type Paladin struct {
 
 	Consecration             *core.Spell
 	CrusaderStrike           *core.Spell
+	DivineFavor              *core.Spell
 	Exorcism                 *core.Spell
+	FlashOfLight             *core.Spell
+	HolyLight                *core.Spell
 	HolyShield               *core.Spell
 	JudgementOfBlood         *core.Spell
 	JudgementOfTheCrusader   *core.Spell
@@ -39,6 +42,7 @@ type Paladin struct {
 
 	ConsecrationDot *core.Dot
 
+	DivineFavorAura            *core.Aura
 	HolyShieldAura             *core.Aura
 	JudgementOfTheCrusaderAura *core.Aura
 	JudgementOfWisdomAura      *core.Aura
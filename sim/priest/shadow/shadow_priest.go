@@ -61,6 +61,12 @@ func (spriest *ShadowPriest) GetPriest() *priest.Priest {
 	return spriest.Priest
 }
 
+func (spriest *ShadowPriest) ClearPartyBuffApproximations(individualBuffs *proto.IndividualBuffs) {
+	if spriest.Talents.VampiricTouch {
+		individualBuffs.ShadowPriestDps = 0
+	}
+}
+
 func (spriest *ShadowPriest) Reset(sim *core.Simulation) {
 	spriest.Priest.Reset(sim)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/wowsims/tbc/sim/core"
 	"github.com/wowsims/tbc/sim/core/proto"
 	"github.com/wowsims/tbc/sim/core/stats"
+	googleProto "google.golang.org/protobuf/proto"
 
 	balanceDruid "github.com/wowsims/tbc/sim/druid/balance"
 	hunter "github.com/wowsims/tbc/sim/hunter"
@@ -143,5 +144,40 @@ func TestBasicRaid(t *testing.T) {
 		SimOptions: SimOptions,
 	}
 
-	core.RaidSimTest("P1 ST", t, rsr, 6236.24)
+	// The first party's Shadow Priest provides VT mana, so the VT mana
+	// approximated in the casters' buffs is ignored.
+	core.RaidSimTest("P1 ST", t, rsr, 6351.95)
+}
+
+// Snapshots per-player results, to catch changes in how players affect each
+// other, e.g. through totems, Bloodlust, Ferocious Inspiration and Innervate.
+func TestRaidSuite(t *testing.T) {
+	testSuite := core.NewRaidTestSuite("TestRaid")
+
+	testSuite.TestRaid(t, "Basic", &proto.RaidSimRequest{
+		Raid:       BasicRaid,
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	})
+
+	// Innervate the shadow priest instead of the druid.
+	innervateRaid := googleProto.Clone(BasicRaid).(*proto.Raid)
+	innervateRaid.Parties[0].Players[0].GetBalanceDruid().Options.InnervateTarget = &proto.RaidTarget{TargetIndex: 3}
+	testSuite.TestRaid(t, "InnervateTarget", &proto.RaidSimRequest{
+		Raid:       innervateRaid,
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	})
+
+	// Hunter in the first party, so Ferocious Inspiration affects everyone.
+	fullPartyRaid := googleProto.Clone(BasicRaid).(*proto.Raid)
+	fullPartyRaid.Parties[0].Players = append(fullPartyRaid.Parties[0].Players, fullPartyRaid.Parties[1].Players...)
+	fullPartyRaid.Parties = fullPartyRaid.Parties[:1]
+	testSuite.TestRaid(t, "FullParty", &proto.RaidSimRequest{
+		Raid:       fullPartyRaid,
+		Encounter:  STEncounter,
+		SimOptions: SimOptions,
+	})
+
+	testSuite.Done(t)
 }
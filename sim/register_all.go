@@ -1,13 +1,14 @@
 package sim
 
 import (
-	_ "github.com/wowsims/tbc/sim/common"
+	"github.com/wowsims/tbc/sim/common"
 	"github.com/wowsims/tbc/sim/druid/balance"
 	"github.com/wowsims/tbc/sim/druid/feral"
 	feralTank "github.com/wowsims/tbc/sim/druid/tank"
 	_ "github.com/wowsims/tbc/sim/encounters"
 	"github.com/wowsims/tbc/sim/hunter"
 	"github.com/wowsims/tbc/sim/mage"
+	holyPaladin "github.com/wowsims/tbc/sim/paladin/holy"
 	protectionPaladin "github.com/wowsims/tbc/sim/paladin/protection"
 	"github.com/wowsims/tbc/sim/paladin/retribution"
 	"github.com/wowsims/tbc/sim/priest/shadow"
@@ -41,6 +42,8 @@ func RegisterAll() {
 	protectionWarrior.RegisterProtectionWarrior()
 	retribution.RegisterRetributionPaladin()
 	protectionPaladin.RegisterProtectionPaladin()
+	holyPaladin.RegisterHolyPaladin()
 	smite.RegisterSmitePriest()
 	warlock.RegisterWarlock()
+	common.RegisterBuffBot()
 }
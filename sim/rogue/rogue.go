@@ -232,6 +232,9 @@ func NewRogue(character core.Character, options proto.Player) *Rogue {
 		maxEnergy = 110
 	}
 	rogue.EnableEnergyBar(maxEnergy, func(sim *core.Simulation) {
+		if rogue.RotationOverridden() {
+			return
+		}
 		rogue.TryUseCooldowns(sim)
 		if rogue.GCD.IsReady(sim) {
 			rogue.doRotation(sim)
@@ -333,7 +333,7 @@ func (rogue *Rogue) tryUseDamageFinisher(sim *core.Simulation, energy float64, c
 	if rogue.Rotation.UseRupture &&
 		!rogue.RuptureDot.IsActive() &&
 		sim.GetRemainingDuration() >= rogue.RuptureDuration(comboPoints) &&
-		(sim.GetNumTargets() == 1 || (rogue.BladeFlurryAura == nil || !rogue.BladeFlurryAura.IsActive())) {
+		(sim.GetNumActiveTargets() == 1 || (rogue.BladeFlurryAura == nil || !rogue.BladeFlurryAura.IsActive())) {
 		if energy >= RuptureEnergyCost || rogue.deathmantleActive() {
 			rogue.Rupture[comboPoints].Cast(sim, rogue.CurrentTarget)
 		}
@@ -348,7 +348,7 @@ func (rogue *Rogue) registerBladeFlurryCD() {
 			rogue.MultiplyMeleeSpeed(sim, inverseHasteBonus)
 		},
 		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
-			if sim.GetNumTargets() < 2 {
+			if sim.GetNumActiveTargets() < 2 {
 				return
 			}
 			if spellEffect.Damage == 0 || !spellEffect.ProcMask.Matches(core.ProcMaskMelee) {
@@ -358,7 +358,7 @@ func (rogue *Rogue) registerBladeFlurryCD() {
 			// Undo armor reduction to get the raw damage value.
 			curDmg = spellEffect.Damage / rogue.AttackTables[spellEffect.Target.Index].ArmorDamageReduction
 
-			bfHit.Cast(sim, rogue.Env.NextTargetUnit(spellEffect.Target))
+			bfHit.Cast(sim, sim.NextActiveTargetUnit(spellEffect.Target))
 			bfHit.SpellMetrics[spellEffect.Target.Index].Casts--
 		},
 	})
@@ -44,26 +44,27 @@ func (shaman *Shaman) newChainLightningSpell(isLightningOverload bool) *core.Spe
 	}
 
 	hasTidefury := ItemSetTidefury.CharacterHasSetBonus(&shaman.Character, 2)
-	numHits := core.MinInt32(3, shaman.Env.GetNumTargets())
-	effects := make([]core.SpellEffect, 0, numHits)
+	spellConfig.ApplyEffects = core.ApplyEffectFuncActiveTargets(shaman.Env, 3, func(targets []*core.Unit) core.ApplySpellEffects {
+		effects := make([]core.SpellEffect, 0, len(targets))
 
-	effect.Target = shaman.Env.GetTargetUnit(0)
-	effect.OnSpellHitDealt = makeOnSpellHit(0)
-	effects = append(effects, effect)
+		effect.Target = targets[0]
+		effect.OnSpellHitDealt = makeOnSpellHit(0)
+		effects = append(effects, effect)
 
-	for i := int32(1); i < numHits; i++ {
-		bounceEffect := effects[i-1] // Makes a copy of the previous bounce
-		bounceEffect.Target = shaman.Env.GetTargetUnit(i)
-		if hasTidefury {
-			bounceEffect.DamageMultiplier *= 0.83
-		} else {
-			bounceEffect.DamageMultiplier *= 0.7
-		}
-		bounceEffect.OnSpellHitDealt = makeOnSpellHit(i)
+		for i := int32(1); i < int32(len(targets)); i++ {
+			bounceEffect := effects[i-1] // Makes a copy of the previous bounce
+			bounceEffect.Target = targets[i]
+			if hasTidefury {
+				bounceEffect.DamageMultiplier *= 0.83
+			} else {
+				bounceEffect.DamageMultiplier *= 0.7
+			}
+			bounceEffect.OnSpellHitDealt = makeOnSpellHit(i)
 
-		effects = append(effects, bounceEffect)
-	}
+			effects = append(effects, bounceEffect)
+		}
 
-	spellConfig.ApplyEffects = core.ApplyEffectFuncDamageMultiple(effects)
+		return core.ApplyEffectFuncDamageMultiple(effects)
+	})
 	return shaman.RegisterSpell(spellConfig)
 }
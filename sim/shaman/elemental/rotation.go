@@ -28,6 +28,10 @@ func (eleShaman *ElementalShaman) tryUseGCD(sim *core.Simulation) {
 		return
 	}
 
+	if eleShaman.tryShockForMovement(sim) {
+		return
+	}
+
 	eleShaman.rotation.DoAction(eleShaman, sim)
 	//actionSuccessful := newAction.Cast(sim)
 	//if actionSuccessful {
@@ -39,6 +43,19 @@ func (eleShaman *ElementalShaman) tryUseGCD(sim *core.Simulation) {
 	//}
 }
 
+// Uses Earth Shock instead of a cast which would be interrupted by movement.
+func (eleShaman *ElementalShaman) tryShockForMovement(sim *core.Simulation) bool {
+	castTime := eleShaman.ApplyCastSpeed(eleShaman.LightningBolt.DefaultCast.CastTime)
+	if !sim.WillBeMovingWithin(castTime) || !eleShaman.EarthShock.IsReady(sim) {
+		return false
+	}
+
+	if !eleShaman.EarthShock.Cast(sim, eleShaman.CurrentTarget) {
+		eleShaman.WaitForMana(sim, eleShaman.EarthShock.CurCast.Cost)
+	}
+	return true
+}
+
 // Picks which attacks / abilities the Shaman does.
 type Rotation interface {
 	GetPresimOptions() *core.PresimOptions
@@ -185,7 +202,7 @@ type AdaptiveRotation struct {
 
 func (rotation *AdaptiveRotation) DoAction(eleShaman *ElementalShaman, sim *core.Simulation) {
 	didLB := false
-	if sim.GetNumTargets() == 1 {
+	if sim.GetNumActiveTargets() == 1 {
 		sp := eleShaman.GetStat(stats.NatureSpellPower) + eleShaman.GetStat(stats.SpellPower)
 		lb := ((612 + (sp * 0.794)) * 1.2) / (2 * eleShaman.CastSpeed)
 		cl := ((786 + (sp * 0.651)) * 1.0666) / core.MaxFloat((1.5*eleShaman.CastSpeed), 1)
@@ -94,6 +94,12 @@ func (enh *EnhancementShaman) Initialize() {
 	enh.Shaman.Initialize()
 	enh.DelayDPSCooldownsForArmorDebuffs()
 
+	// The schedule takes over cooldowns like Bloodlust, so it's only set up if
+	// the rotation is used.
+	if enh.RotationOverridden() {
+		return
+	}
+
 	// This needs to be called after DPS cooldowns are delayed, which also happens
 	// after finalization.
 	enh.Env.RegisterPostFinalizeEffect(enh.SetupRotationSchedule)
@@ -147,6 +147,11 @@ func (warlock *Warlock) tryUseGCD(sim *core.Simulation) {
 		}
 	}
 
+	// Life Tap is instant, so use it instead of a cast which movement would interrupt.
+	if castTime := warlock.ApplyCastSpeed(spell.DefaultCast.CastTime); castTime > 0 && sim.WillBeMovingWithin(castTime) {
+		spell = warlock.LifeTap
+	}
+
 	if success := spell.Cast(sim, target); success {
 		return
 	}
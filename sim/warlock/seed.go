@@ -14,17 +14,12 @@ func (warlock *Warlock) registerSeedSpell() {
 	warlock.Seeds = make([]*core.Spell, numTargets)
 	warlock.SeedDots = make([]*core.Dot, numTargets)
 
-	numHit := float64(numTargets - 1)
-	// For this simulation we always assume the seed target didn't die to trigger the seed because we don't simulate health.
-	// This effectively lowers the seed AOE cap using the function:
-	cap := 13580.0 * numHit / (numHit + 1)
-
 	for i := 0; i < numTargets; i++ {
-		warlock.makeSeed(i, cap)
+		warlock.makeSeed(i)
 	}
 }
 
-func (warlock *Warlock) makeSeed(targetIdx int, cap float64) {
+func (warlock *Warlock) makeSeed(targetIdx int) {
 	baseCost := 882.0
 
 	flatBonus := 0.0
@@ -39,30 +34,33 @@ func (warlock *Warlock) makeSeed(targetIdx int, cap float64) {
 		OutcomeApplier:   warlock.OutcomeFuncMagicHitAndCrit(1.5),
 	}
 
-	// Use a custom aoe effect list that does not include the seeded target.
-	baseEffects := make([]core.SpellEffect, warlock.Env.GetNumTargets()-1)
-	skipped := false
-	for i := range baseEffects {
-		baseEffects[i] = baseSeedExplosionEffect
-		expTarget := i
-		if i == targetIdx {
-			skipped = true
-		}
-		if skipped {
-			expTarget++
-		}
-		baseEffects[i].Target = warlock.Env.GetTargetUnit(int32(expTarget))
-	}
 	seedActionID := core.ActionID{SpellID: 27243}
 
 	explosionId := seedActionID
 	explosionId.Tag = 1
 
 	seedExplosion := warlock.RegisterSpell(core.SpellConfig{
-		ActionID:     explosionId,
-		SpellSchool:  core.SpellSchoolShadow,
-		Cast:         core.CastConfig{},
-		ApplyEffects: core.ApplyEffectFuncMultipleDamageCapped(baseEffects, cap),
+		ActionID:    explosionId,
+		SpellSchool: core.SpellSchoolShadow,
+		Cast:        core.CastConfig{},
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(warlock.Env, warlock.Env.GetNumTargets(), func(targets []*core.Unit) core.ApplySpellEffects {
+			// Use a custom aoe effect list that does not include the seeded target.
+			baseEffects := make([]core.SpellEffect, 0, len(targets))
+			for _, target := range targets {
+				if target.Index != int32(targetIdx) {
+					effect := baseSeedExplosionEffect
+					effect.Target = target
+					baseEffects = append(baseEffects, effect)
+				}
+			}
+
+			numHit := float64(len(baseEffects))
+			// For this simulation we always assume the seed target didn't die to trigger the seed because we don't simulate health.
+			// This effectively lowers the seed AOE cap using the function:
+			cap := 13580.0 * numHit / (numHit + 1)
+
+			return core.ApplyEffectFuncMultipleDamageCapped(baseEffects, cap)
+		}),
 	})
 
 	effect := core.SpellEffect{
@@ -86,6 +86,13 @@ func (warlock *Warlock) AddPartyBuffs(partyBuffs *proto.PartyBuffs) {
 		warlock.Talents.ImprovedImp == 2))
 }
 
+func (warlock *Warlock) ClearDebuffApproximations(debuffs *proto.Debuffs) {
+	// ISB charges only come from Shadow Bolt crits.
+	if warlock.Talents.ImprovedShadowBolt > 0 && warlock.Rotation.PrimarySpell == proto.Warlock_Rotation_Shadowbolt {
+		debuffs.IsbUptime = 0
+	}
+}
+
 func (warlock *Warlock) Reset(sim *core.Simulation) {
 
 }
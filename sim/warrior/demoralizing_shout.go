@@ -22,22 +22,11 @@ func (warrior *Warrior) registerDemoralizingShoutSpell() {
 	}
 
 	numHits := warrior.Env.GetNumTargets()
-	effects := make([]core.SpellEffect, 0, numHits)
+	demoShoutAuras := make([]*core.Aura, numHits)
 	for i := int32(0); i < numHits; i++ {
-		effects = append(effects, baseEffect)
-		effects[i].Target = warrior.Env.GetTargetUnit(i)
-
-		demoShoutAura := core.DemoralizingShoutAura(effects[i].Target, warrior.Talents.BoomingVoice, warrior.Talents.ImprovedDemoralizingShout)
-		if i == 0 {
-			warrior.DemoralizingShoutAura = demoShoutAura
-		}
-
-		effects[i].OnSpellHitDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
-			if spellEffect.Landed() {
-				demoShoutAura.Activate(sim)
-			}
-		}
+		demoShoutAuras[i] = core.DemoralizingShoutAura(warrior.Env.GetTargetUnit(i), warrior.Talents.BoomingVoice, warrior.Talents.ImprovedDemoralizingShout)
 	}
+	warrior.DemoralizingShoutAura = demoShoutAuras[0]
 
 	warrior.DemoralizingShout = warrior.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 25203},
@@ -54,7 +43,21 @@ func (warrior *Warrior) registerDemoralizingShoutSpell() {
 			IgnoreHaste: true,
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(warrior.Env, numHits, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, len(targets))
+			for i, target := range targets {
+				effects[i] = baseEffect
+				effects[i].Target = target
+
+				demoShoutAura := demoShoutAuras[target.Index]
+				effects[i].OnSpellHitDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
+					if spellEffect.Landed() {
+						demoShoutAura.Activate(sim)
+					}
+				}
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
@@ -15,6 +15,9 @@ func (war *DpsWarrior) OnGCDReady(sim *core.Simulation) {
 }
 
 func (war *DpsWarrior) OnAutoAttack(sim *core.Simulation, spell *core.Spell) {
+	if war.RotationOverridden() {
+		return
+	}
 	war.tryQueueSlam(sim)
 	war.tryQueueHsCleave(sim)
 }
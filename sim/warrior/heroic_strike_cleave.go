@@ -58,13 +58,6 @@ func (warrior *Warrior) registerCleaveSpell() {
 		OutcomeApplier: warrior.OutcomeFuncMeleeWeaponSpecialHitAndCrit(warrior.critMultiplier(true)),
 	}
 
-	numHits := core.MinInt32(2, warrior.Env.GetNumTargets())
-	effects := make([]core.SpellEffect, 0, numHits)
-	for i := int32(0); i < numHits; i++ {
-		effects = append(effects, baseEffect)
-		effects[i].Target = warrior.Env.GetTargetUnit(i)
-	}
-
 	warrior.HeroicStrikeOrCleave = warrior.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 25231},
 		SpellSchool: core.SpellSchoolPhysical,
@@ -79,7 +72,14 @@ func (warrior *Warrior) registerCleaveSpell() {
 			},
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(warrior.Env, 2, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, len(targets))
+			for i, target := range targets {
+				effects[i] = baseEffect
+				effects[i].Target = target
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
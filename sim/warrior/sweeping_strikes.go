@@ -45,7 +45,7 @@ func (warrior *Warrior) registerSweepingStrikesCD() {
 			aura.SetStacks(sim, 10)
 		},
 		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
-			if aura.GetStacks() == 0 || spellEffect.Damage == 0 || !spellEffect.ProcMask.Matches(core.ProcMaskMelee) {
+			if aura.GetStacks() == 0 || sim.GetNumActiveTargets() < 2 || spellEffect.Damage == 0 || !spellEffect.ProcMask.Matches(core.ProcMaskMelee) {
 				return
 			}
 
@@ -54,7 +54,7 @@ func (warrior *Warrior) registerSweepingStrikesCD() {
 			// Undo armor reduction to get the raw damage value.
 			curDmg = spellEffect.Damage / warrior.AttackTables[spellEffect.Target.Index].ArmorDamageReduction
 
-			ssHit.Cast(sim, warrior.Env.NextTargetUnit(spellEffect.Target))
+			ssHit.Cast(sim, sim.NextActiveTargetUnit(spellEffect.Target))
 			ssHit.SpellMetrics[spellEffect.Target.Index].Casts--
 			if aura.GetStacks() > 0 {
 				aura.RemoveStack(sim)
@@ -89,7 +89,7 @@ func (warrior *Warrior) registerSweepingStrikesCD() {
 		Spell: ssCD,
 		Type:  core.CooldownTypeDPS,
 		CanActivate: func(sim *core.Simulation, character *core.Character) bool {
-			return sim.GetNumTargets() > 1 && warrior.CurrentRage() >= ssCD.DefaultCast.Cost
+			return sim.GetNumActiveTargets() > 1 && warrior.CurrentRage() >= ssCD.DefaultCast.Cost
 		},
 		ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
 			return true
@@ -29,23 +29,12 @@ func (warrior *Warrior) registerThunderClapSpell() {
 		OutcomeApplier:   warrior.OutcomeFuncMagicHitAndCrit(warrior.spellCritMultiplier(true)),
 	}
 
-	numHits := core.MinInt32(4, warrior.Env.GetNumTargets())
-	effects := make([]core.SpellEffect, 0, numHits)
-	for i := int32(0); i < numHits; i++ {
-		effects = append(effects, baseEffect)
-		effects[i].Target = warrior.Env.GetTargetUnit(i)
-
-		tcAura := core.ThunderClapAura(effects[i].Target, warrior.Talents.ImprovedThunderClap)
-		if i == 0 {
-			warrior.ThunderClapAura = tcAura
-		}
-
-		effects[i].OnSpellHitDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
-			if spellEffect.Landed() {
-				tcAura.Activate(sim)
-			}
-		}
+	numTargets := warrior.Env.GetNumTargets()
+	tcAuras := make([]*core.Aura, numTargets)
+	for i := int32(0); i < numTargets; i++ {
+		tcAuras[i] = core.ThunderClapAura(warrior.Env.GetTargetUnit(i), warrior.Talents.ImprovedThunderClap)
 	}
+	warrior.ThunderClapAura = tcAuras[0]
 
 	warrior.ThunderClap = warrior.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 25264},
@@ -67,7 +56,21 @@ func (warrior *Warrior) registerThunderClapSpell() {
 			},
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(warrior.Env, 4, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, len(targets))
+			for i, target := range targets {
+				effects[i] = baseEffect
+				effects[i].Target = target
+
+				tcAura := tcAuras[target.Index]
+				effects[i].OnSpellHitDealt = func(sim *core.Simulation, spell *core.Spell, spellEffect *core.SpellEffect) {
+					if spellEffect.Landed() {
+						tcAura.Activate(sim)
+					}
+				}
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
@@ -32,25 +32,6 @@ func (warrior *Warrior) registerWhirlwindSpell() {
 		OutcomeApplier: warrior.OutcomeFuncMeleeWeaponSpecialHitAndCrit(warrior.critMultiplier(true)),
 	}
 
-	numHits := core.MinInt32(4, warrior.Env.GetNumTargets())
-	numTotalHits := numHits
-	if warrior.AutoAttacks.IsDualWielding {
-		numTotalHits *= 2
-	}
-
-	effects := make([]core.SpellEffect, 0, numTotalHits)
-	for i := int32(0); i < numHits; i++ {
-		mhEffect := baseEffectMH
-		mhEffect.Target = warrior.Env.GetTargetUnit(i)
-		effects = append(effects, mhEffect)
-
-		if warrior.AutoAttacks.IsDualWielding {
-			ohEffect := baseEffectOH
-			ohEffect.Target = warrior.Env.GetTargetUnit(i)
-			effects = append(effects, ohEffect)
-		}
-	}
-
 	warrior.Whirlwind = warrior.RegisterSpell(core.SpellConfig{
 		ActionID:    core.ActionID{SpellID: 1680},
 		SpellSchool: core.SpellSchoolPhysical,
@@ -71,7 +52,21 @@ func (warrior *Warrior) registerWhirlwindSpell() {
 			},
 		},
 
-		ApplyEffects: core.ApplyEffectFuncDamageMultiple(effects),
+		ApplyEffects: core.ApplyEffectFuncActiveTargets(warrior.Env, 4, func(targets []*core.Unit) core.ApplySpellEffects {
+			effects := make([]core.SpellEffect, 0, len(targets)*2)
+			for _, target := range targets {
+				mhEffect := baseEffectMH
+				mhEffect.Target = target
+				effects = append(effects, mhEffect)
+
+				if warrior.AutoAttacks.IsDualWielding {
+					ohEffect := baseEffectOH
+					ohEffect.Target = target
+					effects = append(effects, ohEffect)
+				}
+			}
+			return core.ApplyEffectFuncDamageMultiple(effects)
+		}),
 	})
 }
 
@@ -4,7 +4,10 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"sync"
 	"syscall/js"
 
 	"github.com/wowsims/tbc/sim"
@@ -20,16 +23,37 @@ func init() {
 func main() {
 	c := make(chan struct{}, 0)
 
+	js.Global().Set("batchCompareAsync", js.FuncOf(batchCompareAsync))
 	js.Global().Set("computeStats", js.FuncOf(computeStats))
 	js.Global().Set("gearList", js.FuncOf(gearList))
+	js.Global().Set("gearOptimize", js.FuncOf(gearOptimize))
 	js.Global().Set("raidSim", js.FuncOf(raidSim))
 	js.Global().Set("raidSimAsync", js.FuncOf(raidSimAsync))
+	js.Global().Set("raidSimCompare", js.FuncOf(raidSimCompare))
 	js.Global().Set("statWeights", js.FuncOf(statWeights))
 	js.Global().Set("statWeightsAsync", js.FuncOf(statWeightsAsync))
+	js.Global().Set("cancelAsync", js.FuncOf(cancelAsync))
 	js.Global().Call("wasmready")
 	<-c
 }
 
+func batchCompareAsync(this js.Value, args []js.Value) interface{} {
+	bcr := &proto.BatchCompareRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), bcr); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	ctx, id, err := startAsync(args)
+	if err != nil {
+		log.Printf("Failed to start async sim: %s", err)
+		return nil
+	}
+	reporter := make(chan *proto.ProgressMetrics, 100)
+	core.BatchCompareAsync(ctx, bcr, reporter)
+
+	return processAsyncProgress(id, args[1], reporter)
+}
+
 func computeStats(this js.Value, args []js.Value) interface{} {
 	csr := &proto.ComputeStatsRequest{}
 	if err := googleProto.Unmarshal(getArgsBinary(args[0]), csr); err != nil {
@@ -70,6 +94,26 @@ func gearList(this js.Value, args []js.Value) interface{} {
 	return outArray
 }
 
+func gearOptimize(this js.Value, args []js.Value) interface{} {
+	gor := &proto.GearOptimizeRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), gor); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.OptimizeGear(gor)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
+
+	return outArray
+}
+
 func raidSim(this js.Value, args []js.Value) interface{} {
 	rsr := &proto.RaidSimRequest{}
 	if err := googleProto.Unmarshal(getArgsBinary(args[0]), rsr); err != nil {
@@ -96,12 +140,35 @@ func raidSimAsync(this js.Value, args []js.Value) interface{} {
 		log.Printf("Failed to parse request: %s", err)
 		return nil
 	}
+	ctx, id, err := startAsync(args)
+	if err != nil {
+		log.Printf("Failed to start async sim: %s", err)
+		return nil
+	}
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	core.RunRaidSimAsync(rsr, reporter)
+	core.RunRaidSimAsync(ctx, rsr, reporter)
+
+	return processAsyncProgress(id, args[1], reporter)
+}
+
+func raidSimCompare(this js.Value, args []js.Value) interface{} {
+	rscr := &proto.RaidSimCompareRequest{}
+	if err := googleProto.Unmarshal(getArgsBinary(args[0]), rscr); err != nil {
+		log.Printf("Failed to parse request: %s", err)
+		return nil
+	}
+	result := core.RunRaidSimCompare(rscr)
+
+	outbytes, err := googleProto.Marshal(result)
+	if err != nil {
+		log.Printf("[ERROR] Failed to marshal result: %s", err.Error())
+		return nil
+	}
+
+	outArray := js.Global().Get("Uint8Array").New(len(outbytes))
+	js.CopyBytesToJS(outArray, outbytes)
 
-	result := processAsyncProgress(args[1], reporter)
-	close(reporter)
-	return result
+	return outArray
 }
 
 func statWeights(this js.Value, args []js.Value) interface{} {
@@ -130,12 +197,54 @@ func statWeightsAsync(this js.Value, args []js.Value) interface{} {
 		log.Printf("Failed to parse request: %s", err)
 		return nil
 	}
+	ctx, id, err := startAsync(args)
+	if err != nil {
+		log.Printf("Failed to start async sim: %s", err)
+		return nil
+	}
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	core.StatWeightsAsync(rsr, reporter)
+	core.StatWeightsAsync(ctx, rsr, reporter)
 
-	result := processAsyncProgress(args[1], reporter)
-	close(reporter)
-	return result
+	return processAsyncProgress(id, args[1], reporter)
+}
+
+// Cancel funcs for running async sims, keyed by the ID passed from JS.
+var asyncCancels = map[string]context.CancelFunc{}
+var asyncCancelsMut sync.Mutex
+
+// Creates the context for an async sim. args[2] is the ID which can later be
+// passed to cancelAsync, and must be unique among running sims.
+func startAsync(args []js.Value) (context.Context, string, error) {
+	if len(args) < 3 || args[2].Type() != js.TypeString || args[2].String() == "" {
+		return nil, "", fmt.Errorf("missing ID for async sim")
+	}
+	id := args[2].String()
+
+	asyncCancelsMut.Lock()
+	defer asyncCancelsMut.Unlock()
+	if _, ok := asyncCancels[id]; ok {
+		return nil, "", fmt.Errorf("async sim with ID %s is already running", id)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	asyncCancels[id] = cancel
+	return ctx, id, nil
+}
+
+func finishAsync(id string) {
+	asyncCancelsMut.Lock()
+	if cancel, ok := asyncCancels[id]; ok {
+		cancel()
+		delete(asyncCancels, id)
+	}
+	asyncCancelsMut.Unlock()
+}
+
+// Stops the async sim with the ID in args[0]. The sim still resolves with a
+// result, containing only the iterations which were completed.
+func cancelAsync(this js.Value, args []js.Value) interface{} {
+	finishAsync(args[0].String())
+	return nil
 }
 
 // Assumes args[0] is a Uint8Array
@@ -145,7 +254,26 @@ func getArgsBinary(value js.Value) []byte {
 	return data
 }
 
-func processAsyncProgress(progFunc js.Value, reporter chan *proto.ProgressMetrics) js.Value {
+// Returns a Promise which resolves to the final progress metrics. The sim runs
+// in the background so that cancelAsync can be called while it is running.
+func processAsyncProgress(id string, progFunc js.Value, reporter chan *proto.ProgressMetrics) js.Value {
+	var handler js.Func
+	handler = js.FuncOf(func(this js.Value, promiseArgs []js.Value) interface{} {
+		resolve := promiseArgs[0]
+		go func() {
+			result := readAsyncProgress(progFunc, reporter)
+			close(reporter)
+			finishAsync(id)
+			handler.Release()
+			resolve.Invoke(result)
+		}()
+		return nil
+	})
+
+	return js.Global().Get("Promise").New(handler)
+}
+
+func readAsyncProgress(progFunc js.Value, reporter chan *proto.ProgressMetrics) js.Value {
 reader:
 	for {
 		// TODO: cleanup so we dont collect these
@@ -163,7 +291,7 @@ reader:
 			js.CopyBytesToJS(outArray, outbytes)
 			progFunc.Invoke(outArray)
 
-			if progMetric.FinalWeightResult != nil || progMetric.FinalRaidResult != nil {
+			if progMetric.FinalWeightResult != nil || progMetric.FinalRaidResult != nil || progMetric.FinalBatchCompareResult != nil {
 				return outArray
 			}
 		}
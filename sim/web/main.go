@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -78,19 +79,22 @@ func main() {
 	runServer(*useFS, *host, *launch, *simName, *wasm, bufio.NewReader(os.Stdin))
 }
 
-type simProgReportCreator func() (string, progReport)
+type simProgReportCreator func(cancel context.CancelFunc) (string, progReport)
 type progReport func(progMetric *proto.ProgressMetrics)
 type asyncAPIHandler struct {
 	msg    func() googleProto.Message
-	handle func(googleProto.Message, chan *proto.ProgressMetrics)
+	handle func(context.Context, googleProto.Message, chan *proto.ProgressMetrics)
 }
 
 var asyncAPIHandlers = map[string]asyncAPIHandler{
-	"/raidSimAsync": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
-		core.RunRaidSimAsync(msg.(*proto.RaidSimRequest), reporter)
+	"/raidSimAsync": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.RunRaidSimAsync(ctx, msg.(*proto.RaidSimRequest), reporter)
 	}},
-	"/statWeightsAsync": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
-		core.StatWeightsAsync(msg.(*proto.StatWeightsRequest), reporter)
+	"/statWeightsAsync": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.StatWeightsAsync(ctx, msg.(*proto.StatWeightsRequest), reporter)
+	}},
+	"/batchCompareAsync": {msg: func() googleProto.Message { return &proto.BatchCompareRequest{} }, handle: func(ctx context.Context, msg googleProto.Message, reporter chan *proto.ProgressMetrics) {
+		core.BatchCompareAsync(ctx, msg.(*proto.BatchCompareRequest), reporter)
 	}},
 }
 
@@ -114,23 +118,19 @@ func handleAsyncAPI(w http.ResponseWriter, r *http.Request, addNewSim simProgRep
 		return
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	handler.handle(msg, reporter)
+	handler.handle(ctx, msg, reporter)
 
-	id, report := addNewSim()
+	id, report := addNewSim(cancel)
 	go func() {
-		for {
-			// TODO: cleanup so we dont collect these
-			select {
-			case progMetric, ok := <-reporter:
-				if !ok {
-					return
-				}
-				report(progMetric)
-				if progMetric.FinalRaidResult != nil || progMetric.FinalWeightResult != nil {
-					close(reporter)
-					return
-				}
+		// Sims always send a final result, even when cancelled.
+		defer cancel()
+		for progMetric := range reporter {
+			report(progMetric)
+			if progMetric.FinalRaidResult != nil || progMetric.FinalWeightResult != nil || progMetric.FinalBatchCompareResult != nil {
+				close(reporter)
+				return
 			}
 		}
 	}()
@@ -154,19 +154,21 @@ func setupAsyncServer() {
 	type asyncProgress struct {
 		mut            sync.Mutex
 		latestProgress proto.ProgressMetrics
+		cancel         context.CancelFunc
 	}
 	progresses := map[string]*asyncProgress{}
 	progMut := &sync.RWMutex{}
-	addNewSim := func() (string, progReport) {
+	addNewSim := func(cancel context.CancelFunc) (string, progReport) {
 		newID := uuid.NewV4().String()
+		progress := &asyncProgress{cancel: cancel}
 		progMut.Lock()
-		progresses[newID] = &asyncProgress{}
+		progresses[newID] = progress
 		progMut.Unlock()
 
 		return newID, func(newProg *proto.ProgressMetrics) {
-			progresses[newID].mut.Lock()
-			progresses[newID].latestProgress = *newProg
-			progresses[newID].mut.Unlock()
+			progress.mut.Lock()
+			progress.latestProgress = *newProg
+			progress.mut.Unlock()
 		}
 	}
 	type progReport func(progMetric *proto.ProgressMetrics)
@@ -177,6 +179,33 @@ func setupAsyncServer() {
 	http.HandleFunc("/raidSimAsync", func(w http.ResponseWriter, r *http.Request) {
 		handleAsyncAPI(w, r, addNewSim)
 	})
+	http.HandleFunc("/asyncCancel", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			log.Printf("Failed to read request body: %s", err.Error())
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		msg := &proto.AsyncAPIResult{}
+		if err := googleProto.Unmarshal(body, msg); err != nil {
+			log.Printf("Failed to parse request: %s", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		progMut.RLock()
+		progress, ok := progresses[msg.ProgressId]
+		progMut.RUnlock()
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// The sim still reports a final result with the iterations it completed,
+		// which is cleaned up by /asyncProgress as usual.
+		progress.cancel()
+		w.WriteHeader(http.StatusNoContent)
+	})
 	http.HandleFunc("/asyncProgress", func(w http.ResponseWriter, r *http.Request) {
 		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
@@ -205,7 +234,7 @@ func setupAsyncServer() {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		if latest.FinalRaidResult != nil || latest.FinalWeightResult != nil {
+		if latest.FinalRaidResult != nil || latest.FinalWeightResult != nil || latest.FinalBatchCompareResult != nil {
 			progMut.Lock()
 			delete(progresses, msg.ProgressId)
 			progMut.Unlock()
@@ -234,6 +263,7 @@ func runServer(useFS bool, host string, launchBrowser bool, simName string, wasm
 	http.HandleFunc("/individualSim", handleAPI)
 	http.HandleFunc("/raidSim", handleAPI)
 	http.HandleFunc("/gearList", handleAPI)
+	http.HandleFunc("/gearOptimize", handleAPI)
 	http.HandleFunc("/", func(resp http.ResponseWriter, req *http.Request) {
 		resp.Header().Add("Cache-Control", "no-cache")
 		if strings.HasSuffix(req.URL.Path, "/tbc/") {
@@ -341,6 +371,9 @@ var handlers = map[string]apiHandler{
 	"/raidSim": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.RunRaidSim(msg.(*proto.RaidSimRequest))
 	}},
+	"/raidSimCompare": {msg: func() googleProto.Message { return &proto.RaidSimCompareRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.RunRaidSimCompare(msg.(*proto.RaidSimCompareRequest))
+	}},
 	"/statWeights": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.StatWeights(msg.(*proto.StatWeightsRequest))
 	}},
@@ -350,6 +383,9 @@ var handlers = map[string]apiHandler{
 	"/gearList": {msg: func() googleProto.Message { return &proto.GearListRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.GetGearList(msg.(*proto.GearListRequest))
 	}},
+	"/gearOptimize": {msg: func() googleProto.Message { return &proto.GearOptimizeRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.OptimizeGear(msg.(*proto.GearOptimizeRequest))
+	}},
 }
 
 // handleAPI is generic handler for any api function using protos.
@@ -333,6 +333,24 @@ function addEncounterFieldPickers(rootElem: HTMLElement, encounter: Encounter, s
 			encounter.setDurationVariation(eventID, newValue);
 		},
 	});
+	new BooleanPicker(rootElem, encounter, {
+		label: 'Use Target Health',
+		labelTooltip: 'End each iteration when all targets with a Health value reach 0, instead of after a fixed duration. Execute range begins when the primary target reaches 20% HP, and Duration is only used as the initial estimate of the fight length.',
+		changedEvent: (encounter: Encounter) => encounter.durationChangeEmitter,
+		getValue: (encounter: Encounter) => encounter.getUseHealth(),
+		setValue: (eventID: EventID, encounter: Encounter, newValue: boolean) => {
+			encounter.setUseHealth(eventID, newValue);
+		},
+	});
+	new NumberPicker(rootElem, encounter, {
+		label: 'Healing Per Second',
+		labelTooltip: 'Healing applied to each player every second, to model raid healers in tank sims. Players take damage from enemy attacks, and the chance of death is reported in the results.',
+		changedEvent: (encounter: Encounter) => encounter.healingPerSecondChangeEmitter,
+		getValue: (encounter: Encounter) => encounter.getHealingPerSecond(),
+		setValue: (eventID: EventID, encounter: Encounter, newValue: number) => {
+			encounter.setHealingPerSecond(eventID, newValue);
+		},
+	});
 
 	if (showExecuteProportion) {
 		new NumberPicker(rootElem, encounter, {
@@ -348,7 +366,7 @@ function addEncounterFieldPickers(rootElem: HTMLElement, encounter: Encounter, s
 }
 
 const ALL_TARGET_STATS: Array<{ stat: Stat, tooltip: string, extraCssClasses: Array<string>}> = [
-	{ stat: Stat.StatHealth, tooltip: 'Not currently used anywhere.', extraCssClasses: []},
+	{ stat: Stat.StatHealth, tooltip: 'Used for health-based phase transitions and fight length.', extraCssClasses: []},
 	{ stat: Stat.StatArmor, tooltip: '', extraCssClasses: []},
 	{ stat: Stat.StatArcaneResistance, tooltip: '', extraCssClasses: []},
 	{ stat: Stat.StatFireResistance, tooltip: '', extraCssClasses: []},
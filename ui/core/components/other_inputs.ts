@@ -351,6 +351,25 @@ export const InFrontOfTarget = {
 	},
 };
 
+export const PlayerLatency = {
+	type: 'number' as const,
+	getModObject: (simUI: IndividualSimUI<any>) => simUI.player,
+	config: {
+		extraCssClasses: [
+			'player-latency-picker',
+		],
+		label: 'Latency (ms)',
+		labelTooltip: 'Delay before reacting to a cast finishing or a wait ending. Actions after an instant\'s GCD are queued, so they aren\'t delayed. Adds to spec-specific latency settings.',
+		changedEvent: (player: Player<any>) => player.latencyChangeEmitter,
+		getValue: (player: Player<any>) => player.getLatency().latencyMs,
+		setValue: (eventID: EventID, player: Player<any>, newValue: number) => {
+			const newLatency = player.getLatency();
+			newLatency.latencyMs = newValue;
+			player.setLatency(eventID, newLatency);
+		},
+	},
+};
+
 export const TankAssignment = {
 	type: 'enum' as const,
 	getModObject: (simUI: IndividualSimUI<any>) => simUI.player,
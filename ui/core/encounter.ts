@@ -1,5 +1,7 @@
 import { Encounter as EncounterProto } from '/tbc/core/proto/common.js';
+import { EncounterPhase } from '/tbc/core/proto/common.js';
 import { MobType } from '/tbc/core/proto/common.js';
+import { MovementWindow } from '/tbc/core/proto/common.js';
 import { Stat } from '/tbc/core/proto/common.js';
 import { Target as TargetProto } from '/tbc/core/proto/common.js';
 import { PresetEncounter } from '/tbc/core/proto/api.js';
@@ -17,11 +19,16 @@ export class Encounter {
 	private duration: number = 180;
 	private durationVariation: number = 5;
 	private executeProportion: number = 0.2;
+	private useHealth: boolean = false;
+	private healingPerSecond: number = 0;
 	private targets: Array<Target>;
+	private phases: Array<EncounterPhase> = [];
+	private movementWindows: Array<MovementWindow> = [];
 
 	readonly targetsChangeEmitter = new TypedEvent<void>();
 	readonly durationChangeEmitter = new TypedEvent<void>();
 	readonly executeProportionChangeEmitter = new TypedEvent<void>();
+	readonly healingPerSecondChangeEmitter = new TypedEvent<void>();
 
 	// Emits when any of the above emitters emit.
 	readonly changeEmitter = new TypedEvent<void>();
@@ -34,6 +41,7 @@ export class Encounter {
 			this.targetsChangeEmitter,
 			this.durationChangeEmitter,
 			this.executeProportionChangeEmitter,
+			this.healingPerSecondChangeEmitter,
 		].forEach(emitter => emitter.on(eventID => this.changeEmitter.emit(eventID)));
 	}
 
@@ -74,6 +82,28 @@ export class Encounter {
 		this.executeProportionChangeEmitter.emit(eventID);
 	}
 
+	getUseHealth(): boolean {
+		return this.useHealth;
+	}
+	setUseHealth(eventID: EventID, newUseHealth: boolean) {
+		if (newUseHealth == this.useHealth)
+			return;
+
+		this.useHealth = newUseHealth;
+		this.durationChangeEmitter.emit(eventID);
+	}
+
+	getHealingPerSecond(): number {
+		return this.healingPerSecond;
+	}
+	setHealingPerSecond(eventID: EventID, newHealingPerSecond: number) {
+		if (newHealingPerSecond == this.healingPerSecond)
+			return;
+
+		this.healingPerSecond = newHealingPerSecond;
+		this.healingPerSecondChangeEmitter.emit(eventID);
+	}
+
 	getNumTargets(): number {
 		return this.targets.length;
 	}
@@ -95,6 +125,30 @@ export class Encounter {
 		});
 	}
 
+	getPhases(): Array<EncounterPhase> {
+		return this.phases.slice();
+	}
+	setPhases(eventID: EventID, newPhases: Array<EncounterPhase>) {
+		if (newPhases.length == this.phases.length && newPhases.every((phase, i) => EncounterPhase.equals(phase, this.phases[i]))) {
+			return;
+		}
+
+		this.phases = newPhases;
+		this.targetsChangeEmitter.emit(eventID);
+	}
+
+	getMovementWindows(): Array<MovementWindow> {
+		return this.movementWindows.slice();
+	}
+	setMovementWindows(eventID: EventID, newMovementWindows: Array<MovementWindow>) {
+		if (newMovementWindows.length == this.movementWindows.length && newMovementWindows.every((window, i) => MovementWindow.equals(window, this.movementWindows[i]))) {
+			return;
+		}
+
+		this.movementWindows = newMovementWindows;
+		this.durationChangeEmitter.emit(eventID);
+	}
+
 	matchesPreset(preset: PresetEncounter): boolean {
 		return preset.targets.length == this.targets.length && this.targets.every((t, i) => t.matchesPreset(preset.targets[i]));
 	}
@@ -108,6 +162,7 @@ export class Encounter {
 
 			newTargets.forEach((nt, i) => nt.applyPreset(eventID, preset.targets[i]));
 			this.setTargets(eventID, newTargets);
+			this.setPhases(eventID, preset.phases);
 		});
 	}
 
@@ -116,7 +171,11 @@ export class Encounter {
 			duration: this.duration,
 			durationVariation: this.durationVariation,
 			executeProportion: this.executeProportion,
+			useHealth: this.useHealth,
+			healingPerSecond: this.healingPerSecond,
 			targets: this.targets.map(target => target.toProto()),
+			phases: this.phases,
+			movementWindows: this.movementWindows,
 		});
 	}
 
@@ -125,6 +184,8 @@ export class Encounter {
 			this.setDuration(eventID, proto.duration);
 			this.setDurationVariation(eventID, proto.durationVariation);
 			this.setExecuteProportion(eventID, proto.executeProportion);
+			this.setUseHealth(eventID, proto.useHealth);
+			this.setHealingPerSecond(eventID, proto.healingPerSecond);
 
 			if (proto.targets.length > 0) {
 				this.setTargets(eventID, proto.targets.map(targetProto => {
@@ -135,6 +196,8 @@ export class Encounter {
 			} else {
 				this.setTargets(eventID, [ Target.fromDefaults(eventID, this.sim) ]);
 			}
+			this.setPhases(eventID, proto.phases);
+			this.setMovementWindows(eventID, proto.movementWindows);
 		});
 	}
 
@@ -15,6 +15,7 @@ import { Stat } from '/tbc/core/proto/common.js';
 import { WeaponImbue } from '/tbc/core/proto/common.js';
 import { WeaponType } from '/tbc/core/proto/common.js';
 import { PlayerStats } from '/tbc/core/proto/api.js';
+import { Latency } from '/tbc/core/proto/api.js';
 import { Player as PlayerProto } from '/tbc/core/proto/api.js';
 import { StatWeightsResult } from '/tbc/core/proto/api.js';
 import { EquippedItem, getWeaponDPS } from '/tbc/core/proto_utils/equipped_item.js';
@@ -78,6 +79,7 @@ export class Player<SpecType extends Spec> {
 	private specOptions: SpecOptions<SpecType>;
 	private cooldowns: Cooldowns = Cooldowns.create();
 	private inFrontOfTarget: boolean = false;
+	private latency: Latency = Latency.create();
 
 	private itemEPCache: Map<number, number> = new Map<number, number>();
 	private gemEPCache: Map<number, number> = new Map<number, number>();
@@ -100,6 +102,7 @@ export class Player<SpecType extends Spec> {
 	readonly specOptionsChangeEmitter = new TypedEvent<void>('PlayerSpecOptions');
 	readonly cooldownsChangeEmitter = new TypedEvent<void>('PlayerCooldowns');
 	readonly inFrontOfTargetChangeEmitter = new TypedEvent<void>('PlayerInFrontOfTarget');
+	readonly latencyChangeEmitter = new TypedEvent<void>('PlayerLatency');
 	readonly epWeightsChangeEmitter = new TypedEvent<void>('PlayerEpWeights');
 
 	readonly currentStatsEmitter = new TypedEvent<void>('PlayerCurrentStats');
@@ -131,6 +134,7 @@ export class Player<SpecType extends Spec> {
 			this.specOptionsChangeEmitter,
 			this.cooldownsChangeEmitter,
 			this.inFrontOfTargetChangeEmitter,
+			this.latencyChangeEmitter,
 			this.epWeightsChangeEmitter,
 		], 'PlayerChange');
 	}
@@ -478,6 +482,20 @@ export class Player<SpecType extends Spec> {
 		this.inFrontOfTargetChangeEmitter.emit(eventID);
 	}
 
+	getLatency(): Latency {
+		// Make a defensive copy
+		return Latency.clone(this.latency);
+	}
+
+	setLatency(eventID: EventID, newLatency: Latency) {
+		if (Latency.equals(this.latency, newLatency))
+			return;
+
+		// Make a defensive copy
+		this.latency = Latency.clone(newLatency);
+		this.latencyChangeEmitter.emit(eventID);
+	}
+
 	computeStatsEP(stats?: Stats): number {
 		if (stats == undefined) {
 			return 0;
@@ -615,6 +633,7 @@ export class Player<SpecType extends Spec> {
 				cooldowns: this.getCooldowns(),
 				talentsString: this.getTalentsString(),
 				inFrontOfTarget: this.getInFrontOfTarget(),
+				latency: this.getLatency(),
 			}),
 			this.getRotation(),
 			forExport ? this.specTypeFunctions.talentsCreate() : this.getTalents(),
@@ -633,6 +652,7 @@ export class Player<SpecType extends Spec> {
 			this.setCooldowns(eventID, proto.cooldowns || Cooldowns.create());
 			this.setTalentsString(eventID, proto.talentsString);
 			this.setInFrontOfTarget(eventID, proto.inFrontOfTarget);
+			this.setLatency(eventID, proto.latency || Latency.create());
 			this.setRotation(eventID, this.specTypeFunctions.rotationFromPlayer(proto));
 			this.setSpecOptions(eventID, this.specTypeFunctions.optionsFromPlayer(proto));
 		});
@@ -48,6 +48,7 @@ import { Mage, Mage_Rotation as MageRotation, MageTalents, Mage_Options as MageO
 import { Rogue, Rogue_Rotation as RogueRotation, RogueTalents, Rogue_Options as RogueOptions } from '/tbc/core/proto/rogue.js';
 import { RetributionPaladin, RetributionPaladin_Rotation as RetributionPaladinRotation, PaladinTalents, RetributionPaladin_Options as RetributionPaladinOptions } from '/tbc/core/proto/paladin.js';
 import { ProtectionPaladin, ProtectionPaladin_Rotation as ProtectionPaladinRotation, ProtectionPaladin_Options as ProtectionPaladinOptions } from '/tbc/core/proto/paladin.js';
+import { HolyPaladin, HolyPaladin_Rotation as HolyPaladinRotation, HolyPaladin_Options as HolyPaladinOptions } from '/tbc/core/proto/paladin.js';
 import { ShadowPriest, SmitePriest_Rotation as SmitePriestRotation, ShadowPriest_Rotation as ShadowPriestRotation, PriestTalents, ShadowPriest_Options as ShadowPriestOptions, SmitePriest_Options as SmitePriestOptions, SmitePriest } from '/tbc/core/proto/priest.js';
 import { Warlock, Warlock_Rotation as WarlockRotation, WarlockTalents, Warlock_Options as WarlockOptions } from '/tbc/core/proto/warlock.js';
 import { Warrior, Warrior_Rotation as WarriorRotation, WarriorTalents, Warrior_Options as WarriorOptions } from '/tbc/core/proto/warrior.js';
@@ -57,7 +58,7 @@ export type DruidSpecs = [Spec.SpecBalanceDruid, Spec.SpecFeralDruid, Spec.SpecF
 export type HunterSpecs = Spec.SpecHunter;
 export type MageSpecs = Spec.SpecMage;
 export type RogueSpecs = Spec.SpecRogue;
-export type PaladinSpecs = [Spec.SpecRetributionPaladin, Spec.SpecProtectionPaladin];
+export type PaladinSpecs = [Spec.SpecRetributionPaladin, Spec.SpecProtectionPaladin, Spec.SpecHolyPaladin];
 export type PriestSpecs = [Spec.SpecShadowPriest, Spec.SpecSmitePriest];
 export type ShamanSpecs = [Spec.SpecElementalShaman, Spec.SpecEnhancementShaman];
 export type WarlockSpecs = Spec.SpecWarlock;
@@ -75,6 +76,7 @@ export const naturalSpecOrder: Array<Spec> = [
 	Spec.SpecMage,
 	Spec.SpecRetributionPaladin,
 	Spec.SpecProtectionPaladin,
+	Spec.SpecHolyPaladin,
 	Spec.SpecShadowPriest,
 	Spec.SpecSmitePriest,
 	Spec.SpecRogue,
@@ -96,6 +98,7 @@ export const specNames: Record<Spec, string> = {
 	[Spec.SpecRogue]: 'Rogue',
 	[Spec.SpecRetributionPaladin]: 'Retribution Paladin',
 	[Spec.SpecProtectionPaladin]: 'Protection Paladin',
+	[Spec.SpecHolyPaladin]: 'Holy Paladin',
 	[Spec.SpecShadowPriest]: 'Shadow Priest',
 	[Spec.SpecWarlock]: 'Warlock',
 	[Spec.SpecWarrior]: 'Warrior',
@@ -127,6 +130,7 @@ export const specIconsLarge: Record<Spec, string> = {
 	[Spec.SpecRogue]: 'https://wow.zamimg.com/images/wow/icons/large/classicon_rogue.jpg',
 	[Spec.SpecRetributionPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_auraoflight.jpg',
 	[Spec.SpecProtectionPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_devotionaura.jpg',
+	[Spec.SpecHolyPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_holybolt.jpg',
 	[Spec.SpecShadowPriest]: 'https://wow.zamimg.com/images/wow/icons/large/spell_shadow_shadowwordpain.jpg',
 	[Spec.SpecWarlock]: 'https://wow.zamimg.com/images/wow/icons/large/spell_shadow_metamorphosis.jpg',
 	[Spec.SpecWarrior]: 'https://wow.zamimg.com/images/wow/icons/large/ability_warrior_innerrage.jpg',
@@ -194,6 +198,7 @@ export const titleIcons: Record<Spec, string> = {
 	[Spec.SpecRogue]: '/tbc/assets/rogue_icon.png',
 	[Spec.SpecRetributionPaladin]: '/tbc/assets/retribution_icon.png',
 	[Spec.SpecProtectionPaladin]: '/tbc/assets/protection_paladin_icon.png',
+	[Spec.SpecHolyPaladin]: 'https://wow.zamimg.com/images/wow/icons/large/spell_holy_holybolt.jpg',
 	[Spec.SpecShadowPriest]: '/tbc/assets/shadow_priest_icon.png',
 	[Spec.SpecWarlock]: '/tbc/assets/warlock_icon.png',
 	[Spec.SpecWarrior]: '/tbc/assets/warrior_icon.png',
@@ -237,6 +242,7 @@ export type RotationUnion =
 	RogueRotation |
 	RetributionPaladinRotation |
 	ProtectionPaladinRotation |
+	HolyPaladinRotation |
 	ShadowPriestRotation |
 	WarlockRotation |
 	WarriorRotation |
@@ -253,6 +259,7 @@ export type SpecRotation<T extends Spec> =
 	T extends Spec.SpecRogue ? RogueRotation :
 	T extends Spec.SpecRetributionPaladin ? RetributionPaladinRotation :
 	T extends Spec.SpecProtectionPaladin ? ProtectionPaladinRotation :
+	T extends Spec.SpecHolyPaladin ? HolyPaladinRotation :
 	T extends Spec.SpecShadowPriest ? ShadowPriestRotation :
 	T extends Spec.SpecWarlock ? WarlockRotation :
 	T extends Spec.SpecWarrior ? WarriorRotation :
@@ -281,6 +288,7 @@ export type SpecTalents<T extends Spec> =
 	T extends Spec.SpecRogue ? RogueTalents :
 	T extends Spec.SpecRetributionPaladin ? PaladinTalents :
 	T extends Spec.SpecProtectionPaladin ? PaladinTalents :
+	T extends Spec.SpecHolyPaladin ? PaladinTalents :
 	T extends Spec.SpecShadowPriest ? PriestTalents :
 	T extends Spec.SpecWarlock ? WarlockTalents :
 	T extends Spec.SpecWarrior ? WarriorTalents :
@@ -298,6 +306,7 @@ export type SpecOptionsUnion =
 	RogueOptions |
 	RetributionPaladinOptions |
 	ProtectionPaladinOptions |
+	HolyPaladinOptions |
 	ShadowPriestOptions |
 	WarlockOptions |
 	WarriorOptions |
@@ -314,6 +323,7 @@ export type SpecOptions<T extends Spec> =
 	T extends Spec.SpecRogue ? RogueOptions :
 	T extends Spec.SpecRetributionPaladin ? RetributionPaladinOptions :
 	T extends Spec.SpecProtectionPaladin ? ProtectionPaladinOptions :
+	T extends Spec.SpecHolyPaladin ? HolyPaladinOptions :
 	T extends Spec.SpecShadowPriest ? ShadowPriestOptions :
 	T extends Spec.SpecWarlock ? WarlockOptions :
 	T extends Spec.SpecWarrior ? WarriorOptions :
@@ -332,6 +342,7 @@ export type SpecProtoUnion =
 	Rogue |
 	RetributionPaladin |
 	ProtectionPaladin |
+	HolyPaladin |
 	ShadowPriest |
 	Warlock |
 	Warrior |
@@ -348,6 +359,7 @@ export type SpecProto<T extends Spec> =
 	T extends Spec.SpecRogue ? Rogue :
 	T extends Spec.SpecRetributionPaladin ? RetributionPaladin :
 	T extends Spec.SpecProtectionPaladin ? ProtectionPaladin :
+	T extends Spec.SpecHolyPaladin ? HolyPaladin :
 	T extends Spec.SpecShadowPriest ? ShadowPriest :
 	T extends Spec.SpecWarlock ? Warlock :
 	T extends Spec.SpecWarrior ? Warrior :
@@ -631,6 +643,34 @@ export const specTypeFunctions: Record<Spec, SpecTypeFunctions<any>> = {
 			? player.spec.protectionPaladin.options || ProtectionPaladinOptions.create()
 			: ProtectionPaladinOptions.create(),
 	},
+	[Spec.SpecHolyPaladin]: {
+		rotationCreate: () => HolyPaladinRotation.create(),
+		rotationEquals: (a, b) => HolyPaladinRotation.equals(a as HolyPaladinRotation, b as HolyPaladinRotation),
+		rotationCopy: (a) => HolyPaladinRotation.clone(a as HolyPaladinRotation),
+		rotationToJson: (a) => HolyPaladinRotation.toJson(a as HolyPaladinRotation),
+		rotationFromJson: (obj) => HolyPaladinRotation.fromJson(obj),
+		rotationFromPlayer: (player) => player.spec.oneofKind == 'holyPaladin'
+			? player.spec.holyPaladin.rotation || HolyPaladinRotation.create()
+			: HolyPaladinRotation.create(),
+
+		talentsCreate: () => PaladinTalents.create(),
+		talentsEquals: (a, b) => PaladinTalents.equals(a as PaladinTalents, b as PaladinTalents),
+		talentsCopy: (a) => PaladinTalents.clone(a as PaladinTalents),
+		talentsToJson: (a) => PaladinTalents.toJson(a as PaladinTalents),
+		talentsFromJson: (obj) => PaladinTalents.fromJson(obj),
+		talentsFromPlayer: (player) => player.spec.oneofKind == 'holyPaladin'
+			? player.spec.holyPaladin.talents || PaladinTalents.create()
+			: PaladinTalents.create(),
+
+		optionsCreate: () => HolyPaladinOptions.create(),
+		optionsEquals: (a, b) => HolyPaladinOptions.equals(a as HolyPaladinOptions, b as HolyPaladinOptions),
+		optionsCopy: (a) => HolyPaladinOptions.clone(a as HolyPaladinOptions),
+		optionsToJson: (a) => HolyPaladinOptions.toJson(a as HolyPaladinOptions),
+		optionsFromJson: (obj) => HolyPaladinOptions.fromJson(obj),
+		optionsFromPlayer: (player) => player.spec.oneofKind == 'holyPaladin'
+			? player.spec.holyPaladin.options || HolyPaladinOptions.create()
+			: HolyPaladinOptions.create(),
+	},
 	[Spec.SpecRogue]: {
 		rotationCreate: () => RogueRotation.create(),
 		rotationEquals: (a, b) => RogueRotation.equals(a as RogueRotation, b as RogueRotation),
@@ -833,6 +873,7 @@ export const specToClass: Record<Spec, Class> = {
 	[Spec.SpecRogue]: Class.ClassRogue,
 	[Spec.SpecRetributionPaladin]: Class.ClassPaladin,
 	[Spec.SpecProtectionPaladin]: Class.ClassPaladin,
+	[Spec.SpecHolyPaladin]: Class.ClassPaladin,
 	[Spec.SpecShadowPriest]: Class.ClassPriest,
 	[Spec.SpecWarlock]: Class.ClassWarlock,
 	[Spec.SpecWarrior]: Class.ClassWarrior,
@@ -928,6 +969,7 @@ export const specToEligibleRaces: Record<Spec, Array<Race>> = {
 	[Spec.SpecMage]: mageRaces,
 	[Spec.SpecRetributionPaladin]: paladinRaces,
 	[Spec.SpecProtectionPaladin]: paladinRaces,
+	[Spec.SpecHolyPaladin]: paladinRaces,
 	[Spec.SpecRogue]: rogueRaces,
 	[Spec.SpecShadowPriest]: priestRaces,
 	[Spec.SpecWarlock]: warlockRaces,
@@ -975,6 +1017,7 @@ export const specToLocalStorageKey: Record<Spec, string> = {
 	[Spec.SpecMage]: '__mage',
 	[Spec.SpecRetributionPaladin]: '__retribution_paladin',
 	[Spec.SpecProtectionPaladin]: '__protection_paladin',
+	[Spec.SpecHolyPaladin]: '__holy_paladin',
 	[Spec.SpecRogue]: '__rogue',
 	[Spec.SpecShadowPriest]: '__shadow_priest',
 	[Spec.SpecWarlock]: '__warlock',
@@ -1083,6 +1126,16 @@ export function withSpecProto<SpecType extends Spec>(
 				}),
 			};
 			return copy;
+		case Spec.SpecHolyPaladin:
+			copy.spec = {
+				oneofKind: 'holyPaladin',
+				holyPaladin: HolyPaladin.create({
+					rotation: rotation as HolyPaladinRotation,
+					talents: talents as PaladinTalents,
+					options: specOptions as HolyPaladinOptions,
+				}),
+			};
+			return copy;
 		case Spec.SpecRogue:
 			copy.spec = {
 				oneofKind: 'rogue',
@@ -1515,6 +1568,7 @@ export function makeDefaultBlessings(numPaladins: number): BlessingsAssignments
 		{ spec: Spec.SpecMage, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfSalvation, Blessings.BlessingOfWisdom] },
 		{ spec: Spec.SpecRetributionPaladin, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfMight, Blessings.BlessingOfSalvation, Blessings.BlessingOfWisdom] },
 		{ spec: Spec.SpecProtectionPaladin, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfSanctuary, Blessings.BlessingOfWisdom, Blessings.BlessingOfMight] },
+		{ spec: Spec.SpecHolyPaladin, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfWisdom, Blessings.BlessingOfSalvation] },
 		{ spec: Spec.SpecShadowPriest, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfSalvation, Blessings.BlessingOfWisdom] },
 		{ spec: Spec.SpecSmitePriest, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfSalvation, Blessings.BlessingOfWisdom] },
 		{ spec: Spec.SpecRogue, blessings: [Blessings.BlessingOfKings, Blessings.BlessingOfSalvation, Blessings.BlessingOfMight] },
@@ -106,6 +106,11 @@ export class Sim {
 	private readonly _initPromise: Promise<void>;
 	private lastUsedRngSeed: number = 0;
 
+	// Controllers for the most recently started sims, so that starting a new sim
+	// stops the previous one of the same kind instead of running both.
+	private raidSimAbortController: AbortController | null = null;
+	private statWeightsAbortController: AbortController | null = null;
+
 	// These callbacks are needed so we can apply BuffBot modifications automatically before sending requests.
 	private modifyRaidProto: ((raidProto: RaidProto) => void) = () => { };
 
@@ -203,10 +208,17 @@ export class Sim {
 
 		const request = this.makeRaidSimRequest(false);
 
-		var result = await this.workerPool.raidSimAsync(request, onProgress);
+		this.raidSimAbortController?.abort();
+		const abortController = new AbortController();
+		this.raidSimAbortController = abortController;
+
+		var result = await this.workerPool.raidSimAsync(request, onProgress, abortController.signal);
 
 		const simResult = await SimResult.makeNew(request, result);
-		this.simResultEmitter.emit(eventID, simResult);
+		// A newer sim replaced this one, so only its results should be shown.
+		if (!abortController.signal.aborted) {
+			this.simResultEmitter.emit(eventID, simResult);
+		}
 		return simResult;
 	}
 
@@ -220,10 +232,17 @@ export class Sim {
 		await this.waitForInit();
 
 		const request = this.makeRaidSimRequest(true);
-		const result = await this.workerPool.raidSimAsync(request, () => { });
+
+		this.raidSimAbortController?.abort();
+		const abortController = new AbortController();
+		this.raidSimAbortController = abortController;
+
+		const result = await this.workerPool.raidSimAsync(request, () => { }, abortController.signal);
 
 		const simResult = await SimResult.makeNew(request, result);
-		this.simResultEmitter.emit(eventID, simResult);
+		if (!abortController.signal.aborted) {
+			this.simResultEmitter.emit(eventID, simResult);
+		}
 		return simResult;
 	}
 
@@ -285,7 +304,12 @@ export class Sim {
 				statsToWeigh: epStats,
 				epReferenceStat: epReferenceStat,
 			});
-			var result = await this.workerPool.statWeightsAsync(request, onProgress);
+
+			this.statWeightsAbortController?.abort();
+			const abortController = new AbortController();
+			this.statWeightsAbortController = abortController;
+
+			var result = await this.workerPool.statWeightsAsync(request, onProgress, abortController.signal);
 			return result;
 		}
 	}
@@ -48,10 +48,12 @@ export class WorkerPool {
 		return ComputeStatsResult.fromBinary(result);
 	}
 
-	async statWeightsAsync(request: StatWeightsRequest, onProgress: Function): Promise<StatWeightsResult> {
+	// If signal is aborted, the sim stops early and resolves with the iterations it completed.
+	async statWeightsAsync(request: StatWeightsRequest, onProgress: Function, signal?: AbortSignal): Promise<StatWeightsResult> {
 		console.log('Stat weights request: ' + StatWeightsRequest.toJsonString(request));
 		const worker = this.getLeastBusyWorker();
 		const id = worker.makeTaskId();
+		signal?.addEventListener('abort', () => worker.cancelTask(id));
 		// Add handler for the progress events
 		worker.addPromiseFunc(id + "progress", this.newProgressHandler(id, worker, onProgress), (err) => { })
 
@@ -62,16 +64,21 @@ export class WorkerPool {
 		return result.finalWeightResult!;
 	}
 
-	async raidSimAsync(request: RaidSimRequest, onProgress: Function): Promise<RaidSimResult> {
+	// If signal is aborted, the sim stops early and resolves with the iterations it completed.
+	async raidSimAsync(request: RaidSimRequest, onProgress: Function, signal?: AbortSignal): Promise<RaidSimResult> {
 		console.log('Raid sim request: ' + RaidSimRequest.toJsonString(request));
 		const worker = this.getLeastBusyWorker();
 		const id = worker.makeTaskId();
+		signal?.addEventListener('abort', () => worker.cancelTask(id));
 		// Add handler for the progress events
 		worker.addPromiseFunc(id + "progress", this.newProgressHandler(id, worker, onProgress), (err) => { })
 
 		// Now start the async sim
 		const resultData = await worker.doApiCall('raidSimAsync', RaidSimRequest.toBinary(request), id);
 		const result = ProgressMetrics.fromBinary(resultData)
+		if (result.finalRaidResult?.errorResult) {
+			throw new Error(result.finalRaidResult.errorResult);
+		}
 
 		// Don't print the logs because it just clogs the console.
 		const resultJson = RaidSimResult.toJson(result.finalRaidResult!) as any;
@@ -137,6 +144,14 @@ class SimWorker {
 		this.taskIdsToPromiseFuncs[id] = [callback, onError];
 	}
 
+	// Stops a running async sim. Its promise still resolves with a partial result.
+	cancelTask(id: string) {
+		this.worker.postMessage({
+			msg: 'cancelAsync',
+			id: id,
+		});
+	}
+
 	makeTaskId(): string {
 		let id = '';
 		const characters = 'ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789';
@@ -217,6 +217,7 @@ export class ElementalShamanSimUI extends IndividualSimUI<Spec.SpecElementalSham
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					ShamanInputs.SnapshotT42Pc,
 					OtherInputs.ShadowPriestDPS,
 					OtherInputs.StartingPotion,
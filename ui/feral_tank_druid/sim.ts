@@ -268,6 +268,7 @@ export class FeralTankDruidSimUI extends IndividualSimUI<Spec.SpecFeralTankDruid
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					DruidInputs.StartingRage,
 					OtherInputs.StartingPotion,
 					OtherInputs.NumStartingPotions,
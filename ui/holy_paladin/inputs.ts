@@ -0,0 +1,86 @@
+import { Spec } from '/tbc/core/proto/common.js';
+import { Player } from '/tbc/core/player.js';
+import { EventID } from '/tbc/core/typed_event.js';
+import { IndividualSimUI } from '/tbc/core/individual_sim_ui.js';
+
+import {
+	PaladinAura as PaladinAura,
+	HolyPaladin_Rotation_PrimarySpell as PrimarySpell,
+} from '/tbc/core/proto/paladin.js';
+
+// Configuration for spec-specific UI elements on the settings tab.
+// These don't need to be in a separate file but it keeps things cleaner.
+export const HolyPaladinRotationConfig = {
+	inputs: [
+		{
+			type: 'enum' as const, cssClass: 'primary-spell-picker',
+			getModObject: (simUI: IndividualSimUI<any>) => simUI.player,
+			config: {
+				label: 'Primary Spell',
+				labelTooltip: 'Heal which is cast on the heal target whenever possible.',
+				values: [
+					{ name: 'Holy Light', value: PrimarySpell.HolyLight },
+					{ name: 'Flash of Light', value: PrimarySpell.FlashOfLight },
+				],
+				changedEvent: (player: Player<Spec.SpecHolyPaladin>) => player.rotationChangeEmitter,
+				getValue: (player: Player<Spec.SpecHolyPaladin>) => player.getRotation().primarySpell,
+				setValue: (eventID: EventID, player: Player<Spec.SpecHolyPaladin>, newValue: number) => {
+					const newRotation = player.getRotation();
+					newRotation.primarySpell = newValue;
+					player.setRotation(eventID, newRotation);
+				},
+			},
+		},
+		{
+			type: 'boolean' as const, cssClass: 'use-divine-favor-picker',
+			getModObject: (simUI: IndividualSimUI<any>) => simUI.player,
+			config: {
+				label: 'Use Divine Favor',
+				labelTooltip: 'Uses Divine Favor whenever it is off cooldown.',
+				changedEvent: (player: Player<Spec.SpecHolyPaladin>) => player.rotationChangeEmitter,
+				getValue: (player: Player<Spec.SpecHolyPaladin>) => player.getRotation().useDivineFavor,
+				setValue: (eventID: EventID, player: Player<Spec.SpecHolyPaladin>, newValue: boolean) => {
+					const newRotation = player.getRotation();
+					newRotation.useDivineFavor = newValue;
+					player.setRotation(eventID, newRotation);
+				},
+			},
+		},
+	],
+}
+
+export const AuraSelection = {
+	type: 'enum' as const, cssClass: 'aura-picker',
+	getModObject: (simUI: IndividualSimUI<any>) => simUI.player,
+	config: {
+		label: 'Aura',
+		values: [
+			{ name: 'None', value: PaladinAura.NoPaladinAura },
+			{ name: 'Devotion Aura', value: PaladinAura.DevotionAura },
+			{ name: 'Retribution Aura', value: PaladinAura.RetributionAura },
+		],
+		changedEvent: (player: Player<Spec.SpecHolyPaladin>) => player.specOptionsChangeEmitter,
+		getValue: (player: Player<Spec.SpecHolyPaladin>) => player.getSpecOptions().aura,
+		setValue: (eventID: EventID, player: Player<Spec.SpecHolyPaladin>, newValue: number) => {
+			const newOptions = player.getSpecOptions();
+			newOptions.aura = newValue;
+			player.setSpecOptions(eventID, newOptions);
+		},
+	},
+}
+
+export const DamageTakenPerSecond = {
+	type: 'number' as const, cssClass: 'damage-taken-per-second-picker',
+	getModObject: (simUI: IndividualSimUI<any>) => simUI.player,
+	config: {
+		label: 'Incoming DPS',
+		labelTooltip: 'Damage per second taken by the heal target, in addition to any damage from the encounter.',
+		changedEvent: (player: Player<Spec.SpecHolyPaladin>) => player.specOptionsChangeEmitter,
+		getValue: (player: Player<Spec.SpecHolyPaladin>) => player.getSpecOptions().damageTakenPerSecond,
+		setValue: (eventID: EventID, player: Player<Spec.SpecHolyPaladin>, newValue: number) => {
+			const newOptions = player.getSpecOptions();
+			newOptions.damageTakenPerSecond = newValue;
+			player.setSpecOptions(eventID, newOptions);
+		},
+	},
+};
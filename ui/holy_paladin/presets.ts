@@ -0,0 +1,83 @@
+import { Consumes } from '/tbc/core/proto/common.js';
+import { EquipmentSpec } from '/tbc/core/proto/common.js';
+import { Flask } from '/tbc/core/proto/common.js';
+import { Food } from '/tbc/core/proto/common.js';
+import { Potions } from '/tbc/core/proto/common.js';
+import { Spec } from '/tbc/core/proto/common.js';
+import { Player } from '/tbc/core/player.js';
+
+import {
+	PaladinAura as PaladinAura,
+	HolyPaladin_Rotation as HolyPaladinRotation,
+	HolyPaladin_Rotation_PrimarySpell as PrimarySpell,
+	HolyPaladin_Options as HolyPaladinOptions,
+} from '/tbc/core/proto/paladin.js';
+
+import * as Tooltips from '/tbc/core/constants/tooltips.js';
+
+// Preset options for this spec.
+// Eventually we will import these values for the raid sim too, so its good to
+// keep them in a separate file.
+
+// Default talents. Uses the wowhead calculator format, make the talents on
+// https://tbc.wowhead.com/talent-calc and copy the numbers in the url.
+export const StandardTalents = {
+	name: 'Standard',
+	data: '05503100521035131351-55305',
+};
+
+export const DefaultRotation = HolyPaladinRotation.create({
+	primarySpell: PrimarySpell.HolyLight,
+	useDivineFavor: true,
+});
+
+export const DefaultOptions = HolyPaladinOptions.create({
+	aura: PaladinAura.DevotionAura,
+	damageTakenPerSecond: 1000,
+});
+
+export const DefaultConsumes = Consumes.create({
+	flask: Flask.FlaskOfMightyRestoration,
+	food: Food.FoodBlackenedBasilisk,
+	defaultPotion: Potions.SuperManaPotion,
+});
+
+export const P1_PRESET = {
+	name: 'P1 Preset',
+	tooltip: Tooltips.BASIC_BIS_DISCLAIMER,
+	enableWhen: (player: Player<Spec.SpecHolyPaladin>) => true,
+	gear: EquipmentSpec.fromJsonString(`{"items": [
+		{
+			"id": 29061
+		},
+		{},
+		{
+			"id": 29064
+		},
+		{},
+		{
+			"id": 29062
+		},
+		{},
+		{
+			"id": 29065
+		},
+		{},
+		{
+			"id": 29063
+		},
+		{},
+		{
+			"id": 28790
+		},
+		{},
+		{},
+		{},
+		{
+			"id": 28771
+		},
+		{
+			"id": 29458
+		}
+	]}`),
+};
@@ -0,0 +1,188 @@
+import { RaidBuffs } from '/tbc/core/proto/common.js';
+import { PartyBuffs } from '/tbc/core/proto/common.js';
+import { IndividualBuffs } from '/tbc/core/proto/common.js';
+import { Debuffs } from '/tbc/core/proto/common.js';
+import { Spec } from '/tbc/core/proto/common.js';
+import { Stat } from '/tbc/core/proto/common.js';
+import { TristateEffect } from '/tbc/core/proto/common.js'
+import { Stats } from '/tbc/core/proto_utils/stats.js';
+import { Player } from '/tbc/core/player.js';
+import { IndividualSimUI } from '/tbc/core/individual_sim_ui.js';
+import { TypedEvent } from '/tbc/core/typed_event.js';
+
+import { Conjured } from '/tbc/core/proto/common.js';
+import { Flask } from '/tbc/core/proto/common.js';
+import { Food } from '/tbc/core/proto/common.js';
+import { GuardianElixir } from '/tbc/core/proto/common.js';
+import { BattleElixir } from '/tbc/core/proto/common.js';
+import { Potions } from '/tbc/core/proto/common.js';
+
+import * as IconInputs from '/tbc/core/components/icon_inputs.js';
+
+import * as HolyPaladinInputs from './inputs.js';
+import * as Presets from './presets.js';
+
+export class HolyPaladinSimUI extends IndividualSimUI<Spec.SpecHolyPaladin> {
+	constructor(parentElem: HTMLElement, player: Player<Spec.SpecHolyPaladin>) {
+		super(parentElem, player, {
+			cssClass: 'holy-paladin-sim-ui',
+			// List any known bugs / issues here and they'll be shown on the site.
+			knownIssues: [
+			],
+			warnings: [
+				(simUI: IndividualSimUI<Spec.SpecHolyPaladin>) => {
+					return {
+						updateOn: TypedEvent.onAny([simUI.player.rotationChangeEmitter]),
+						shouldDisplay: () => true,
+						getContent: () => 'This is the first healing sim. Results are reported as HPS in the detailed results, and DPS will be 0.',
+					};
+				},
+			],
+
+			// All stats for which EP should be calculated.
+			epStats: [
+				Stat.StatIntellect,
+				Stat.StatSpirit,
+				Stat.StatHealingPower,
+				Stat.StatSpellCrit,
+				Stat.StatSpellHaste,
+				Stat.StatMP5,
+			],
+			// Reference stat against which to calculate EP.
+			epReferenceStat: Stat.StatHealingPower,
+			// Which stats to display in the Character Stats section, at the bottom of the left-hand sidebar.
+			displayStats: [
+				Stat.StatHealth,
+				Stat.StatArmor,
+				Stat.StatStamina,
+				Stat.StatIntellect,
+				Stat.StatSpirit,
+				Stat.StatMP5,
+				Stat.StatHealingPower,
+				Stat.StatSpellCrit,
+				Stat.StatSpellHaste,
+			],
+			defaults: {
+				// Default equipped gear.
+				gear: Presets.P1_PRESET.gear,
+				// Default EP weights for sorting gear in the gear picker.
+				epWeights: Stats.fromMap({
+					[Stat.StatIntellect]: 0.4,
+					[Stat.StatSpirit]: 0.1,
+					[Stat.StatHealingPower]: 1,
+					[Stat.StatSpellCrit]: 0.3,
+					[Stat.StatSpellHaste]: 0.5,
+					[Stat.StatMP5]: 1,
+				}),
+				// Default consumes settings.
+				consumes: Presets.DefaultConsumes,
+				// Default rotation settings.
+				rotation: Presets.DefaultRotation,
+				// Default talents.
+				talents: Presets.StandardTalents.data,
+				// Default spec-specific settings.
+				specOptions: Presets.DefaultOptions,
+				// Default raid/party buffs settings.
+				raidBuffs: RaidBuffs.create({
+					arcaneBrilliance: true,
+					powerWordFortitude: TristateEffect.TristateEffectImproved,
+					giftOfTheWild: TristateEffect.TristateEffectImproved,
+				}),
+				partyBuffs: PartyBuffs.create({
+					manaSpringTotem: TristateEffect.TristateEffectRegular,
+					wrathOfAirTotem: TristateEffect.TristateEffectRegular,
+				}),
+				individualBuffs: IndividualBuffs.create({
+					blessingOfKings: true,
+					blessingOfWisdom: TristateEffect.TristateEffectImproved,
+				}),
+				debuffs: Debuffs.create({
+				}),
+			},
+
+			// IconInputs to include in the 'Self Buffs' section on the settings tab.
+			selfBuffInputs: [
+			],
+			// IconInputs to include in the 'Other Buffs' section on the settings tab.
+			raidBuffInputs: [
+				IconInputs.ArcaneBrilliance,
+				IconInputs.PowerWordFortitude,
+				IconInputs.DivineSpirit,
+				IconInputs.GiftOfTheWild,
+			],
+			partyBuffInputs: [
+				IconInputs.Bloodlust,
+				IconInputs.ManaSpringTotem,
+				IconInputs.WrathOfAirTotem,
+				IconInputs.TotemOfWrath,
+				IconInputs.MoonkinAura,
+				IconInputs.DevotionAura,
+			],
+			playerBuffInputs: [
+				IconInputs.BlessingOfKings,
+				IconInputs.BlessingOfWisdom,
+				IconInputs.BlessingOfSalvation,
+			],
+			// IconInputs to include in the 'Debuffs' section on the settings tab.
+			debuffInputs: [
+				IconInputs.JudgementOfWisdom,
+			],
+			// Which options are selectable in the 'Consumes' section.
+			consumeOptions: {
+				potions: [
+					Potions.SuperManaPotion,
+				],
+				conjured: [
+					Conjured.ConjuredDarkRune,
+				],
+				flasks: [
+					Flask.FlaskOfMightyRestoration,
+					Flask.FlaskOfBlindingLight,
+				],
+				battleElixirs: [
+					BattleElixir.AdeptsElixir,
+				],
+				guardianElixirs: [
+					GuardianElixir.ElixirOfDraenicWisdom,
+					GuardianElixir.ElixirOfMajorMageblood,
+				],
+				food: [
+					Food.FoodBlackenedBasilisk,
+					Food.FoodSkullfishSoup,
+				],
+			},
+			// Inputs to include in the 'Rotation' section on the settings tab.
+			rotationInputs: HolyPaladinInputs.HolyPaladinRotationConfig,
+			// Inputs to include in the 'Other' section on the settings tab.
+			otherInputs: {
+				inputs: [
+					OtherInputs.PlayerLatency,
+					HolyPaladinInputs.AuraSelection,
+					HolyPaladinInputs.DamageTakenPerSecond,
+				],
+			},
+			encounterPicker: {
+				// Target stats to show for 'Simple' encounters.
+				simpleTargetStats: [
+				],
+				// Whether to include 'Execute Duration (%)' in the 'Encounter' section of the settings tab.
+				showExecuteProportion: false,
+			},
+
+			// If true, the talents on the talents tab will not be individually modifiable by the user.
+			// Note that the use can still pick between preset talents, if there is more than 1.
+			freezeTalents: false,
+
+			presets: {
+				// Preset talents that the user can quickly select.
+				talents: [
+					Presets.StandardTalents,
+				],
+				// Preset gear configurations that the user can quickly select.
+				gear: [
+					Presets.P1_PRESET,
+				],
+			},
+		});
+	}
+}
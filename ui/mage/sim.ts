@@ -209,6 +209,7 @@ export class MageSimUI extends IndividualSimUI<Spec.SpecMage> {
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					MageInputs.EvocationTicks,
 					OtherInputs.ShadowPriestDPS,
 					OtherInputs.StartingPotion,
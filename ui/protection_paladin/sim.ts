@@ -278,6 +278,7 @@ export class ProtectionPaladinSimUI extends IndividualSimUI<Spec.SpecProtectionP
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					ProtectionPaladinInputs.AuraSelection,
 					ProtectionPaladinInputs.UseAvengingWrath,
 					OtherInputs.ExposeWeaknessUptime,
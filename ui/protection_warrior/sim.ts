@@ -264,6 +264,7 @@ export class ProtectionWarriorSimUI extends IndividualSimUI<Spec.SpecProtectionW
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					ProtectionWarriorInputs.StartingRage,
 					ProtectionWarriorInputs.ShoutPicker,
 					ProtectionWarriorInputs.PrecastShout,
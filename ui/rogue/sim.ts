@@ -255,6 +255,7 @@ export class RogueSimUI extends IndividualSimUI<Spec.SpecRogue> {
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					OtherInputs.StartingConjured,
 					OtherInputs.NumStartingConjured,
 					OtherInputs.ExposeWeaknessUptime,
@@ -209,6 +209,7 @@ export class SmitePriestSimUI extends IndividualSimUI<Spec.SpecSmitePriest> {
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					OtherInputs.ISBUptime,
 					OtherInputs.ShadowPriestDPS,
 					OtherInputs.StartingPotion,
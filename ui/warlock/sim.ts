@@ -225,6 +225,7 @@ export class WarlockSimUI extends IndividualSimUI<Spec.SpecWarlock> {
 			// Inputs to include in the 'Other' section on the settings tab.
 			otherInputs: {
 				inputs: [
+					OtherInputs.PlayerLatency,
 					OtherInputs.ISBUptime,
 					OtherInputs.ShadowPriestDPS,
 					OtherInputs.StartingPotion,
@@ -1,5 +1,8 @@
 var workerID = "";
 
+// Progress IDs of running async sims, keyed by task ID.
+var asyncProgressIDs = {};
+
 addEventListener('message', async (e) => {
 	const msg = e.data.msg;
 	const id = e.data.id;
@@ -10,6 +13,19 @@ addEventListener('message', async (e) => {
         return;
 	}
 
+    if (msg == "cancelAsync") {
+        if (asyncProgressIDs[id]) {
+            fetch("/asyncCancel", {
+                method: 'POST',
+                headers: {
+                    'Content-Type': 'application/x-protobuf'
+                },
+                body: asyncProgressIDs[id],
+            });
+        }
+        return;
+    }
+
     var url = "/"+msg;
     let response = await fetch(url, {
         method: 'POST',
@@ -22,6 +38,7 @@ addEventListener('message', async (e) => {
     var content = await response.arrayBuffer();
     var outputData;
     if (msg == "raidSimAsync" || msg == "statWeightsAsync") {
+        asyncProgressIDs[id] = content;
         while (true) {
             let progressResponse = await fetch("/asyncProgress", {
                 method: 'POST',
@@ -45,6 +62,7 @@ addEventListener('message', async (e) => {
             });        
             await new Promise(resolve => setTimeout(resolve, 500));
         }
+        delete asyncProgressIDs[id];
     } else {
         outputData = content;
     }
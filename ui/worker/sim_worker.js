@@ -36,7 +36,7 @@ addEventListener('message', async (e) => {
 					outputData: result,
 					id: id+"progress",
 				});
-			});
+			}, id);
 		}],
 		['statWeights', statWeights],
 		['statWeightsAsync', (data) => {
@@ -46,21 +46,23 @@ addEventListener('message', async (e) => {
 					outputData: result,
 					id: id+"progress",
 				});
-			});
+			}, id);
 		}],
-	].forEach(funcData => {
+	].forEach(async funcData => {
 		const funcName = funcData[0];
 		const func = funcData[1];
 
 		if (msg == funcName) {
-			const outputData = func(e.data.inputData);
+			handled = true;
+			// Async sims return a Promise, so the worker can still receive
+			// cancelAsync messages while they run.
+			const outputData = await func(e.data.inputData);
 
 			postMessage({
 				msg: funcName,
 				outputData: outputData,
 				id: id,
 			});
-			handled = true;
 		}
 	});
 
@@ -68,6 +70,11 @@ addEventListener('message', async (e) => {
 		return;
 	}
 
+	if (msg == "cancelAsync") {
+		cancelAsync(id);
+		return;
+	}
+
 	if (msg == "setID") {
 		workerID = id;
 		postMessage({ msg: "idconfirm" })